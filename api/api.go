@@ -1,5 +1,22 @@
 package api
 
+const (
+	// Version is the version of the daemon API, which is served under
+	// PathPrefix and described by the document in package api/openapi
+	Version = "v1"
+
+	// PathPrefix is the path under which the daemon API is served
+	PathPrefix = "/" + Version
+
+	// DaemonVersionHeader is the HTTP header used by the daemon to report its
+	// version in every response
+	DaemonVersionHeader = "X-Inertia-Daemon-Version"
+
+	// APIVersionHeader is the HTTP header used by the daemon to report the
+	// version of the API it serves in every response
+	APIVersionHeader = "X-Inertia-API-Version"
+)
+
 const (
 	// MsgDaemonOK is the OK response upon successfully reaching daemon
 	MsgDaemonOK = "I'm a little Webhook, short and stout!"
@@ -15,6 +32,31 @@ const (
 
 	// Entries is a constant used in HTTP GET query strings
 	Entries = "entries"
+
+	// Interval is a constant used in HTTP GET query strings, in seconds
+	Interval = "interval"
+
+	// Since and Until are constants used in HTTP GET query strings, as RFC3339
+	// timestamps, Unix timestamps, or durations before the present such as "10m"
+	Since = "since"
+	Until = "until"
+
+	// Grep is a constant used in HTTP GET query strings, as a regular expression
+	Grep = "grep"
+
+	// Level is a constant used in HTTP GET query strings, such as "warn"
+	Level = "level"
+
+	// All is a constant used in HTTP GET query strings
+	All = "all"
+
+	// Type is a constant used in HTTP GET query strings, as a comma-separated
+	// list of event types or prefixes such as "build"
+	Type = "type"
+
+	// MigrationPassphraseHeader is the HTTP header used to provide the
+	// passphrase for a migration bundle being imported
+	MigrationPassphraseHeader = "X-Inertia-Migration-Passphrase"
 )
 
 // UpRequest is the configurable body of a UP request to the daemon.
@@ -22,18 +64,21 @@ const (
 type UpRequest struct {
 	Stream                 bool       `json:"stream"`
 	Project                string     `json:"project"`
+	Profile                string     `json:"profile,omitempty"`
 	BuildType              string     `json:"build_type"`
 	BuildFilePath          string     `json:"build_file_path"`
 	GitOptions             GitOptions `json:"git_options"`
 	WebHookSecret          string     `json:"webhook_secret"`
 	IntermediaryContainers []string   `json:"intermediary_containers"`
 	SlackNotificationURL   string     `json:"slack_notification_url"`
+	LogSinks               []LogSink  `json:"log_sinks,omitempty"`
 }
 
 // GitOptions represents GitHub-related deployment options
 type GitOptions struct {
 	RemoteURL string `json:"remote"`
 	Branch    string `json:"branch"`
+	Commit    string `json:"commit,omitempty"`
 }
 
 // UserRequest is used for logging in or modifying users
@@ -51,5 +96,133 @@ type EnvRequest struct {
 	Value   string `json:"value,omitempty"`
 	Encrypt bool   `json:"encrypt,omitempty"`
 
+	// Profile and Container restrict the variable to deployments using the
+	// given profile and to the given container or service respectively
+	Profile   string `json:"profile,omitempty"`
+	Container string `json:"container,omitempty"`
+
 	Remove bool `json:"remove,omitempty"`
 }
+
+// SecretRequest represents a request to manage secret files
+type SecretRequest struct {
+	Name  string `json:"name,omitempty"`
+	Value []byte `json:"value,omitempty"`
+
+	Remove bool `json:"remove,omitempty"`
+}
+
+// EnvTransferRequest represents a request to export or import environment
+// variables, encrypted using the given passphrase
+type EnvTransferRequest struct {
+	Passphrase string `json:"passphrase"`
+	Data       []byte `json:"data,omitempty"`
+}
+
+const (
+	// BackupStorageLocal stores backups on the remote's disk
+	BackupStorageLocal = "local"
+	// BackupStorageS3 stores backups in an S3-compatible bucket
+	BackupStorageS3 = "s3"
+)
+
+// BackupConfiguration configures backups of the project's persistent data
+type BackupConfiguration struct {
+	// Storage is where backups are stored - either BackupStorageLocal or
+	// BackupStorageS3. Defaults to BackupStorageLocal.
+	Storage string           `json:"storage,omitempty"`
+	S3      *S3Configuration `json:"s3,omitempty"`
+
+	// Passphrase, if set, is used to encrypt backups
+	Passphrase string `json:"passphrase,omitempty"`
+
+	// Schedule is the interval between automatic backups, such as "24h". No
+	// backups are made automatically if unset.
+	Schedule string `json:"schedule,omitempty"`
+
+	// Keep is the number of most recent backups to retain, and MaxAge is the
+	// age after which backups are removed, such as "720h". Backups are retained
+	// indefinitely if unset.
+	Keep   int    `json:"keep,omitempty"`
+	MaxAge string `json:"max_age,omitempty"`
+}
+
+// S3Configuration denotes how to access an S3-compatible bucket
+type S3Configuration struct {
+	Endpoint        string `json:"endpoint,omitempty"`
+	Region          string `json:"region,omitempty"`
+	Bucket          string `json:"bucket"`
+	Prefix          string `json:"prefix,omitempty"`
+	AccessKeyID     string `json:"access_key_id,omitempty"`
+	SecretAccessKey string `json:"secret_access_key,omitempty"`
+
+	// PathStyle enables path-style addressing, which is required by most
+	// S3-compatible services such as MinIO
+	PathStyle bool `json:"path_style,omitempty"`
+}
+
+// BackupRequest represents a request to manage a backup
+type BackupRequest struct {
+	Name string `json:"name"`
+}
+
+// MetricsConfiguration configures access to the daemon's metrics endpoint.
+// Scrapers must either provide ScrapeToken as a bearer token, or connect from
+// one of AllowedNetworks.
+type MetricsConfiguration struct {
+	ScrapeToken string `json:"scrape_token,omitempty"`
+
+	// AllowedNetworks is a list of IP addresses or CIDR ranges
+	AllowedNetworks []string `json:"allowed_networks,omitempty"`
+}
+
+// DiskConfiguration configures how the daemon responds to low disk space on
+// the filesystems it builds and stores data on
+type DiskConfiguration struct {
+	// MinimumFree is the amount of free space below which unused Docker assets
+	// are pruned, warnings are sent, and builds are refused - either a size,
+	// such as "2GiB", or a percentage of the filesystem, such as "10%"
+	MinimumFree string `json:"minimum_free,omitempty"`
+
+	// Disabled turns off disk space monitoring
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// Supported types of log sinks
+const (
+	LogSinkSyslog = "syslog"
+	LogSinkHTTP   = "http"
+	LogSinkLoki   = "loki"
+)
+
+// LogSink configures an external service that container and daemon logs are
+// forwarded to
+type LogSink struct {
+	// Type is one of "syslog", "http", or "loki"
+	Type string `json:"type"`
+
+	// URL is where logs are sent - "tcp://host:port" or "udp://host:port" for
+	// syslog, or an HTTP(S) endpoint for the other types
+	URL string `json:"url"`
+
+	// Headers are added to requests made to HTTP and Loki sinks
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Labels are attached to every entry sent to HTTP and Loki sinks
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// LogSinkConfiguration configures where logs are forwarded to
+type LogSinkConfiguration struct {
+	// Labels are attached to entries sent to every sink, such as the project
+	// and profile that the sinks were configured by
+	Labels map[string]string `json:"labels,omitempty"`
+
+	Sinks []LogSink `json:"sinks,omitempty"`
+}
+
+// MigrationRequest represents a request to export daemon state, encrypted with
+// the given passphrase
+type MigrationRequest struct {
+	Passphrase string `json:"passphrase"`
+}
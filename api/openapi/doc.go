@@ -0,0 +1,4 @@
+// Package openapi provides the OpenAPI document describing the Inertia daemon
+// API, which the daemon serves and which handlers and clients are checked
+// against in tests
+package openapi
@@ -0,0 +1,159 @@
+// Code generated by fileb0x at "2026-10-19 11:07:38.108788512 +0000 UTC m=+0.002134844" from config file "b0x.yml" DO NOT EDIT.
+// modification hash(222736a736923feb3935b90d5404c901.5fdb4761fa1c69eb8de9f2c16b20a8da)
+
+package internal
+
+import (
+	"bytes"
+
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path"
+
+	"golang.org/x/net/webdav"
+)
+
+var (
+	// CTX is a context for webdav vfs
+	CTX = context.Background()
+
+	// FS is a virtual memory file system
+	FS = webdav.NewMemFS()
+
+	// Handler is used to server files through a http handler
+	Handler *webdav.Handler
+
+	// HTTP is the http file system
+	HTTP http.FileSystem = new(HTTPFS)
+)
+
+// HTTPFS implements http.FileSystem
+type HTTPFS struct {
+	// Prefix allows to limit the path of all requests. F.e. a prefix "css" would allow only calls to /css/*
+	Prefix string
+}
+
+// FileOpenapiYaml is "openapi.yaml"
+var FileOpenapiYaml = []byte("\x6f\x70\x65\x6e\x61\x70\x69\x3a\x20\x33\x2e\x30\x2e\x33\x0a\x69\x6e\x66\x6f\x3a\x0a\x20\x20\x74\x69\x74\x6c\x65\x3a\x20\x49\x6e\x65\x72\x74\x69\x61\x20\x44\x61\x65\x6d\x6f\x6e\x20\x41\x50\x49\x0a\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x7c\x0a\x20\x20\x20\x20\x54\x68\x65\x20\x41\x50\x49\x20\x73\x65\x72\x76\x65\x64\x20\x62\x79\x20\x69\x6e\x65\x72\x74\x69\x61\x64\x2c\x20\x74\x68\x65\x20\x49\x6e\x65\x72\x74\x69\x61\x20\x64\x61\x65\x6d\x6f\x6e\x2e\x20\x41\x6c\x6c\x20\x65\x6e\x64\x70\x6f\x69\x6e\x74\x73\x20\x61\x72\x65\x20\x73\x65\x72\x76\x65\x64\x0a\x20\x20\x20\x20\x75\x6e\x64\x65\x72\x20\x74\x68\x65\x20\x41\x50\x49\x20\x76\x65\x72\x73\x69\x6f\x6e\x20\x70\x72\x65\x66\x69\x78\x2c\x20\x61\x6e\x64\x20\x72\x65\x71\x75\x65\x73\x74\x73\x20\x66\x6f\x72\x20\x75\x6e\x73\x75\x70\x70\x6f\x72\x74\x65\x64\x20\x76\x65\x72\x73\x69\x6f\x6e\x73\x20\x61\x72\x65\x0a\x20\x20\x20\x20\x72\x65\x6a\x65\x63\x74\x65\x64\x2e\x20\x45\x76\x65\x72\x79\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x20\x63\x61\x72\x72\x69\x65\x73\x20\x74\x68\x65\x20\x58\x2d\x49\x6e\x65\x72\x74\x69\x61\x2d\x44\x61\x65\x6d\x6f\x6e\x2d\x56\x65\x72\x73\x69\x6f\x6e\x20\x61\x6e\x64\x0a\x20\x20\x20\x20\x58\x2d\x49\x6e\x65\x72\x74\x69\x61\x2d\x41\x50\x49\x2d\x56\x65\x72\x73\x69\x6f\x6e\x20\x68\x65\x61\x64\x65\x72\x73\x2e\x0a\x0a\x20\x20\x20\x20\x45\x6e\x64\x70\x6f\x69\x6e\x74\x73\x20\x61\x72\x65\x20\x61\x6e\x6e\x6f\x74\x61\x74\x65\x64\x20\x77\x69\x74\x68\x20\x22\x78\x2d\x61\x63\x63\x65\x73\x73\x22\x2c\x20\x77\x68\x69\x63\x68\x20\x69\x73\x20\x6f\x6e\x65\x20\x6f\x66\x20\x22\x70\x75\x62\x6c\x69\x63\x22\x2c\x20\x22\x75\x73\x65\x72\x22\x0a\x20\x20\x20\x20\x28\x72\x65\x71\x75\x69\x72\x65\x73\x20\x61\x20\x75\x73\x65\x72\x20\x73\x65\x73\x73\x69\x6f\x6e\x20\x6f\x72\x20\x41\x50\x49\x20\x74\x6f\x6b\x65\x6e\x29\x2c\x20\x22\x61\x64\x6d\x69\x6e\x22\x20\x28\x72\x65\x71\x75\x69\x72\x65\x73\x20\x61\x6e\x20\x61\x64\x6d\x69\x6e\x69\x73\x74\x72\x61\x74\x6f\x72\x29\x2c\x0a\x20\x20\x20\x20\x6f\x72\x20\x22\x73\x63\x72\x61\x70\x65\x72\x22\x20\x28\x72\x65\x71\x75\x69\x72\x65\x73\x20\x74\x68\x65\x20\x6d\x65\x74\x72\x69\x63\x73\x20\x73\x63\x72\x61\x70\x65\x20\x74\x6f\x6b\x65\x6e\x20\x6f\x72\x20\x61\x6e\x20\x61\x6c\x6c\x6f\x77\x65\x64\x20\x61\x64\x64\x72\x65\x73\x73\x29\x2e\x0a\x20\x20\x76\x65\x72\x73\x69\x6f\x6e\x3a\x20\x76\x31\x0a\x73\x65\x72\x76\x65\x72\x73\x3a\x0a\x20\x20\x2d\x20\x75\x72\x6c\x3a\x20\x2f\x76\x31\x0a\x0a\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x3a\x0a\x20\x20\x73\x65\x63\x75\x72\x69\x74\x79\x53\x63\x68\x65\x6d\x65\x73\x3a\x0a\x20\x20\x20\x20\x74\x6f\x6b\x65\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x68\x74\x74\x70\x0a\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x65\x3a\x20\x62\x65\x61\x72\x65\x72\x0a\x20\x20\x20\x20\x23\x20\x73\x65\x74\x20\x77\x68\x65\x6e\x20\x6c\x6f\x67\x67\x69\x6e\x67\x20\x69\x6e\x2c\x20\x66\x6f\x72\x20\x62\x72\x6f\x77\x73\x65\x72\x20\x63\x6c\x69\x65\x6e\x74\x73\x20\x73\x75\x63\x68\x20\x61\x73\x20\x74\x68\x65\x20\x64\x61\x73\x68\x62\x6f\x61\x72\x64\x0a\x20\x20\x20\x20\x73\x65\x73\x73\x69\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x61\x70\x69\x4b\x65\x79\x0a\x20\x20\x20\x20\x20\x20\x69\x6e\x3a\x20\x63\x6f\x6f\x6b\x69\x65\x0a\x20\x20\x20\x20\x20\x20\x6e\x61\x6d\x65\x3a\x20\x69\x6e\x65\x72\x74\x69\x61\x5f\x73\x65\x73\x73\x69\x6f\x6e\x0a\x0a\x20\x20\x73\x63\x68\x65\x6d\x61\x73\x3a\x0a\x20\x20\x20\x20\x23\x20\x45\x76\x65\x72\x79\x20\x4a\x53\x4f\x4e\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x20\x69\x73\x20\x77\x72\x61\x70\x70\x65\x64\x20\x69\x6e\x20\x61\x20\x52\x65\x73\x70\x6f\x6e\x73\x65\x2e\x20\x45\x6e\x64\x70\x6f\x69\x6e\x74\x73\x20\x74\x68\x61\x74\x20\x72\x65\x74\x75\x72\x6e\x20\x64\x61\x74\x61\x0a\x20\x20\x20\x20\x23\x20\x6c\x69\x73\x74\x20\x74\x68\x65\x20\x6b\x65\x79\x73\x20\x74\x68\x65\x79\x20\x70\x6f\x70\x75\x6c\x61\x74\x65\x20\x69\x6e\x20\x22\x64\x61\x74\x61\x22\x2e\x0a\x20\x20\x20\x20\x52\x65\x73\x70\x6f\x6e\x73\x65\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x67\x6f\x2d\x74\x79\x70\x65\x3a\x20\x42\x61\x73\x65\x52\x65\x73\x70\x6f\x6e\x73\x65\x0a\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x64\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x67\x65\x72\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x71\x75\x65\x73\x74\x5f\x69\x64\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6d\x65\x73\x73\x61\x67\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x72\x72\x6f\x72\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x7d\x0a\x0a\x20\x20\x20\x20\x55\x70\x52\x65\x71\x75\x65\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x67\x6f\x2d\x74\x79\x70\x65\x3a\x20\x55\x70\x52\x65\x71\x75\x65\x73\x74\x0a\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x73\x74\x72\x65\x61\x6d\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x62\x6f\x6f\x6c\x65\x61\x6e\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x6a\x65\x63\x74\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x66\x69\x6c\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x62\x75\x69\x6c\x64\x5f\x74\x79\x70\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x62\x75\x69\x6c\x64\x5f\x66\x69\x6c\x65\x5f\x70\x61\x74\x68\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x67\x69\x74\x5f\x6f\x70\x74\x69\x6f\x6e\x73\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x47\x69\x74\x4f\x70\x74\x69\x6f\x6e\x73\x27\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x77\x65\x62\x68\x6f\x6f\x6b\x5f\x73\x65\x63\x72\x65\x74\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x6e\x74\x65\x72\x6d\x65\x64\x69\x61\x72\x79\x5f\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x61\x72\x72\x61\x79\x2c\x20\x6e\x75\x6c\x6c\x61\x62\x6c\x65\x3a\x20\x74\x72\x75\x65\x2c\x20\x69\x74\x65\x6d\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x73\x6c\x61\x63\x6b\x5f\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x5f\x75\x72\x6c\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6c\x6f\x67\x5f\x73\x69\x6e\x6b\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x61\x72\x72\x61\x79\x2c\x20\x69\x74\x65\x6d\x73\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x4c\x6f\x67\x53\x69\x6e\x6b\x27\x7d\x7d\x0a\x20\x20\x20\x20\x47\x69\x74\x4f\x70\x74\x69\x6f\x6e\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x67\x6f\x2d\x74\x79\x70\x65\x3a\x20\x47\x69\x74\x4f\x70\x74\x69\x6f\x6e\x73\x0a\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x6d\x6f\x74\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x62\x72\x61\x6e\x63\x68\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6d\x6d\x69\x74\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x4c\x6f\x67\x53\x69\x6e\x6b\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x67\x6f\x2d\x74\x79\x70\x65\x3a\x20\x4c\x6f\x67\x53\x69\x6e\x6b\x0a\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x65\x6e\x75\x6d\x3a\x20\x5b\x73\x79\x73\x6c\x6f\x67\x2c\x20\x68\x74\x74\x70\x2c\x20\x6c\x6f\x6b\x69\x5d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x75\x72\x6c\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x65\x61\x64\x65\x72\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x2c\x20\x61\x64\x64\x69\x74\x69\x6f\x6e\x61\x6c\x50\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6c\x61\x62\x65\x6c\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x2c\x20\x61\x64\x64\x69\x74\x69\x6f\x6e\x61\x6c\x50\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x7d\x0a\x20\x20\x20\x20\x55\x73\x65\x72\x52\x65\x71\x75\x65\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x67\x6f\x2d\x74\x79\x70\x65\x3a\x20\x55\x73\x65\x72\x52\x65\x71\x75\x65\x73\x74\x0a\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x75\x73\x65\x72\x6e\x61\x6d\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x70\x61\x73\x73\x77\x6f\x72\x64\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x6d\x61\x69\x6c\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x61\x64\x6d\x69\x6e\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x62\x6f\x6f\x6c\x65\x61\x6e\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x6f\x74\x70\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x45\x6e\x76\x52\x65\x71\x75\x65\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x67\x6f\x2d\x74\x79\x70\x65\x3a\x20\x45\x6e\x76\x52\x65\x71\x75\x65\x73\x74\x0a\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6e\x61\x6d\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x6c\x75\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x6e\x63\x72\x79\x70\x74\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x62\x6f\x6f\x6c\x65\x61\x6e\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x66\x69\x6c\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x6d\x6f\x76\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x62\x6f\x6f\x6c\x65\x61\x6e\x7d\x0a\x20\x20\x20\x20\x53\x65\x63\x72\x65\x74\x52\x65\x71\x75\x65\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x67\x6f\x2d\x74\x79\x70\x65\x3a\x20\x53\x65\x63\x72\x65\x74\x52\x65\x71\x75\x65\x73\x74\x0a\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6e\x61\x6d\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x6c\x75\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x66\x6f\x72\x6d\x61\x74\x3a\x20\x62\x79\x74\x65\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x6d\x6f\x76\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x62\x6f\x6f\x6c\x65\x61\x6e\x7d\x0a\x20\x20\x20\x20\x45\x6e\x76\x54\x72\x61\x6e\x73\x66\x65\x72\x52\x65\x71\x75\x65\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x67\x6f\x2d\x74\x79\x70\x65\x3a\x20\x45\x6e\x76\x54\x72\x61\x6e\x73\x66\x65\x72\x52\x65\x71\x75\x65\x73\x74\x0a\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x70\x61\x73\x73\x70\x68\x72\x61\x73\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x66\x6f\x72\x6d\x61\x74\x3a\x20\x62\x79\x74\x65\x7d\x0a\x20\x20\x20\x20\x42\x61\x63\x6b\x75\x70\x43\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x67\x6f\x2d\x74\x79\x70\x65\x3a\x20\x42\x61\x63\x6b\x75\x70\x43\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x0a\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x73\x74\x6f\x72\x61\x67\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x65\x6e\x75\x6d\x3a\x20\x5b\x6c\x6f\x63\x61\x6c\x2c\x20\x73\x33\x5d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x73\x33\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x53\x33\x43\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x27\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x70\x61\x73\x73\x70\x68\x72\x61\x73\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x64\x75\x6c\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6b\x65\x65\x70\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x67\x65\x72\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6d\x61\x78\x5f\x61\x67\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x53\x33\x43\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x67\x6f\x2d\x74\x79\x70\x65\x3a\x20\x53\x33\x43\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x0a\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x6e\x64\x70\x6f\x69\x6e\x74\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x67\x69\x6f\x6e\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x62\x75\x63\x6b\x65\x74\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x65\x66\x69\x78\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x61\x63\x63\x65\x73\x73\x5f\x6b\x65\x79\x5f\x69\x64\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x73\x65\x63\x72\x65\x74\x5f\x61\x63\x63\x65\x73\x73\x5f\x6b\x65\x79\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x70\x61\x74\x68\x5f\x73\x74\x79\x6c\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x62\x6f\x6f\x6c\x65\x61\x6e\x7d\x0a\x20\x20\x20\x20\x42\x61\x63\x6b\x75\x70\x52\x65\x71\x75\x65\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x67\x6f\x2d\x74\x79\x70\x65\x3a\x20\x42\x61\x63\x6b\x75\x70\x52\x65\x71\x75\x65\x73\x74\x0a\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6e\x61\x6d\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x4d\x65\x74\x72\x69\x63\x73\x43\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x67\x6f\x2d\x74\x79\x70\x65\x3a\x20\x4d\x65\x74\x72\x69\x63\x73\x43\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x0a\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x72\x61\x70\x65\x5f\x74\x6f\x6b\x65\x6e\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x61\x6c\x6c\x6f\x77\x65\x64\x5f\x6e\x65\x74\x77\x6f\x72\x6b\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x61\x72\x72\x61\x79\x2c\x20\x69\x74\x65\x6d\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x7d\x0a\x20\x20\x20\x20\x44\x69\x73\x6b\x43\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x67\x6f\x2d\x74\x79\x70\x65\x3a\x20\x44\x69\x73\x6b\x43\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x0a\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6d\x69\x6e\x69\x6d\x75\x6d\x5f\x66\x72\x65\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x64\x69\x73\x61\x62\x6c\x65\x64\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x62\x6f\x6f\x6c\x65\x61\x6e\x7d\x0a\x20\x20\x20\x20\x4d\x69\x67\x72\x61\x74\x69\x6f\x6e\x52\x65\x71\x75\x65\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x67\x6f\x2d\x74\x79\x70\x65\x3a\x20\x4d\x69\x67\x72\x61\x74\x69\x6f\x6e\x52\x65\x71\x75\x65\x73\x74\x0a\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x70\x61\x73\x73\x70\x68\x72\x61\x73\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x0a\x20\x20\x20\x20\x54\x6f\x74\x70\x52\x65\x73\x70\x6f\x6e\x73\x65\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x67\x6f\x2d\x74\x79\x70\x65\x3a\x20\x54\x6f\x74\x70\x52\x65\x73\x70\x6f\x6e\x73\x65\x0a\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x73\x65\x63\x72\x65\x74\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x62\x61\x63\x6b\x75\x70\x5f\x63\x6f\x64\x65\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x61\x72\x72\x61\x79\x2c\x20\x6e\x75\x6c\x6c\x61\x62\x6c\x65\x3a\x20\x74\x72\x75\x65\x2c\x20\x69\x74\x65\x6d\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x7d\x0a\x20\x20\x20\x20\x44\x65\x70\x6c\x6f\x79\x6d\x65\x6e\x74\x53\x74\x61\x74\x75\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x67\x6f\x2d\x74\x79\x70\x65\x3a\x20\x44\x65\x70\x6c\x6f\x79\x6d\x65\x6e\x74\x53\x74\x61\x74\x75\x73\x0a\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x62\x72\x61\x6e\x63\x68\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6d\x6d\x69\x74\x5f\x68\x61\x73\x68\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6d\x6d\x69\x74\x5f\x6d\x65\x73\x73\x61\x67\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x62\x75\x69\x6c\x64\x5f\x74\x79\x70\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x61\x72\x72\x61\x79\x2c\x20\x6e\x75\x6c\x6c\x61\x62\x6c\x65\x3a\x20\x74\x72\x75\x65\x2c\x20\x69\x74\x65\x6d\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x62\x75\x69\x6c\x64\x5f\x61\x63\x74\x69\x76\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x62\x6f\x6f\x6c\x65\x61\x6e\x7d\x0a\x20\x20\x20\x20\x44\x65\x70\x6c\x6f\x79\x6d\x65\x6e\x74\x53\x74\x61\x74\x75\x73\x57\x69\x74\x68\x56\x65\x72\x73\x69\x6f\x6e\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x67\x6f\x2d\x74\x79\x70\x65\x3a\x20\x44\x65\x70\x6c\x6f\x79\x6d\x65\x6e\x74\x53\x74\x61\x74\x75\x73\x57\x69\x74\x68\x56\x65\x72\x73\x69\x6f\x6e\x73\x0a\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x62\x72\x61\x6e\x63\x68\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6d\x6d\x69\x74\x5f\x68\x61\x73\x68\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6d\x6d\x69\x74\x5f\x6d\x65\x73\x73\x61\x67\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x62\x75\x69\x6c\x64\x5f\x74\x79\x70\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x61\x72\x72\x61\x79\x2c\x20\x6e\x75\x6c\x6c\x61\x62\x6c\x65\x3a\x20\x74\x72\x75\x65\x2c\x20\x69\x74\x65\x6d\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x62\x75\x69\x6c\x64\x5f\x61\x63\x74\x69\x76\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x62\x6f\x6f\x6c\x65\x61\x6e\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x65\x72\x73\x69\x6f\x6e\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6e\x65\x77\x5f\x76\x65\x72\x73\x69\x6f\x6e\x5f\x61\x76\x61\x69\x6c\x61\x62\x6c\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x6e\x75\x6c\x6c\x61\x62\x6c\x65\x3a\x20\x74\x72\x75\x65\x7d\x0a\x20\x20\x20\x20\x4c\x6f\x67\x45\x6e\x74\x72\x79\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x67\x6f\x2d\x74\x79\x70\x65\x3a\x20\x4c\x6f\x67\x45\x6e\x74\x72\x79\x0a\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x69\x6d\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x66\x6f\x72\x6d\x61\x74\x3a\x20\x64\x61\x74\x65\x2d\x74\x69\x6d\x65\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x73\x74\x72\x65\x61\x6d\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x65\x6e\x75\x6d\x3a\x20\x5b\x73\x74\x64\x6f\x75\x74\x2c\x20\x73\x74\x64\x65\x72\x72\x5d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6d\x65\x73\x73\x61\x67\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x45\x76\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x67\x6f\x2d\x74\x79\x70\x65\x3a\x20\x45\x76\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x64\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x67\x65\x72\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x65\x6e\x75\x6d\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x77\x65\x62\x68\x6f\x6f\x6b\x2e\x72\x65\x63\x65\x69\x76\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x77\x65\x62\x68\x6f\x6f\x6b\x2e\x69\x67\x6e\x6f\x72\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x62\x75\x69\x6c\x64\x2e\x73\x74\x61\x72\x74\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x62\x75\x69\x6c\x64\x2e\x66\x69\x6e\x69\x73\x68\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x2e\x73\x74\x61\x72\x74\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x2e\x64\x69\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x65\x6e\x76\x2e\x63\x68\x61\x6e\x67\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x75\x73\x65\x72\x2e\x6c\x6f\x67\x67\x65\x64\x5f\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x69\x6d\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x66\x6f\x72\x6d\x61\x74\x3a\x20\x64\x61\x74\x65\x2d\x74\x69\x6d\x65\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6d\x65\x73\x73\x61\x67\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x2c\x20\x61\x64\x64\x69\x74\x69\x6f\x6e\x61\x6c\x50\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x7d\x0a\x20\x20\x20\x20\x44\x65\x70\x6c\x6f\x79\x6d\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x67\x6f\x2d\x74\x79\x70\x65\x3a\x20\x44\x65\x70\x6c\x6f\x79\x6d\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x62\x72\x61\x6e\x63\x68\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6d\x6d\x69\x74\x5f\x68\x61\x73\x68\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x70\x6c\x6f\x79\x65\x64\x5f\x61\x74\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x66\x6f\x72\x6d\x61\x74\x3a\x20\x64\x61\x74\x65\x2d\x74\x69\x6d\x65\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x5f\x73\x74\x61\x74\x75\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x68\x61\x6e\x67\x65\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x61\x72\x72\x61\x79\x2c\x20\x69\x74\x65\x6d\x73\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x43\x6f\x6d\x6d\x69\x74\x27\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x68\x61\x6e\x67\x65\x73\x5f\x74\x72\x75\x6e\x63\x61\x74\x65\x64\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x62\x6f\x6f\x6c\x65\x61\x6e\x7d\x0a\x20\x20\x20\x20\x43\x6f\x6d\x6d\x69\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x67\x6f\x2d\x74\x79\x70\x65\x3a\x20\x43\x6f\x6d\x6d\x69\x74\x0a\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x61\x73\x68\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x61\x75\x74\x68\x6f\x72\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6d\x65\x73\x73\x61\x67\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x69\x6d\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x66\x6f\x72\x6d\x61\x74\x3a\x20\x64\x61\x74\x65\x2d\x74\x69\x6d\x65\x7d\x0a\x20\x20\x20\x20\x44\x65\x70\x6c\x6f\x79\x6d\x65\x6e\x74\x50\x6c\x61\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x67\x6f\x2d\x74\x79\x70\x65\x3a\x20\x44\x65\x70\x6c\x6f\x79\x6d\x65\x6e\x74\x50\x6c\x61\x6e\x0a\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x75\x72\x72\x65\x6e\x74\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x43\x6f\x6d\x6d\x69\x74\x27\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x61\x72\x67\x65\x74\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x43\x6f\x6d\x6d\x69\x74\x27\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6d\x6d\x69\x74\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x61\x72\x72\x61\x79\x2c\x20\x6e\x75\x6c\x6c\x61\x62\x6c\x65\x3a\x20\x74\x72\x75\x65\x2c\x20\x69\x74\x65\x6d\x73\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x43\x6f\x6d\x6d\x69\x74\x27\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6d\x6d\x69\x74\x73\x5f\x74\x72\x75\x6e\x63\x61\x74\x65\x64\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x62\x6f\x6f\x6c\x65\x61\x6e\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x73\x65\x74\x74\x69\x6e\x67\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x61\x72\x72\x61\x79\x2c\x20\x6e\x75\x6c\x6c\x61\x62\x6c\x65\x3a\x20\x74\x72\x75\x65\x2c\x20\x69\x74\x65\x6d\x73\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x50\x6c\x61\x6e\x53\x65\x74\x74\x69\x6e\x67\x27\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x6e\x76\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x61\x72\x72\x61\x79\x2c\x20\x6e\x75\x6c\x6c\x61\x62\x6c\x65\x3a\x20\x74\x72\x75\x65\x2c\x20\x69\x74\x65\x6d\x73\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x45\x6e\x76\x43\x68\x61\x6e\x67\x65\x27\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x61\x72\x72\x61\x79\x2c\x20\x6e\x75\x6c\x6c\x61\x62\x6c\x65\x3a\x20\x74\x72\x75\x65\x2c\x20\x69\x74\x65\x6d\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x7d\x0a\x20\x20\x20\x20\x50\x6c\x61\x6e\x53\x65\x74\x74\x69\x6e\x67\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x67\x6f\x2d\x74\x79\x70\x65\x3a\x20\x50\x6c\x61\x6e\x53\x65\x74\x74\x69\x6e\x67\x0a\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6e\x61\x6d\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x75\x72\x72\x65\x6e\x74\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x70\x6c\x61\x6e\x6e\x65\x64\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x45\x6e\x76\x43\x68\x61\x6e\x67\x65\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x67\x6f\x2d\x74\x79\x70\x65\x3a\x20\x45\x6e\x76\x43\x68\x61\x6e\x67\x65\x0a\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6e\x61\x6d\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x68\x61\x6e\x67\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x65\x6e\x75\x6d\x3a\x20\x5b\x61\x64\x64\x65\x64\x2c\x20\x63\x68\x61\x6e\x67\x65\x64\x2c\x20\x72\x65\x6d\x6f\x76\x65\x64\x5d\x7d\x0a\x20\x20\x20\x20\x45\x6e\x76\x56\x61\x72\x69\x61\x62\x6c\x65\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x67\x6f\x2d\x74\x79\x70\x65\x3a\x20\x45\x6e\x76\x56\x61\x72\x69\x61\x62\x6c\x65\x0a\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6e\x61\x6d\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x6c\x75\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x6e\x63\x72\x79\x70\x74\x65\x64\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x62\x6f\x6f\x6c\x65\x61\x6e\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x66\x69\x6c\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x42\x61\x63\x6b\x75\x70\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x67\x6f\x2d\x74\x79\x70\x65\x3a\x20\x42\x61\x63\x6b\x75\x70\x0a\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6e\x61\x6d\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x73\x69\x7a\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x67\x65\x72\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x72\x65\x61\x74\x65\x64\x5f\x61\x74\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x66\x6f\x72\x6d\x61\x74\x3a\x20\x64\x61\x74\x65\x2d\x74\x69\x6d\x65\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x65\x6e\x63\x72\x79\x70\x74\x65\x64\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x62\x6f\x6f\x6c\x65\x61\x6e\x7d\x0a\x20\x20\x20\x20\x53\x74\x61\x74\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x67\x6f\x2d\x74\x79\x70\x65\x3a\x20\x53\x74\x61\x74\x73\x0a\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x69\x6d\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x66\x6f\x72\x6d\x61\x74\x3a\x20\x64\x61\x74\x65\x2d\x74\x69\x6d\x65\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x6f\x73\x74\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x48\x6f\x73\x74\x53\x74\x61\x74\x73\x27\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x61\x72\x72\x61\x79\x2c\x20\x6e\x75\x6c\x6c\x61\x62\x6c\x65\x3a\x20\x74\x72\x75\x65\x2c\x20\x69\x74\x65\x6d\x73\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x43\x6f\x6e\x74\x61\x69\x6e\x65\x72\x53\x74\x61\x74\x73\x27\x7d\x7d\x0a\x20\x20\x20\x20\x48\x6f\x73\x74\x53\x74\x61\x74\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x67\x6f\x2d\x74\x79\x70\x65\x3a\x20\x48\x6f\x73\x74\x53\x74\x61\x74\x73\x0a\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6d\x65\x6d\x6f\x72\x79\x5f\x74\x6f\x74\x61\x6c\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x67\x65\x72\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6d\x65\x6d\x6f\x72\x79\x5f\x61\x76\x61\x69\x6c\x61\x62\x6c\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x67\x65\x72\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x64\x69\x73\x6b\x5f\x70\x61\x74\x68\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x64\x69\x73\x6b\x5f\x74\x6f\x74\x61\x6c\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x67\x65\x72\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x64\x69\x73\x6b\x5f\x66\x72\x65\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x67\x65\x72\x7d\x0a\x20\x20\x20\x20\x43\x6f\x6e\x74\x61\x69\x6e\x65\x72\x53\x74\x61\x74\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x67\x6f\x2d\x74\x79\x70\x65\x3a\x20\x43\x6f\x6e\x74\x61\x69\x6e\x65\x72\x53\x74\x61\x74\x73\x0a\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6e\x61\x6d\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x70\x75\x5f\x70\x65\x72\x63\x65\x6e\x74\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x6e\x75\x6d\x62\x65\x72\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6d\x65\x6d\x6f\x72\x79\x5f\x75\x73\x61\x67\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x67\x65\x72\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6d\x65\x6d\x6f\x72\x79\x5f\x6c\x69\x6d\x69\x74\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x67\x65\x72\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6e\x65\x74\x77\x6f\x72\x6b\x5f\x72\x78\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x67\x65\x72\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6e\x65\x74\x77\x6f\x72\x6b\x5f\x74\x78\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x67\x65\x72\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x62\x6c\x6f\x63\x6b\x5f\x72\x65\x61\x64\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x67\x65\x72\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x62\x6c\x6f\x63\x6b\x5f\x77\x72\x69\x74\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x67\x65\x72\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x70\x69\x64\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x67\x65\x72\x7d\x0a\x20\x20\x20\x20\x4d\x69\x67\x72\x61\x74\x69\x6f\x6e\x4d\x61\x6e\x69\x66\x65\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x6f\x62\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x67\x6f\x2d\x74\x79\x70\x65\x3a\x20\x4d\x69\x67\x72\x61\x74\x69\x6f\x6e\x4d\x61\x6e\x69\x66\x65\x73\x74\x0a\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x65\x72\x73\x69\x6f\x6e\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x67\x65\x72\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x65\x6d\x6f\x6e\x5f\x76\x65\x72\x73\x69\x6f\x6e\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x72\x65\x61\x74\x65\x64\x5f\x61\x74\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x66\x6f\x72\x6d\x61\x74\x3a\x20\x64\x61\x74\x65\x2d\x74\x69\x6d\x65\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x70\x6c\x6f\x79\x6d\x65\x6e\x74\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x44\x65\x70\x6c\x6f\x79\x6d\x65\x6e\x74\x53\x74\x61\x74\x75\x73\x27\x7d\x0a\x0a\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x4f\x4b\x3a\x0a\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x72\x65\x71\x75\x65\x73\x74\x20\x73\x75\x63\x63\x65\x65\x64\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x52\x65\x73\x70\x6f\x6e\x73\x65\x27\x7d\x0a\x20\x20\x20\x20\x53\x74\x72\x65\x61\x6d\x3a\x0a\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x7c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x71\x75\x65\x73\x74\x20\x73\x75\x63\x63\x65\x65\x64\x65\x64\x20\x2d\x20\x69\x66\x20\x73\x74\x72\x65\x61\x6d\x69\x6e\x67\x20\x77\x61\x73\x20\x72\x65\x71\x75\x65\x73\x74\x65\x64\x2c\x20\x6f\x75\x74\x70\x75\x74\x20\x69\x73\x20\x77\x72\x69\x74\x74\x65\x6e\x20\x61\x73\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x70\x6c\x61\x69\x6e\x20\x74\x65\x78\x74\x20\x61\x73\x20\x69\x74\x20\x68\x61\x70\x70\x65\x6e\x73\x2c\x20\x66\x6f\x6c\x6c\x6f\x77\x65\x64\x20\x62\x79\x20\x61\x20\x66\x69\x6e\x61\x6c\x20\x52\x65\x73\x70\x6f\x6e\x73\x65\x0a\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x65\x78\x74\x2f\x70\x6c\x61\x69\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x52\x65\x73\x70\x6f\x6e\x73\x65\x27\x7d\x0a\x0a\x73\x65\x63\x75\x72\x69\x74\x79\x3a\x0a\x20\x20\x2d\x20\x74\x6f\x6b\x65\x6e\x3a\x20\x5b\x5d\x0a\x20\x20\x2d\x20\x73\x65\x73\x73\x69\x6f\x6e\x3a\x20\x5b\x5d\x0a\x0a\x70\x61\x74\x68\x73\x3a\x0a\x20\x20\x2f\x3a\x0a\x20\x20\x20\x20\x73\x65\x72\x76\x65\x72\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x2d\x20\x75\x72\x6c\x3a\x20\x2f\x0a\x20\x20\x20\x20\x67\x65\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x70\x69\x6e\x67\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x43\x68\x65\x63\x6b\x20\x74\x68\x61\x74\x20\x74\x68\x65\x20\x64\x61\x65\x6d\x6f\x6e\x20\x69\x73\x20\x72\x65\x61\x63\x68\x61\x62\x6c\x65\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x70\x75\x62\x6c\x69\x63\x0a\x20\x20\x20\x20\x20\x20\x73\x65\x63\x75\x72\x69\x74\x79\x3a\x20\x5b\x5d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x20\x7b\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x64\x61\x65\x6d\x6f\x6e\x20\x69\x73\x20\x72\x65\x61\x63\x68\x61\x62\x6c\x65\x7d\x0a\x0a\x20\x20\x2f\x77\x65\x62\x68\x6f\x6f\x6b\x3a\x0a\x20\x20\x20\x20\x73\x65\x72\x76\x65\x72\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x2d\x20\x75\x72\x6c\x3a\x20\x2f\x0a\x20\x20\x20\x20\x70\x6f\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x77\x65\x62\x68\x6f\x6f\x6b\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x52\x65\x63\x65\x69\x76\x65\x20\x77\x65\x62\x68\x6f\x6f\x6b\x73\x20\x66\x72\x6f\x6d\x20\x47\x69\x74\x48\x75\x62\x2c\x20\x47\x69\x74\x4c\x61\x62\x2c\x20\x6f\x72\x20\x42\x69\x74\x62\x75\x63\x6b\x65\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x70\x75\x62\x6c\x69\x63\x0a\x20\x20\x20\x20\x20\x20\x73\x65\x63\x75\x72\x69\x74\x79\x3a\x20\x5b\x5d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x32\x27\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x2f\x4f\x4b\x27\x7d\x0a\x0a\x20\x20\x2f\x6d\x65\x74\x72\x69\x63\x73\x3a\x0a\x20\x20\x20\x20\x73\x65\x72\x76\x65\x72\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x2d\x20\x75\x72\x6c\x3a\x20\x2f\x0a\x20\x20\x20\x20\x67\x65\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x6d\x65\x74\x72\x69\x63\x73\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x45\x78\x70\x6f\x72\x74\x20\x6d\x65\x74\x72\x69\x63\x73\x20\x69\x6e\x20\x74\x68\x65\x20\x50\x72\x6f\x6d\x65\x74\x68\x65\x75\x73\x20\x74\x65\x78\x74\x20\x66\x6f\x72\x6d\x61\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x73\x63\x72\x61\x70\x65\x72\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x6d\x65\x74\x72\x69\x63\x73\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x74\x65\x78\x74\x2f\x70\x6c\x61\x69\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x0a\x20\x20\x2f\x64\x61\x73\x68\x62\x6f\x61\x72\x64\x3a\x0a\x20\x20\x20\x20\x73\x65\x72\x76\x65\x72\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x2d\x20\x75\x72\x6c\x3a\x20\x2f\x0a\x20\x20\x20\x20\x67\x65\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x64\x61\x73\x68\x62\x6f\x61\x72\x64\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x53\x65\x72\x76\x65\x20\x74\x68\x65\x20\x77\x65\x62\x20\x64\x61\x73\x68\x62\x6f\x61\x72\x64\x0a\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x7c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x54\x68\x65\x20\x64\x61\x73\x68\x62\x6f\x61\x72\x64\x20\x69\x73\x20\x61\x20\x73\x69\x6e\x67\x6c\x65\x2d\x70\x61\x67\x65\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x20\x73\x65\x72\x76\x65\x64\x20\x75\x6e\x64\x65\x72\x20\x2f\x64\x61\x73\x68\x62\x6f\x61\x72\x64\x2f\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x77\x68\x69\x63\x68\x20\x75\x73\x65\x73\x20\x74\x68\x69\x73\x20\x41\x50\x49\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x73\x65\x73\x73\x69\x6f\x6e\x20\x63\x6f\x6f\x6b\x69\x65\x20\x73\x65\x74\x20\x77\x68\x65\x6e\x20\x6c\x6f\x67\x67\x69\x6e\x67\x20\x69\x6e\x2e\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x70\x75\x62\x6c\x69\x63\x0a\x20\x20\x20\x20\x20\x20\x73\x65\x63\x75\x72\x69\x74\x79\x3a\x20\x5b\x5d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x64\x61\x73\x68\x62\x6f\x61\x72\x64\x20\x70\x61\x67\x65\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x74\x65\x78\x74\x2f\x68\x74\x6d\x6c\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x0a\x20\x20\x2f\x6f\x70\x65\x6e\x61\x70\x69\x2e\x79\x61\x6d\x6c\x3a\x0a\x20\x20\x20\x20\x67\x65\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x67\x65\x74\x53\x70\x65\x63\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x52\x65\x74\x72\x69\x65\x76\x65\x20\x74\x68\x69\x73\x20\x64\x6f\x63\x75\x6d\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x70\x75\x62\x6c\x69\x63\x0a\x20\x20\x20\x20\x20\x20\x73\x65\x63\x75\x72\x69\x74\x79\x3a\x20\x5b\x5d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x74\x68\x65\x20\x4f\x70\x65\x6e\x41\x50\x49\x20\x64\x6f\x63\x75\x6d\x65\x6e\x74\x20\x64\x65\x73\x63\x72\x69\x62\x69\x6e\x67\x20\x74\x68\x69\x73\x20\x41\x50\x49\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x79\x61\x6d\x6c\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x0a\x20\x20\x2f\x74\x6f\x6b\x65\x6e\x3a\x0a\x20\x20\x20\x20\x67\x65\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x63\x72\x65\x61\x74\x65\x54\x6f\x6b\x65\x6e\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x47\x65\x6e\x65\x72\x61\x74\x65\x20\x61\x6e\x20\x41\x50\x49\x20\x74\x6f\x6b\x65\x6e\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x61\x64\x6d\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x74\x6f\x6b\x65\x6e\x20\x67\x65\x6e\x65\x72\x61\x74\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x6c\x6c\x4f\x66\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x52\x65\x73\x70\x6f\x6e\x73\x65\x27\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x74\x6f\x6b\x65\x6e\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x0a\x20\x20\x2f\x73\x74\x61\x74\x75\x73\x3a\x0a\x20\x20\x20\x20\x67\x65\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x67\x65\x74\x53\x74\x61\x74\x75\x73\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x52\x65\x74\x72\x69\x65\x76\x65\x20\x74\x68\x65\x20\x73\x74\x61\x74\x75\x73\x20\x6f\x66\x20\x74\x68\x65\x20\x64\x65\x70\x6c\x6f\x79\x6d\x65\x6e\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x75\x73\x65\x72\x0a\x20\x20\x20\x20\x20\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x7b\x6e\x61\x6d\x65\x3a\x20\x62\x61\x64\x67\x65\x2c\x20\x69\x6e\x3a\x20\x71\x75\x65\x72\x79\x2c\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x62\x6f\x6f\x6c\x65\x61\x6e\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x73\x74\x61\x74\x75\x73\x20\x72\x65\x74\x72\x69\x65\x76\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x6c\x6c\x4f\x66\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x52\x65\x73\x70\x6f\x6e\x73\x65\x27\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x74\x61\x74\x75\x73\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x44\x65\x70\x6c\x6f\x79\x6d\x65\x6e\x74\x53\x74\x61\x74\x75\x73\x57\x69\x74\x68\x56\x65\x72\x73\x69\x6f\x6e\x73\x27\x7d\x0a\x0a\x20\x20\x2f\x6c\x6f\x67\x73\x3a\x0a\x20\x20\x20\x20\x67\x65\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x67\x65\x74\x4c\x6f\x67\x73\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x52\x65\x74\x72\x69\x65\x76\x65\x20\x6f\x72\x20\x73\x74\x72\x65\x61\x6d\x20\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x20\x6c\x6f\x67\x73\x0a\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x7c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x49\x66\x20\x73\x74\x72\x65\x61\x6d\x20\x69\x73\x20\x73\x65\x74\x2c\x20\x74\x68\x65\x20\x63\x6f\x6e\x6e\x65\x63\x74\x69\x6f\x6e\x20\x69\x73\x20\x75\x70\x67\x72\x61\x64\x65\x64\x20\x74\x6f\x20\x61\x20\x77\x65\x62\x73\x6f\x63\x6b\x65\x74\x20\x61\x6e\x64\x20\x65\x61\x63\x68\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6c\x6f\x67\x20\x65\x6e\x74\x72\x79\x20\x69\x73\x20\x73\x65\x6e\x74\x20\x61\x73\x20\x61\x20\x4a\x53\x4f\x4e\x2d\x65\x6e\x63\x6f\x64\x65\x64\x20\x4c\x6f\x67\x45\x6e\x74\x72\x79\x2e\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x75\x73\x65\x72\x0a\x20\x20\x20\x20\x20\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x7b\x6e\x61\x6d\x65\x3a\x20\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x2c\x20\x69\x6e\x3a\x20\x71\x75\x65\x72\x79\x2c\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x2c\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x63\x6f\x6d\x6d\x61\x2d\x73\x65\x70\x61\x72\x61\x74\x65\x64\x20\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x20\x6e\x61\x6d\x65\x73\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x7b\x6e\x61\x6d\x65\x3a\x20\x61\x6c\x6c\x2c\x20\x69\x6e\x3a\x20\x71\x75\x65\x72\x79\x2c\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x62\x6f\x6f\x6c\x65\x61\x6e\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x7b\x6e\x61\x6d\x65\x3a\x20\x73\x74\x72\x65\x61\x6d\x2c\x20\x69\x6e\x3a\x20\x71\x75\x65\x72\x79\x2c\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x62\x6f\x6f\x6c\x65\x61\x6e\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x7b\x6e\x61\x6d\x65\x3a\x20\x65\x6e\x74\x72\x69\x65\x73\x2c\x20\x69\x6e\x3a\x20\x71\x75\x65\x72\x79\x2c\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x67\x65\x72\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x7b\x6e\x61\x6d\x65\x3a\x20\x73\x69\x6e\x63\x65\x2c\x20\x69\x6e\x3a\x20\x71\x75\x65\x72\x79\x2c\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x7b\x6e\x61\x6d\x65\x3a\x20\x75\x6e\x74\x69\x6c\x2c\x20\x69\x6e\x3a\x20\x71\x75\x65\x72\x79\x2c\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x7b\x6e\x61\x6d\x65\x3a\x20\x67\x72\x65\x70\x2c\x20\x69\x6e\x3a\x20\x71\x75\x65\x72\x79\x2c\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x7b\x6e\x61\x6d\x65\x3a\x20\x6c\x65\x76\x65\x6c\x2c\x20\x69\x6e\x3a\x20\x71\x75\x65\x72\x79\x2c\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x65\x6e\x75\x6d\x3a\x20\x5b\x64\x65\x62\x75\x67\x2c\x20\x69\x6e\x66\x6f\x2c\x20\x77\x61\x72\x6e\x2c\x20\x65\x72\x72\x6f\x72\x5d\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x6c\x6f\x67\x73\x20\x72\x65\x74\x72\x69\x65\x76\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x6c\x6c\x4f\x66\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x52\x65\x73\x70\x6f\x6e\x73\x65\x27\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x6c\x6f\x67\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x61\x72\x72\x61\x79\x2c\x20\x69\x74\x65\x6d\x73\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x4c\x6f\x67\x45\x6e\x74\x72\x79\x27\x7d\x7d\x0a\x0a\x20\x20\x2f\x73\x74\x61\x74\x73\x3a\x0a\x20\x20\x20\x20\x67\x65\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x67\x65\x74\x53\x74\x61\x74\x73\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x52\x65\x74\x72\x69\x65\x76\x65\x20\x6f\x72\x20\x73\x74\x72\x65\x61\x6d\x20\x72\x65\x73\x6f\x75\x72\x63\x65\x20\x75\x73\x61\x67\x65\x0a\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x7c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x49\x66\x20\x73\x74\x72\x65\x61\x6d\x20\x69\x73\x20\x73\x65\x74\x2c\x20\x74\x68\x65\x20\x63\x6f\x6e\x6e\x65\x63\x74\x69\x6f\x6e\x20\x69\x73\x20\x75\x70\x67\x72\x61\x64\x65\x64\x20\x74\x6f\x20\x61\x20\x77\x65\x62\x73\x6f\x63\x6b\x65\x74\x20\x61\x6e\x64\x20\x61\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x4a\x53\x4f\x4e\x2d\x65\x6e\x63\x6f\x64\x65\x64\x20\x53\x74\x61\x74\x73\x20\x69\x73\x20\x73\x65\x6e\x74\x20\x65\x76\x65\x72\x79\x20\x69\x6e\x74\x65\x72\x76\x61\x6c\x2e\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x75\x73\x65\x72\x0a\x20\x20\x20\x20\x20\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x7b\x6e\x61\x6d\x65\x3a\x20\x73\x74\x72\x65\x61\x6d\x2c\x20\x69\x6e\x3a\x20\x71\x75\x65\x72\x79\x2c\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x62\x6f\x6f\x6c\x65\x61\x6e\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x7b\x6e\x61\x6d\x65\x3a\x20\x69\x6e\x74\x65\x72\x76\x61\x6c\x2c\x20\x69\x6e\x3a\x20\x71\x75\x65\x72\x79\x2c\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x67\x65\x72\x7d\x2c\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x73\x65\x63\x6f\x6e\x64\x73\x7d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x73\x74\x61\x74\x73\x20\x72\x65\x74\x72\x69\x65\x76\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x6c\x6c\x4f\x66\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x52\x65\x73\x70\x6f\x6e\x73\x65\x27\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x74\x61\x74\x73\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x53\x74\x61\x74\x73\x27\x7d\x0a\x0a\x20\x20\x2f\x65\x76\x65\x6e\x74\x73\x3a\x0a\x20\x20\x20\x20\x67\x65\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x67\x65\x74\x45\x76\x65\x6e\x74\x73\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x53\x74\x72\x65\x61\x6d\x20\x64\x61\x65\x6d\x6f\x6e\x20\x61\x6e\x64\x20\x64\x65\x70\x6c\x6f\x79\x6d\x65\x6e\x74\x20\x65\x76\x65\x6e\x74\x73\x0a\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x7c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x45\x76\x65\x6e\x74\x73\x20\x61\x72\x65\x20\x73\x65\x6e\x74\x20\x61\x73\x20\x73\x65\x72\x76\x65\x72\x2d\x73\x65\x6e\x74\x20\x65\x76\x65\x6e\x74\x73\x2c\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x65\x76\x65\x6e\x74\x27\x73\x20\x49\x44\x20\x61\x6e\x64\x20\x74\x79\x70\x65\x20\x61\x73\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x68\x65\x20\x53\x53\x45\x20\x69\x64\x20\x61\x6e\x64\x20\x65\x76\x65\x6e\x74\x20\x66\x69\x65\x6c\x64\x73\x20\x61\x6e\x64\x20\x61\x20\x4a\x53\x4f\x4e\x2d\x65\x6e\x63\x6f\x64\x65\x64\x20\x45\x76\x65\x6e\x74\x20\x61\x73\x20\x64\x61\x74\x61\x2e\x20\x50\x72\x6f\x76\x69\x64\x69\x6e\x67\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x68\x65\x20\x49\x44\x20\x6f\x66\x20\x74\x68\x65\x20\x6c\x61\x73\x74\x20\x65\x76\x65\x6e\x74\x20\x72\x65\x63\x65\x69\x76\x65\x64\x20\x69\x6e\x20\x74\x68\x65\x20\x4c\x61\x73\x74\x2d\x45\x76\x65\x6e\x74\x2d\x49\x44\x20\x68\x65\x61\x64\x65\x72\x20\x72\x65\x70\x6c\x61\x79\x73\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x63\x65\x6e\x74\x20\x65\x76\x65\x6e\x74\x73\x20\x65\x6d\x69\x74\x74\x65\x64\x20\x61\x66\x74\x65\x72\x20\x69\x74\x2e\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x75\x73\x65\x72\x0a\x20\x20\x20\x20\x20\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x7b\x6e\x61\x6d\x65\x3a\x20\x74\x79\x70\x65\x2c\x20\x69\x6e\x3a\x20\x71\x75\x65\x72\x79\x2c\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x2c\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x63\x6f\x6d\x6d\x61\x2d\x73\x65\x70\x61\x72\x61\x74\x65\x64\x20\x65\x76\x65\x6e\x74\x20\x74\x79\x70\x65\x73\x20\x6f\x72\x20\x70\x72\x65\x66\x69\x78\x65\x73\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x7b\x6e\x61\x6d\x65\x3a\x20\x4c\x61\x73\x74\x2d\x45\x76\x65\x6e\x74\x2d\x49\x44\x2c\x20\x69\x6e\x3a\x20\x68\x65\x61\x64\x65\x72\x2c\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x67\x65\x72\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x65\x76\x65\x6e\x74\x20\x73\x74\x72\x65\x61\x6d\x20\x6f\x70\x65\x6e\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x74\x65\x78\x74\x2f\x65\x76\x65\x6e\x74\x2d\x73\x74\x72\x65\x61\x6d\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x0a\x20\x20\x2f\x68\x69\x73\x74\x6f\x72\x79\x3a\x0a\x20\x20\x20\x20\x67\x65\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x67\x65\x74\x48\x69\x73\x74\x6f\x72\x79\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x4c\x69\x73\x74\x20\x70\x72\x65\x76\x69\x6f\x75\x73\x20\x64\x65\x70\x6c\x6f\x79\x6d\x65\x6e\x74\x73\x20\x6f\x66\x20\x74\x68\x65\x20\x70\x72\x6f\x6a\x65\x63\x74\x2c\x20\x6d\x6f\x73\x74\x20\x72\x65\x63\x65\x6e\x74\x20\x66\x69\x72\x73\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x75\x73\x65\x72\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x64\x65\x70\x6c\x6f\x79\x6d\x65\x6e\x74\x20\x68\x69\x73\x74\x6f\x72\x79\x20\x72\x65\x74\x72\x69\x65\x76\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x6c\x6c\x4f\x66\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x52\x65\x73\x70\x6f\x6e\x73\x65\x27\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x68\x69\x73\x74\x6f\x72\x79\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x74\x79\x70\x65\x3a\x20\x61\x72\x72\x61\x79\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x74\x65\x6d\x73\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x44\x65\x70\x6c\x6f\x79\x6d\x65\x6e\x74\x27\x7d\x0a\x0a\x20\x20\x2f\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x73\x2f\x61\x64\x64\x72\x65\x73\x73\x3a\x0a\x20\x20\x20\x20\x67\x65\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x67\x65\x74\x43\x6f\x6e\x74\x61\x69\x6e\x65\x72\x41\x64\x64\x72\x65\x73\x73\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x52\x65\x73\x6f\x6c\x76\x65\x20\x74\x68\x65\x20\x61\x64\x64\x72\x65\x73\x73\x20\x6f\x66\x20\x61\x20\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x20\x6f\x6e\x20\x74\x68\x65\x20\x72\x65\x6d\x6f\x74\x65\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x61\x64\x6d\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x7b\x6e\x61\x6d\x65\x3a\x20\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x2c\x20\x69\x6e\x3a\x20\x71\x75\x65\x72\x79\x2c\x20\x72\x65\x71\x75\x69\x72\x65\x64\x3a\x20\x74\x72\x75\x65\x2c\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x20\x61\x64\x64\x72\x65\x73\x73\x20\x72\x65\x73\x6f\x6c\x76\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x6c\x6c\x4f\x66\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x52\x65\x73\x70\x6f\x6e\x73\x65\x27\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x64\x64\x72\x65\x73\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x0a\x20\x20\x2f\x70\x6c\x61\x6e\x3a\x0a\x20\x20\x20\x20\x70\x6f\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x70\x6c\x61\x6e\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x44\x65\x73\x63\x72\x69\x62\x65\x20\x77\x68\x61\x74\x20\x64\x65\x70\x6c\x6f\x79\x69\x6e\x67\x20\x74\x68\x65\x20\x70\x72\x6f\x6a\x65\x63\x74\x20\x77\x6f\x75\x6c\x64\x20\x63\x68\x61\x6e\x67\x65\x2c\x20\x77\x69\x74\x68\x6f\x75\x74\x20\x64\x65\x70\x6c\x6f\x79\x69\x6e\x67\x20\x69\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x61\x64\x6d\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x71\x75\x65\x73\x74\x42\x6f\x64\x79\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x55\x70\x52\x65\x71\x75\x65\x73\x74\x27\x7d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x64\x65\x70\x6c\x6f\x79\x6d\x65\x6e\x74\x20\x70\x6c\x61\x6e\x6e\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x6c\x6c\x4f\x66\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x52\x65\x73\x70\x6f\x6e\x73\x65\x27\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x6c\x61\x6e\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x44\x65\x70\x6c\x6f\x79\x6d\x65\x6e\x74\x50\x6c\x61\x6e\x27\x7d\x0a\x0a\x20\x20\x2f\x75\x70\x3a\x0a\x20\x20\x20\x20\x70\x6f\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x75\x70\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x44\x65\x70\x6c\x6f\x79\x20\x74\x68\x65\x20\x70\x72\x6f\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x61\x64\x6d\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x71\x75\x65\x73\x74\x42\x6f\x64\x79\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x55\x70\x52\x65\x71\x75\x65\x73\x74\x27\x7d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x31\x27\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x2f\x53\x74\x72\x65\x61\x6d\x27\x7d\x0a\x0a\x20\x20\x2f\x72\x65\x64\x65\x70\x6c\x6f\x79\x3a\x0a\x20\x20\x20\x20\x70\x6f\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x72\x65\x64\x65\x70\x6c\x6f\x79\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x52\x65\x62\x75\x69\x6c\x64\x20\x61\x6e\x64\x20\x72\x65\x73\x74\x61\x72\x74\x20\x74\x68\x65\x20\x70\x72\x6f\x6a\x65\x63\x74\x20\x77\x69\x74\x68\x20\x69\x74\x73\x20\x63\x75\x72\x72\x65\x6e\x74\x20\x63\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x61\x64\x6d\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x7b\x6e\x61\x6d\x65\x3a\x20\x73\x74\x72\x65\x61\x6d\x2c\x20\x69\x6e\x3a\x20\x71\x75\x65\x72\x79\x2c\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x62\x6f\x6f\x6c\x65\x61\x6e\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x31\x27\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x2f\x53\x74\x72\x65\x61\x6d\x27\x7d\x0a\x0a\x20\x20\x2f\x64\x6f\x77\x6e\x3a\x0a\x20\x20\x20\x20\x70\x6f\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x64\x6f\x77\x6e\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x53\x68\x75\x74\x20\x64\x6f\x77\x6e\x20\x74\x68\x65\x20\x70\x72\x6f\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x61\x64\x6d\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x2f\x4f\x4b\x27\x7d\x0a\x0a\x20\x20\x2f\x72\x65\x73\x65\x74\x3a\x0a\x20\x20\x20\x20\x70\x6f\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x72\x65\x73\x65\x74\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x53\x68\x75\x74\x20\x64\x6f\x77\x6e\x20\x61\x6e\x64\x20\x72\x65\x6d\x6f\x76\x65\x20\x74\x68\x65\x20\x70\x72\x6f\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x61\x64\x6d\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x2f\x4f\x4b\x27\x7d\x0a\x0a\x20\x20\x2f\x70\x72\x75\x6e\x65\x3a\x0a\x20\x20\x20\x20\x70\x6f\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x70\x72\x75\x6e\x65\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x52\x65\x6d\x6f\x76\x65\x20\x75\x6e\x75\x73\x65\x64\x20\x44\x6f\x63\x6b\x65\x72\x20\x61\x73\x73\x65\x74\x73\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x61\x64\x6d\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x2f\x4f\x4b\x27\x7d\x0a\x0a\x20\x20\x2f\x65\x6e\x76\x3a\x0a\x20\x20\x20\x20\x67\x65\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x6c\x69\x73\x74\x45\x6e\x76\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x4c\x69\x73\x74\x20\x63\x6f\x6e\x66\x69\x67\x75\x72\x65\x64\x20\x65\x6e\x76\x69\x72\x6f\x6e\x6d\x65\x6e\x74\x20\x76\x61\x72\x69\x61\x62\x6c\x65\x73\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x61\x64\x6d\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x63\x6f\x6e\x66\x69\x67\x75\x72\x65\x64\x20\x65\x6e\x76\x69\x72\x6f\x6e\x6d\x65\x6e\x74\x20\x76\x61\x72\x69\x61\x62\x6c\x65\x73\x20\x72\x65\x74\x72\x69\x65\x76\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x6c\x6c\x4f\x66\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x52\x65\x73\x70\x6f\x6e\x73\x65\x27\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x69\x61\x62\x6c\x65\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x61\x72\x72\x61\x79\x2c\x20\x69\x74\x65\x6d\x73\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x45\x6e\x76\x56\x61\x72\x69\x61\x62\x6c\x65\x27\x7d\x7d\x0a\x20\x20\x20\x20\x70\x6f\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x75\x70\x64\x61\x74\x65\x45\x6e\x76\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x53\x65\x74\x20\x6f\x72\x20\x72\x65\x6d\x6f\x76\x65\x20\x61\x6e\x20\x65\x6e\x76\x69\x72\x6f\x6e\x6d\x65\x6e\x74\x20\x76\x61\x72\x69\x61\x62\x6c\x65\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x61\x64\x6d\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x71\x75\x65\x73\x74\x42\x6f\x64\x79\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x45\x6e\x76\x52\x65\x71\x75\x65\x73\x74\x27\x7d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x32\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x65\x6e\x76\x69\x72\x6f\x6e\x6d\x65\x6e\x74\x20\x76\x61\x72\x69\x61\x62\x6c\x65\x20\x75\x70\x64\x61\x74\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x6c\x6c\x4f\x66\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x52\x65\x73\x70\x6f\x6e\x73\x65\x27\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x69\x61\x62\x6c\x65\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x0a\x20\x20\x2f\x65\x6e\x76\x2f\x65\x78\x70\x6f\x72\x74\x3a\x0a\x20\x20\x20\x20\x70\x6f\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x65\x78\x70\x6f\x72\x74\x45\x6e\x76\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x45\x78\x70\x6f\x72\x74\x20\x65\x6e\x76\x69\x72\x6f\x6e\x6d\x65\x6e\x74\x20\x76\x61\x72\x69\x61\x62\x6c\x65\x73\x2c\x20\x65\x6e\x63\x72\x79\x70\x74\x65\x64\x20\x77\x69\x74\x68\x20\x61\x20\x70\x61\x73\x73\x70\x68\x72\x61\x73\x65\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x61\x64\x6d\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x71\x75\x65\x73\x74\x42\x6f\x64\x79\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x45\x6e\x76\x54\x72\x61\x6e\x73\x66\x65\x72\x52\x65\x71\x75\x65\x73\x74\x27\x7d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x65\x6e\x76\x69\x72\x6f\x6e\x6d\x65\x6e\x74\x20\x76\x61\x72\x69\x61\x62\x6c\x65\x73\x20\x65\x78\x70\x6f\x72\x74\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x6c\x6c\x4f\x66\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x52\x65\x73\x70\x6f\x6e\x73\x65\x27\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x66\x6f\x72\x6d\x61\x74\x3a\x20\x62\x79\x74\x65\x7d\x0a\x0a\x20\x20\x2f\x65\x6e\x76\x2f\x69\x6d\x70\x6f\x72\x74\x3a\x0a\x20\x20\x20\x20\x70\x6f\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x69\x6d\x70\x6f\x72\x74\x45\x6e\x76\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x49\x6d\x70\x6f\x72\x74\x20\x65\x6e\x76\x69\x72\x6f\x6e\x6d\x65\x6e\x74\x20\x76\x61\x72\x69\x61\x62\x6c\x65\x73\x20\x65\x78\x70\x6f\x72\x74\x65\x64\x20\x62\x79\x20\x65\x78\x70\x6f\x72\x74\x45\x6e\x76\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x61\x64\x6d\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x71\x75\x65\x73\x74\x42\x6f\x64\x79\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x45\x6e\x76\x54\x72\x61\x6e\x73\x66\x65\x72\x52\x65\x71\x75\x65\x73\x74\x27\x7d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x32\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x65\x6e\x76\x69\x72\x6f\x6e\x6d\x65\x6e\x74\x20\x76\x61\x72\x69\x61\x62\x6c\x65\x73\x20\x69\x6d\x70\x6f\x72\x74\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x6c\x6c\x4f\x66\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x52\x65\x73\x70\x6f\x6e\x73\x65\x27\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x75\x6e\x74\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x67\x65\x72\x7d\x0a\x0a\x20\x20\x2f\x65\x6e\x76\x2f\x72\x6f\x74\x61\x74\x65\x2d\x6b\x65\x79\x3a\x0a\x20\x20\x20\x20\x70\x6f\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x72\x6f\x74\x61\x74\x65\x45\x6e\x76\x4b\x65\x79\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x52\x65\x2d\x65\x6e\x63\x72\x79\x70\x74\x20\x65\x6e\x76\x69\x72\x6f\x6e\x6d\x65\x6e\x74\x20\x76\x61\x72\x69\x61\x62\x6c\x65\x73\x20\x77\x69\x74\x68\x20\x61\x20\x6e\x65\x77\x20\x6b\x65\x79\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x61\x64\x6d\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x2f\x4f\x4b\x27\x7d\x0a\x0a\x20\x20\x2f\x73\x65\x63\x72\x65\x74\x73\x3a\x0a\x20\x20\x20\x20\x67\x65\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x6c\x69\x73\x74\x53\x65\x63\x72\x65\x74\x73\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x4c\x69\x73\x74\x20\x63\x6f\x6e\x66\x69\x67\x75\x72\x65\x64\x20\x73\x65\x63\x72\x65\x74\x20\x66\x69\x6c\x65\x73\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x61\x64\x6d\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x63\x6f\x6e\x66\x69\x67\x75\x72\x65\x64\x20\x73\x65\x63\x72\x65\x74\x73\x20\x72\x65\x74\x72\x69\x65\x76\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x6c\x6c\x4f\x66\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x52\x65\x73\x70\x6f\x6e\x73\x65\x27\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x65\x63\x72\x65\x74\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x61\x72\x72\x61\x79\x2c\x20\x69\x74\x65\x6d\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x7d\x0a\x20\x20\x20\x20\x70\x6f\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x75\x70\x64\x61\x74\x65\x53\x65\x63\x72\x65\x74\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x53\x65\x74\x20\x6f\x72\x20\x72\x65\x6d\x6f\x76\x65\x20\x61\x20\x73\x65\x63\x72\x65\x74\x20\x66\x69\x6c\x65\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x61\x64\x6d\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x71\x75\x65\x73\x74\x42\x6f\x64\x79\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x53\x65\x63\x72\x65\x74\x52\x65\x71\x75\x65\x73\x74\x27\x7d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x32\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x73\x65\x63\x72\x65\x74\x20\x75\x70\x64\x61\x74\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x6c\x6c\x4f\x66\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x52\x65\x73\x70\x6f\x6e\x73\x65\x27\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x65\x63\x72\x65\x74\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x0a\x20\x20\x2f\x62\x61\x63\x6b\x75\x70\x73\x3a\x0a\x20\x20\x20\x20\x67\x65\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x6c\x69\x73\x74\x42\x61\x63\x6b\x75\x70\x73\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x4c\x69\x73\x74\x20\x62\x61\x63\x6b\x75\x70\x73\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x61\x64\x6d\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x62\x61\x63\x6b\x75\x70\x73\x20\x72\x65\x74\x72\x69\x65\x76\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x6c\x6c\x4f\x66\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x52\x65\x73\x70\x6f\x6e\x73\x65\x27\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x62\x61\x63\x6b\x75\x70\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x61\x72\x72\x61\x79\x2c\x20\x69\x74\x65\x6d\x73\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x42\x61\x63\x6b\x75\x70\x27\x7d\x7d\x0a\x20\x20\x20\x20\x70\x6f\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x63\x72\x65\x61\x74\x65\x42\x61\x63\x6b\x75\x70\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x42\x61\x63\x6b\x20\x75\x70\x20\x74\x68\x65\x20\x70\x72\x6f\x6a\x65\x63\x74\x27\x73\x20\x70\x65\x72\x73\x69\x73\x74\x65\x6e\x74\x20\x64\x61\x74\x61\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x61\x64\x6d\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x31\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x62\x61\x63\x6b\x75\x70\x20\x63\x72\x65\x61\x74\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x6c\x6c\x4f\x66\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x52\x65\x73\x70\x6f\x6e\x73\x65\x27\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x62\x61\x63\x6b\x75\x70\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x42\x61\x63\x6b\x75\x70\x27\x7d\x0a\x0a\x20\x20\x2f\x62\x61\x63\x6b\x75\x70\x73\x2f\x72\x65\x73\x74\x6f\x72\x65\x3a\x0a\x20\x20\x20\x20\x70\x6f\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x72\x65\x73\x74\x6f\x72\x65\x42\x61\x63\x6b\x75\x70\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x52\x65\x73\x74\x6f\x72\x65\x20\x74\x68\x65\x20\x70\x72\x6f\x6a\x65\x63\x74\x27\x73\x20\x70\x65\x72\x73\x69\x73\x74\x65\x6e\x74\x20\x64\x61\x74\x61\x20\x66\x72\x6f\x6d\x20\x61\x20\x62\x61\x63\x6b\x75\x70\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x61\x64\x6d\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x71\x75\x65\x73\x74\x42\x6f\x64\x79\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x42\x61\x63\x6b\x75\x70\x52\x65\x71\x75\x65\x73\x74\x27\x7d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x62\x61\x63\x6b\x75\x70\x20\x72\x65\x73\x74\x6f\x72\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x6c\x6c\x4f\x66\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x52\x65\x73\x70\x6f\x6e\x73\x65\x27\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x62\x61\x63\x6b\x75\x70\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x0a\x20\x20\x2f\x62\x61\x63\x6b\x75\x70\x73\x2f\x63\x6f\x6e\x66\x69\x67\x3a\x0a\x20\x20\x20\x20\x67\x65\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x67\x65\x74\x42\x61\x63\x6b\x75\x70\x43\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x52\x65\x74\x72\x69\x65\x76\x65\x20\x74\x68\x65\x20\x62\x61\x63\x6b\x75\x70\x20\x63\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x2c\x20\x77\x69\x74\x68\x20\x63\x72\x65\x64\x65\x6e\x74\x69\x61\x6c\x73\x20\x72\x65\x64\x61\x63\x74\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x61\x64\x6d\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x62\x61\x63\x6b\x75\x70\x20\x63\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x20\x72\x65\x74\x72\x69\x65\x76\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x6c\x6c\x4f\x66\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x52\x65\x73\x70\x6f\x6e\x73\x65\x27\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x66\x69\x67\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x42\x61\x63\x6b\x75\x70\x43\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x27\x7d\x0a\x20\x20\x20\x20\x70\x6f\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x75\x70\x64\x61\x74\x65\x42\x61\x63\x6b\x75\x70\x43\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x55\x70\x64\x61\x74\x65\x20\x74\x68\x65\x20\x62\x61\x63\x6b\x75\x70\x20\x63\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x61\x64\x6d\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x71\x75\x65\x73\x74\x42\x6f\x64\x79\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x42\x61\x63\x6b\x75\x70\x43\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x27\x7d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x2f\x4f\x4b\x27\x7d\x0a\x0a\x20\x20\x2f\x6d\x69\x67\x72\x61\x74\x65\x2f\x65\x78\x70\x6f\x72\x74\x3a\x0a\x20\x20\x20\x20\x70\x6f\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x65\x78\x70\x6f\x72\x74\x53\x74\x61\x74\x65\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x45\x78\x70\x6f\x72\x74\x20\x64\x61\x65\x6d\x6f\x6e\x20\x73\x74\x61\x74\x65\x20\x61\x73\x20\x61\x20\x62\x75\x6e\x64\x6c\x65\x2c\x20\x65\x6e\x63\x72\x79\x70\x74\x65\x64\x20\x77\x69\x74\x68\x20\x61\x20\x70\x61\x73\x73\x70\x68\x72\x61\x73\x65\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x61\x64\x6d\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x71\x75\x65\x73\x74\x42\x6f\x64\x79\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x4d\x69\x67\x72\x61\x74\x69\x6f\x6e\x52\x65\x71\x75\x65\x73\x74\x27\x7d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x74\x68\x65\x20\x65\x6e\x63\x72\x79\x70\x74\x65\x64\x20\x62\x75\x6e\x64\x6c\x65\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6f\x63\x74\x65\x74\x2d\x73\x74\x72\x65\x61\x6d\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x66\x6f\x72\x6d\x61\x74\x3a\x20\x62\x69\x6e\x61\x72\x79\x7d\x0a\x0a\x20\x20\x2f\x6d\x69\x67\x72\x61\x74\x65\x2f\x69\x6d\x70\x6f\x72\x74\x3a\x0a\x20\x20\x20\x20\x70\x6f\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x69\x6d\x70\x6f\x72\x74\x53\x74\x61\x74\x65\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x49\x6d\x70\x6f\x72\x74\x20\x61\x20\x62\x75\x6e\x64\x6c\x65\x20\x65\x78\x70\x6f\x72\x74\x65\x64\x20\x62\x79\x20\x65\x78\x70\x6f\x72\x74\x53\x74\x61\x74\x65\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x61\x64\x6d\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x70\x61\x72\x61\x6d\x65\x74\x65\x72\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x7b\x6e\x61\x6d\x65\x3a\x20\x58\x2d\x49\x6e\x65\x72\x74\x69\x61\x2d\x4d\x69\x67\x72\x61\x74\x69\x6f\x6e\x2d\x50\x61\x73\x73\x70\x68\x72\x61\x73\x65\x2c\x20\x69\x6e\x3a\x20\x68\x65\x61\x64\x65\x72\x2c\x20\x72\x65\x71\x75\x69\x72\x65\x64\x3a\x20\x74\x72\x75\x65\x2c\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x7d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x71\x75\x65\x73\x74\x42\x6f\x64\x79\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6f\x63\x74\x65\x74\x2d\x73\x74\x72\x65\x61\x6d\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x2c\x20\x66\x6f\x72\x6d\x61\x74\x3a\x20\x62\x69\x6e\x61\x72\x79\x7d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x73\x74\x61\x74\x65\x20\x69\x6d\x70\x6f\x72\x74\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x6c\x6c\x4f\x66\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x52\x65\x73\x70\x6f\x6e\x73\x65\x27\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x6d\x61\x6e\x69\x66\x65\x73\x74\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x4d\x69\x67\x72\x61\x74\x69\x6f\x6e\x4d\x61\x6e\x69\x66\x65\x73\x74\x27\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x75\x73\x65\x72\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x69\x6e\x74\x65\x67\x65\x72\x7d\x0a\x0a\x20\x20\x2f\x6d\x65\x74\x72\x69\x63\x73\x2f\x63\x6f\x6e\x66\x69\x67\x3a\x0a\x20\x20\x20\x20\x67\x65\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x67\x65\x74\x4d\x65\x74\x72\x69\x63\x73\x43\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x52\x65\x74\x72\x69\x65\x76\x65\x20\x74\x68\x65\x20\x6d\x65\x74\x72\x69\x63\x73\x20\x63\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x2c\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x73\x63\x72\x61\x70\x65\x20\x74\x6f\x6b\x65\x6e\x20\x72\x65\x64\x61\x63\x74\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x61\x64\x6d\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x6d\x65\x74\x72\x69\x63\x73\x20\x63\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x20\x72\x65\x74\x72\x69\x65\x76\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x6c\x6c\x4f\x66\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x52\x65\x73\x70\x6f\x6e\x73\x65\x27\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x66\x69\x67\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x4d\x65\x74\x72\x69\x63\x73\x43\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x27\x7d\x0a\x20\x20\x20\x20\x70\x6f\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x75\x70\x64\x61\x74\x65\x4d\x65\x74\x72\x69\x63\x73\x43\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x55\x70\x64\x61\x74\x65\x20\x74\x68\x65\x20\x6d\x65\x74\x72\x69\x63\x73\x20\x63\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x61\x64\x6d\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x71\x75\x65\x73\x74\x42\x6f\x64\x79\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x4d\x65\x74\x72\x69\x63\x73\x43\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x27\x7d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x2f\x4f\x4b\x27\x7d\x0a\x0a\x20\x20\x2f\x64\x69\x73\x6b\x2f\x63\x6f\x6e\x66\x69\x67\x3a\x0a\x20\x20\x20\x20\x67\x65\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x67\x65\x74\x44\x69\x73\x6b\x43\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x52\x65\x74\x72\x69\x65\x76\x65\x20\x74\x68\x65\x20\x64\x69\x73\x6b\x20\x73\x70\x61\x63\x65\x20\x6d\x6f\x6e\x69\x74\x6f\x72\x69\x6e\x67\x20\x63\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x61\x64\x6d\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x64\x69\x73\x6b\x20\x63\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x20\x72\x65\x74\x72\x69\x65\x76\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x6c\x6c\x4f\x66\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x52\x65\x73\x70\x6f\x6e\x73\x65\x27\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x66\x69\x67\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x44\x69\x73\x6b\x43\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x27\x7d\x0a\x20\x20\x20\x20\x70\x6f\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x75\x70\x64\x61\x74\x65\x44\x69\x73\x6b\x43\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x55\x70\x64\x61\x74\x65\x20\x74\x68\x65\x20\x64\x69\x73\x6b\x20\x73\x70\x61\x63\x65\x20\x6d\x6f\x6e\x69\x74\x6f\x72\x69\x6e\x67\x20\x63\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x61\x64\x6d\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x71\x75\x65\x73\x74\x42\x6f\x64\x79\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x44\x69\x73\x6b\x43\x6f\x6e\x66\x69\x67\x75\x72\x61\x74\x69\x6f\x6e\x27\x7d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x2f\x4f\x4b\x27\x7d\x0a\x0a\x20\x20\x2f\x75\x73\x65\x72\x2f\x6c\x6f\x67\x69\x6e\x3a\x0a\x20\x20\x20\x20\x70\x6f\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x6c\x6f\x67\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x43\x72\x65\x61\x74\x65\x20\x61\x20\x75\x73\x65\x72\x20\x73\x65\x73\x73\x69\x6f\x6e\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x70\x75\x62\x6c\x69\x63\x0a\x20\x20\x20\x20\x20\x20\x73\x65\x63\x75\x72\x69\x74\x79\x3a\x20\x5b\x5d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x71\x75\x65\x73\x74\x42\x6f\x64\x79\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x55\x73\x65\x72\x52\x65\x71\x75\x65\x73\x74\x27\x7d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x73\x65\x73\x73\x69\x6f\x6e\x20\x63\x72\x65\x61\x74\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x6c\x6c\x4f\x66\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x52\x65\x73\x70\x6f\x6e\x73\x65\x27\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x74\x6f\x6b\x65\x6e\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x0a\x20\x20\x2f\x75\x73\x65\x72\x2f\x6c\x6f\x67\x6f\x75\x74\x3a\x0a\x20\x20\x20\x20\x70\x6f\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x6c\x6f\x67\x6f\x75\x74\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x45\x6e\x64\x20\x74\x68\x65\x20\x63\x75\x72\x72\x65\x6e\x74\x20\x75\x73\x65\x72\x20\x73\x65\x73\x73\x69\x6f\x6e\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x70\x75\x62\x6c\x69\x63\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x2f\x4f\x4b\x27\x7d\x0a\x0a\x20\x20\x2f\x75\x73\x65\x72\x2f\x76\x61\x6c\x69\x64\x61\x74\x65\x3a\x0a\x20\x20\x20\x20\x67\x65\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x76\x61\x6c\x69\x64\x61\x74\x65\x53\x65\x73\x73\x69\x6f\x6e\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x43\x68\x65\x63\x6b\x20\x74\x68\x61\x74\x20\x74\x68\x65\x20\x63\x75\x72\x72\x65\x6e\x74\x20\x73\x65\x73\x73\x69\x6f\x6e\x20\x69\x73\x20\x76\x61\x6c\x69\x64\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x75\x73\x65\x72\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x73\x65\x73\x73\x69\x6f\x6e\x20\x69\x73\x20\x76\x61\x6c\x69\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x6c\x6c\x4f\x66\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x52\x65\x73\x70\x6f\x6e\x73\x65\x27\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x75\x73\x65\x72\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x64\x6d\x69\x6e\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x62\x6f\x6f\x6c\x65\x61\x6e\x7d\x0a\x0a\x20\x20\x2f\x75\x73\x65\x72\x2f\x74\x6f\x74\x70\x2f\x65\x6e\x61\x62\x6c\x65\x3a\x0a\x20\x20\x20\x20\x70\x6f\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x65\x6e\x61\x62\x6c\x65\x54\x6f\x74\x70\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x45\x6e\x61\x62\x6c\x65\x20\x74\x77\x6f\x2d\x66\x61\x63\x74\x6f\x72\x20\x61\x75\x74\x68\x65\x6e\x74\x69\x63\x61\x74\x69\x6f\x6e\x20\x66\x6f\x72\x20\x74\x68\x65\x20\x63\x75\x72\x72\x65\x6e\x74\x20\x75\x73\x65\x72\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x75\x73\x65\x72\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x71\x75\x65\x73\x74\x42\x6f\x64\x79\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x55\x73\x65\x72\x52\x65\x71\x75\x65\x73\x74\x27\x7d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x54\x4f\x54\x50\x20\x65\x6e\x61\x62\x6c\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x6c\x6c\x4f\x66\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x52\x65\x73\x70\x6f\x6e\x73\x65\x27\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x74\x6f\x74\x70\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x54\x6f\x74\x70\x52\x65\x73\x70\x6f\x6e\x73\x65\x27\x7d\x0a\x0a\x20\x20\x2f\x75\x73\x65\x72\x2f\x74\x6f\x74\x70\x2f\x64\x69\x73\x61\x62\x6c\x65\x3a\x0a\x20\x20\x20\x20\x70\x6f\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x64\x69\x73\x61\x62\x6c\x65\x54\x6f\x74\x70\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x44\x69\x73\x61\x62\x6c\x65\x20\x74\x77\x6f\x2d\x66\x61\x63\x74\x6f\x72\x20\x61\x75\x74\x68\x65\x6e\x74\x69\x63\x61\x74\x69\x6f\x6e\x20\x66\x6f\x72\x20\x74\x68\x65\x20\x63\x75\x72\x72\x65\x6e\x74\x20\x75\x73\x65\x72\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x75\x73\x65\x72\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x54\x4f\x54\x50\x20\x64\x69\x73\x61\x62\x6c\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x6c\x6c\x4f\x66\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x52\x65\x73\x70\x6f\x6e\x73\x65\x27\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x75\x73\x65\x72\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x0a\x20\x20\x2f\x75\x73\x65\x72\x2f\x6c\x69\x73\x74\x3a\x0a\x20\x20\x20\x20\x67\x65\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x6c\x69\x73\x74\x55\x73\x65\x72\x73\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x4c\x69\x73\x74\x20\x72\x65\x67\x69\x73\x74\x65\x72\x65\x64\x20\x75\x73\x65\x72\x73\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x61\x64\x6d\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x75\x73\x65\x72\x73\x20\x72\x65\x74\x72\x69\x65\x76\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x6c\x6c\x4f\x66\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x52\x65\x73\x70\x6f\x6e\x73\x65\x27\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x75\x73\x65\x72\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x61\x72\x72\x61\x79\x2c\x20\x69\x74\x65\x6d\x73\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x7d\x0a\x0a\x20\x20\x2f\x75\x73\x65\x72\x2f\x61\x64\x64\x3a\x0a\x20\x20\x20\x20\x70\x6f\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x61\x64\x64\x55\x73\x65\x72\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x52\x65\x67\x69\x73\x74\x65\x72\x20\x61\x20\x75\x73\x65\x72\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x61\x64\x6d\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x71\x75\x65\x73\x74\x42\x6f\x64\x79\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x55\x73\x65\x72\x52\x65\x71\x75\x65\x73\x74\x27\x7d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x31\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x75\x73\x65\x72\x20\x61\x64\x64\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x6c\x6c\x4f\x66\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x52\x65\x73\x70\x6f\x6e\x73\x65\x27\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x75\x73\x65\x72\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x0a\x20\x20\x2f\x75\x73\x65\x72\x2f\x72\x65\x6d\x6f\x76\x65\x3a\x0a\x20\x20\x20\x20\x70\x6f\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x72\x65\x6d\x6f\x76\x65\x55\x73\x65\x72\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x52\x65\x6d\x6f\x76\x65\x20\x61\x20\x75\x73\x65\x72\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x61\x64\x6d\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x71\x75\x65\x73\x74\x42\x6f\x64\x79\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x55\x73\x65\x72\x52\x65\x71\x75\x65\x73\x74\x27\x7d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x75\x73\x65\x72\x20\x72\x65\x6d\x6f\x76\x65\x64\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x63\x68\x65\x6d\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x61\x6c\x6c\x4f\x66\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x73\x63\x68\x65\x6d\x61\x73\x2f\x52\x65\x73\x70\x6f\x6e\x73\x65\x27\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2d\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x64\x61\x74\x61\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x70\x65\x72\x74\x69\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x75\x73\x65\x72\x3a\x20\x7b\x74\x79\x70\x65\x3a\x20\x73\x74\x72\x69\x6e\x67\x7d\x0a\x0a\x20\x20\x2f\x75\x73\x65\x72\x2f\x72\x65\x73\x65\x74\x3a\x0a\x20\x20\x20\x20\x70\x6f\x73\x74\x3a\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x65\x72\x61\x74\x69\x6f\x6e\x49\x64\x3a\x20\x72\x65\x73\x65\x74\x55\x73\x65\x72\x73\x0a\x20\x20\x20\x20\x20\x20\x73\x75\x6d\x6d\x61\x72\x79\x3a\x20\x52\x65\x6d\x6f\x76\x65\x20\x61\x6c\x6c\x20\x75\x73\x65\x72\x73\x20\x61\x6e\x64\x20\x73\x65\x73\x73\x69\x6f\x6e\x73\x0a\x20\x20\x20\x20\x20\x20\x78\x2d\x61\x63\x63\x65\x73\x73\x3a\x20\x61\x64\x6d\x69\x6e\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x3a\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x27\x32\x30\x30\x27\x3a\x20\x7b\x24\x72\x65\x66\x3a\x20\x27\x23\x2f\x63\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x73\x2f\x72\x65\x73\x70\x6f\x6e\x73\x65\x73\x2f\x4f\x4b\x27\x7d\x0a")
+
+func init() {
+	err := CTX.Err()
+	if err != nil {
+		panic(err)
+	}
+
+	var f webdav.File
+
+	f, err = FS.OpenFile(CTX, "openapi.yaml", os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0777)
+	if err != nil {
+		panic(err)
+	}
+
+	_, err = f.Write(FileOpenapiYaml)
+	if err != nil {
+		panic(err)
+	}
+
+	err = f.Close()
+	if err != nil {
+		panic(err)
+	}
+
+	Handler = &webdav.Handler{
+		FileSystem: FS,
+		LockSystem: webdav.NewMemLS(),
+	}
+
+}
+
+// Open a file
+func (hfs *HTTPFS) Open(path string) (http.File, error) {
+	path = hfs.Prefix + path
+
+	f, err := FS.OpenFile(CTX, path, os.O_RDONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// ReadFile is adapTed from ioutil
+func ReadFile(path string) ([]byte, error) {
+	f, err := FS.OpenFile(CTX, path, os.O_RDONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(make([]byte, 0, bytes.MinRead))
+
+	// If the buffer overflows, we will get bytes.ErrTooLarge.
+	// Return that as an error. Any other panic remains.
+	defer func() {
+		e := recover()
+		if e == nil {
+			return
+		}
+		if panicErr, ok := e.(error); ok && panicErr == bytes.ErrTooLarge {
+			err = panicErr
+		} else {
+			panic(e)
+		}
+	}()
+	_, err = buf.ReadFrom(f)
+	return buf.Bytes(), err
+}
+
+// WriteFile is adapTed from ioutil
+func WriteFile(filename string, data []byte, perm os.FileMode) error {
+	f, err := FS.OpenFile(CTX, filename, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	n, err := f.Write(data)
+	if err == nil && n < len(data) {
+		err = io.ErrShortWrite
+	}
+	if err1 := f.Close(); err == nil {
+		err = err1
+	}
+	return err
+}
+
+// WalkDirs looks for files in the given dir and returns a list of files in it
+// usage for all files in the b0x: WalkDirs("", false)
+func WalkDirs(name string, includeDirsInList bool, files ...string) ([]string, error) {
+	f, err := FS.OpenFile(CTX, name, os.O_RDONLY, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	fileInfos, err := f.Readdir(0)
+	if err != nil {
+		return nil, err
+	}
+
+	err = f.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, info := range fileInfos {
+		filename := path.Join(name, info.Name())
+
+		if includeDirsInList || !info.IsDir() {
+			files = append(files, filename)
+		}
+
+		if info.IsDir() {
+			files, err = WalkDirs(filename, includeDirsInList, files...)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return files, nil
+}
@@ -0,0 +1,2 @@
+// Package internal provides the compiled OpenAPI document
+package internal
@@ -0,0 +1,221 @@
+package openapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+
+	"github.com/ubclaunchpad/inertia/api/openapi/internal"
+)
+
+//go:generate go run github.com/UnnoTed/fileb0x b0x.yml
+
+// Levels of access required by operations, declared with "x-access"
+const (
+	AccessPublic  = "public"
+	AccessUser    = "user"
+	AccessAdmin   = "admin"
+	AccessScraper = "scraper"
+)
+
+// Document is the OpenAPI document describing the daemon API
+type Document struct {
+	*openapi3.T
+
+	// routers find the operations that requests are made to - there is one
+	// for each path that declares its own servers, and one for the rest
+	routers []routers.Router
+}
+
+// Endpoint is an operation on a specific method and path
+type Endpoint struct {
+	Method string
+	// Path is the path as declared in the document, and URL is the path that
+	// requests are made to, including the server's prefix
+	Path string
+	URL  string
+	// Access is the level of access required, declared with "x-access"
+	Access string
+
+	*openapi3.Operation
+}
+
+// options used when validating requests and responses - authentication is
+// left to the daemon, so security requirements are not checked
+var options = &openapi3filter.Options{
+	AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+}
+
+func init() {
+	// events are streamed as text, which openapi3filter doesn't decode by default
+	openapi3filter.RegisterBodyDecoder("text/event-stream",
+		openapi3filter.RegisteredBodyDecoder("text/plain"))
+}
+
+// Raw returns the OpenAPI document describing the daemon API, in YAML
+func Raw() []byte {
+	raw, err := internal.ReadFile("openapi.yaml")
+	if err != nil {
+		// the document is compiled in, so this should never happen
+		panic(fmt.Sprintf("openapi document missing: %s", err.Error()))
+	}
+	return raw
+}
+
+// Load parses and validates the OpenAPI document describing the daemon API
+func Load() (*Document, error) {
+	return Parse(Raw())
+}
+
+// Parse parses and validates an OpenAPI document
+func Parse(raw []byte) (*Document, error) {
+	var loader = openapi3.NewLoader()
+	spec, err := loader.LoadFromData(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid openapi document: %w", err)
+	}
+	if err := spec.Validate(loader.Context); err != nil {
+		return nil, fmt.Errorf("invalid openapi document: %w", err)
+	}
+
+	// routers only consider the document's servers, so paths that declare
+	// their own are routed separately
+	var specs []*openapi3.T
+	var shared = *spec
+	shared.Paths = openapi3.Paths{}
+	for p, item := range spec.Paths {
+		if len(item.Servers) == 0 {
+			shared.Paths[p] = item
+			continue
+		}
+		var own = *spec
+		own.Servers = item.Servers
+		own.Paths = openapi3.Paths{p: item}
+		specs = append(specs, &own)
+	}
+	specs = append(specs, &shared)
+
+	var doc = &Document{T: spec}
+	for _, s := range specs {
+		router, err := gorillamux.NewRouter(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid openapi document: %w", err)
+		}
+		doc.routers = append(doc.routers, router)
+	}
+	return doc, nil
+}
+
+// Endpoints lists every operation in the document, sorted by path
+func (d *Document) Endpoints() []Endpoint {
+	var endpoints []Endpoint
+	for p, item := range d.Paths {
+		var servers = item.Servers
+		if len(servers) == 0 {
+			servers = d.Servers
+		}
+		var prefix string
+		if len(servers) > 0 {
+			prefix = servers[0].URL
+		}
+		for method, op := range item.Operations() {
+			endpoints = append(endpoints, Endpoint{
+				Method:    method,
+				Path:      p,
+				URL:       path.Join("/", prefix, p),
+				Access:    extension(op.ExtensionProps, "x-access"),
+				Operation: op,
+			})
+		}
+	}
+	sort.Slice(endpoints, func(i, j int) bool {
+		if endpoints[i].Path == endpoints[j].Path {
+			return endpoints[i].Method < endpoints[j].Method
+		}
+		return endpoints[i].Path < endpoints[j].Path
+	})
+	return endpoints
+}
+
+// ValidateRequest checks that the request is made to an endpoint in the
+// document, and that its parameters and body match that endpoint
+func (d *Document) ValidateRequest(r *http.Request) error {
+	input, err := d.requestInput(r)
+	if err != nil {
+		return err
+	}
+	if err := openapi3filter.ValidateRequest(r.Context(), input); err != nil {
+		return fmt.Errorf("%s %s: %w", r.Method, r.URL.Path, err)
+	}
+
+	// undeclared query parameters are allowed by OpenAPI, but are most likely
+	// misspelled or unsupported here
+	for name := range r.URL.Query() {
+		if input.Route.Operation.Parameters.GetByInAndName(openapi3.ParameterInQuery, name) == nil &&
+			input.Route.PathItem.Parameters.GetByInAndName(openapi3.ParameterInQuery, name) == nil {
+			return fmt.Errorf("%s %s: undeclared query parameter '%s'",
+				r.Method, r.URL.Path, name)
+		}
+	}
+	return nil
+}
+
+// ValidateResponse checks that a response to the request matches the response
+// the endpoint declares for its status
+func (d *Document) ValidateResponse(r *http.Request, status int, header http.Header, body []byte) error {
+	input, err := d.requestInput(r)
+	if err != nil {
+		return err
+	}
+	if err := openapi3filter.ValidateResponse(context.Background(), (&openapi3filter.ResponseValidationInput{
+		RequestValidationInput: input,
+		Status:                 status,
+		Header:                 header,
+		Options:                options,
+	}).SetBodyBytes(body)); err != nil {
+		return fmt.Errorf("%s %s: %w", r.Method, r.URL.Path, err)
+	}
+	return nil
+}
+
+// requestInput finds the operation the request is made to
+func (d *Document) requestInput(r *http.Request) (*openapi3filter.RequestValidationInput, error) {
+	for _, router := range d.routers {
+		route, params, err := router.FindRoute(r)
+		if errors.Is(err, routers.ErrPathNotFound) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", r.Method, r.URL.Path, err)
+		}
+		return &openapi3filter.RequestValidationInput{
+			Request:    r,
+			PathParams: params,
+			Route:      route,
+			Options:    options,
+		}, nil
+	}
+	return nil, fmt.Errorf("%s %s is not an endpoint in the API", r.Method, r.URL.Path)
+}
+
+// extension returns the value of a string extension, such as "x-access", or an
+// empty string if it isn't set
+func extension(props openapi3.ExtensionProps, name string) string {
+	raw, ok := props.Extensions[name].(json.RawMessage)
+	if !ok {
+		return ""
+	}
+	var value string
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return ""
+	}
+	return value
+}
@@ -0,0 +1,185 @@
+package openapi
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ubclaunchpad/inertia/api"
+)
+
+// goTypes are the types in package api that schemas may describe
+var goTypes = map[string]interface{}{
+	"BaseResponse":                 api.BaseResponse{},
+	"UpRequest":                    api.UpRequest{},
+	"GitOptions":                   api.GitOptions{},
+	"LogSink":                      api.LogSink{},
+	"UserRequest":                  api.UserRequest{},
+	"EnvRequest":                   api.EnvRequest{},
+	"SecretRequest":                api.SecretRequest{},
+	"EnvTransferRequest":           api.EnvTransferRequest{},
+	"BackupConfiguration":          api.BackupConfiguration{},
+	"S3Configuration":              api.S3Configuration{},
+	"BackupRequest":                api.BackupRequest{},
+	"MetricsConfiguration":         api.MetricsConfiguration{},
+	"DiskConfiguration":            api.DiskConfiguration{},
+	"MigrationRequest":             api.MigrationRequest{},
+	"TotpResponse":                 api.TotpResponse{},
+	"DeploymentStatus":             api.DeploymentStatus{},
+	"DeploymentStatusWithVersions": api.DeploymentStatusWithVersions{},
+	"LogEntry":                     api.LogEntry{},
+	"Event":                        api.Event{},
+	"Deployment":                   api.Deployment{},
+	"Commit":                       api.Commit{},
+	"DeploymentPlan":               api.DeploymentPlan{},
+	"PlanSetting":                  api.PlanSetting{},
+	"EnvChange":                    api.EnvChange{},
+	"EnvVariable":                  api.EnvVariable{},
+	"Backup":                       api.Backup{},
+	"Stats":                        api.Stats{},
+	"HostStats":                    api.HostStats{},
+	"ContainerStats":               api.ContainerStats{},
+	"MigrationManifest":            api.MigrationManifest{},
+}
+
+func TestLoad(t *testing.T) {
+	doc, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, api.Version, doc.Info.Version)
+	require.Len(t, doc.Servers, 1)
+	assert.Equal(t, api.PathPrefix, doc.Servers[0].URL)
+
+	var ids = map[string]bool{}
+	for _, e := range doc.Endpoints() {
+		assert.NotEmpty(t, e.OperationID, "%s %s", e.Method, e.Path)
+		assert.False(t, ids[e.OperationID], "duplicate operation %s", e.OperationID)
+		ids[e.OperationID] = true
+		assert.Contains(t, []string{AccessPublic, AccessUser, AccessAdmin, AccessScraper},
+			e.Access, "%s %s", e.Method, e.Path)
+		assert.NotEmpty(t, e.Responses, "%s %s", e.Method, e.Path)
+	}
+}
+
+func TestRaw(t *testing.T) {
+	// the compiled document must be regenerated with 'go generate' whenever
+	// openapi.yaml is changed
+	onDisk, err := ioutil.ReadFile("openapi.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, string(onDisk), string(Raw()))
+}
+
+func TestParse(t *testing.T) {
+	_, err := Parse([]byte(`
+openapi: 3.0.3
+info: {title: test, version: v1}
+paths:
+  /status:
+    get:
+      responses:
+        '200':
+          description: status
+          content:
+            application/json:
+              schema: {$ref: '#/components/schemas/Missing'}
+`))
+	assert.Error(t, err)
+}
+
+func TestDocument_SchemasMatchTypes(t *testing.T) {
+	doc, err := Load()
+	require.NoError(t, err)
+
+	for name, s := range doc.Components.Schemas {
+		var goType = extension(s.Value.ExtensionProps, "x-go-type")
+		if goType == "" {
+			continue
+		}
+		v, ok := goTypes[goType]
+		if !assert.True(t, ok, "schema %s describes unknown type api.%s", name, goType) {
+			continue
+		}
+		var properties []string
+		for p := range s.Value.Properties {
+			properties = append(properties, p)
+		}
+		sort.Strings(properties)
+		assert.Equal(t, jsonFields(reflect.TypeOf(v)), properties,
+			"schema %s does not match api.%s", name, goType)
+	}
+}
+
+func TestDocument_ValidateRequest(t *testing.T) {
+	doc, err := Load()
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		method  string
+		target  string
+		wantErr bool
+	}{
+		{"GET", "/v1/status", false},
+		{"GET", "/v1/logs?container=web&stream=true", false},
+		{"GET", "/", false},
+		{"POST", "/webhook", false},
+		{"GET", "/status", true},
+		{"POST", "/v1/status", true},
+		{"GET", "/v1/logs?colour=red", true},
+		{"GET", "/v1/containers/address", true},
+		{"POST", "/v1/migrate/import", true},
+	} {
+		var r = httptest.NewRequest(tt.method, tt.target, nil)
+		err := doc.ValidateRequest(r)
+		assert.Equal(t, tt.wantErr, err != nil, "%s %s: %v", tt.method, tt.target, err)
+	}
+}
+
+func TestDocument_ValidateResponse(t *testing.T) {
+	doc, err := Load()
+	require.NoError(t, err)
+	var jsonHeader = http.Header{"Content-Type": {"application/json"}}
+
+	var r = httptest.NewRequest("GET", "/v1/token", nil)
+	assert.NoError(t, doc.ValidateResponse(r, 200, jsonHeader, []byte(`{"data":{"token":"abc"}}`)))
+	assert.NoError(t, doc.ValidateResponse(r, 500, jsonHeader, []byte(`{"error":"oops"}`)))
+	assert.Error(t, doc.ValidateResponse(r, 200, jsonHeader, []byte(`{"data":{"token":123}}`)))
+	assert.Error(t, doc.ValidateResponse(r, 200, jsonHeader, []byte(`{"code":"200"}`)))
+	assert.Error(t, doc.ValidateResponse(r, 200, http.Header{"Content-Type": {"text/html"}},
+		[]byte("<html></html>")))
+
+	r = httptest.NewRequest("GET", "/v1/status", nil)
+	assert.Error(t, doc.ValidateResponse(r, 200, jsonHeader,
+		[]byte(`{"data":{"status":{"containers":"web"}}}`)))
+
+	r = httptest.NewRequest("POST", "/v1/up", nil)
+	assert.NoError(t, doc.ValidateResponse(r, 201, http.Header{"Content-Type": {"text/plain"}},
+		[]byte("building...")))
+}
+
+// jsonFields lists the names of the fields of a struct when encoded as JSON
+func jsonFields(t reflect.Type) []string {
+	var fields []string
+	for i := 0; i < t.NumField(); i++ {
+		var f = t.Field(i)
+		if f.Anonymous {
+			fields = append(fields, jsonFields(f.Type)...)
+			continue
+		}
+		var name = strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields = append(fields, name)
+	}
+	sort.Strings(fields)
+	return fields
+}
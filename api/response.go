@@ -7,6 +7,7 @@ import (
 	"io"
 	"io/ioutil"
 	"strings"
+	"time"
 )
 
 // BaseResponse is the underlying response structure to all responses.
@@ -98,6 +99,197 @@ type DeploymentStatus struct {
 	BuildContainerActive bool     `json:"build_active"`
 }
 
+// LogEntry is a line of output from a container
+type LogEntry struct {
+	Time      time.Time `json:"time"`
+	Container string    `json:"container"`
+	// Stream is "stdout" or "stderr"
+	Stream  string `json:"stream"`
+	Message string `json:"message"`
+}
+
+// Types of events emitted by the daemon
+const (
+	EventWebhookReceived  = "webhook.received"
+	EventWebhookIgnored   = "webhook.ignored"
+	EventBuildStarted     = "build.started"
+	EventBuildFinished    = "build.finished"
+	EventContainerStarted = "container.started"
+	EventContainerDied    = "container.died"
+	EventEnvChanged       = "env.changed"
+	EventUserLoggedIn     = "user.logged_in"
+)
+
+// Event is a change in the state of the daemon or deployment
+type Event struct {
+	// ID increases with each event emitted by the daemon, and is reset when
+	// the daemon restarts
+	ID   int64     `json:"id"`
+	Type string    `json:"type"`
+	Time time.Time `json:"time"`
+
+	Message string `json:"message"`
+	// Data holds details that depend on the type of event, such as the
+	// container that died or the error a build failed with
+	Data map[string]string `json:"data,omitempty"`
+}
+
+// Deployment is a record of a successful deployment of the project
+type Deployment struct {
+	Branch     string    `json:"branch"`
+	CommitHash string    `json:"commit_hash"`
+	DeployedAt time.Time `json:"deployed_at"`
+
+	// ContainerStatus is the state of the project container shortly after
+	// the deployment, if a project container could be found
+	ContainerStatus string `json:"container_status,omitempty"`
+
+	// Changes lists the commits shipped since the previous deployment, most
+	// recent first. ChangesTruncated is set if there were too many to list.
+	Changes          []Commit `json:"changes,omitempty"`
+	ChangesTruncated bool     `json:"changes_truncated,omitempty"`
+}
+
+// Commit describes a commit in the project's repository
+type Commit struct {
+	Hash    string    `json:"hash"`
+	Author  string    `json:"author,omitempty"`
+	Message string    `json:"message,omitempty"`
+	Time    time.Time `json:"time,omitempty"`
+}
+
+// Kinds of changes to environment variables
+const (
+	EnvAdded   = "added"
+	EnvChanged = "changed"
+	EnvRemoved = "removed"
+)
+
+// DeploymentPlan describes what deploying the project would change
+type DeploymentPlan struct {
+	// Current is the deployed commit, or nil if nothing has been deployed
+	Current *Commit `json:"current,omitempty"`
+	// Target is the commit that would be deployed
+	Target Commit `json:"target"`
+	// Commits lists the commits between Current and Target, newest first. If
+	// there are too many to list, only the newest are included and
+	// CommitsTruncated is set.
+	Commits          []Commit `json:"commits"`
+	CommitsTruncated bool     `json:"commits_truncated,omitempty"`
+
+	// Settings compares the settings the daemon is running with to the ones
+	// that would be applied
+	Settings []PlanSetting `json:"settings"`
+	// Env lists environment variables that have changed since the last deploy
+	Env []EnvChange `json:"env"`
+	// Containers lists the active containers that would be recreated
+	Containers []string `json:"containers"`
+}
+
+// PlanSetting compares the current and planned values of a deployment setting
+type PlanSetting struct {
+	Name    string `json:"name"`
+	Current string `json:"current"`
+	Planned string `json:"planned"`
+}
+
+// Changed returns true if the planned value differs from the current one
+func (s PlanSetting) Changed() bool { return s.Current != s.Planned }
+
+// EnvChange describes an environment variable that has been added, changed,
+// or removed
+type EnvChange struct {
+	Name string `json:"name"`
+	// Container is set if the variable only applies to one container
+	Container string `json:"container,omitempty"`
+	// Change is one of EnvAdded, EnvChanged, or EnvRemoved
+	Change string `json:"change"`
+}
+
+// EnvVariable describes an environment variable configured on the daemon
+type EnvVariable struct {
+	Name string `json:"name"`
+	// Value is omitted for encrypted variables
+	Value     string `json:"value,omitempty"`
+	Encrypted bool   `json:"encrypted"`
+	// Profile and Container are set if the variable only applies to one profile
+	// or container respectively
+	Profile   string `json:"profile,omitempty"`
+	Container string `json:"container,omitempty"`
+}
+
+// String renders the variable as 'NAME=value', followed by its scope if it has
+// one, such as '(profile: staging)'
+func (v EnvVariable) String() string {
+	var value = v.Value
+	if v.Encrypted {
+		value = "[ENCRYPTED]"
+	}
+	var scope []string
+	if v.Profile != "" {
+		scope = append(scope, "profile: "+v.Profile)
+	}
+	if v.Container != "" {
+		scope = append(scope, "container: "+v.Container)
+	}
+	if len(scope) == 0 {
+		return v.Name + "=" + value
+	}
+	return v.Name + "=" + value + " (" + strings.Join(scope, ", ") + ")"
+}
+
+// Backup describes a backup of the project's persistent data
+type Backup struct {
+	Name      string    `json:"name"`
+	Size      int64     `json:"size"`
+	CreatedAt time.Time `json:"created_at"`
+	Encrypted bool      `json:"encrypted"`
+}
+
+// Stats is a snapshot of resource usage on the remote
+type Stats struct {
+	Time       time.Time        `json:"time"`
+	Host       HostStats        `json:"host"`
+	Containers []ContainerStats `json:"containers"`
+}
+
+// HostStats describes the resource usage of the remote host, in bytes
+type HostStats struct {
+	MemoryTotal     uint64 `json:"memory_total"`
+	MemoryAvailable uint64 `json:"memory_available"`
+
+	// DiskPath is the location of the filesystem Inertia stores data on
+	DiskPath  string `json:"disk_path"`
+	DiskTotal uint64 `json:"disk_total"`
+	DiskFree  uint64 `json:"disk_free"`
+}
+
+// ContainerStats describes the resource usage of a project container
+type ContainerStats struct {
+	Name string `json:"name"`
+
+	// CPUPercent is relative to a single CPU, so may exceed 100 for containers
+	// using several CPUs
+	CPUPercent  float64 `json:"cpu_percent"`
+	MemoryUsage uint64  `json:"memory_usage"`
+	MemoryLimit uint64  `json:"memory_limit"`
+	NetworkRx   uint64  `json:"network_rx"`
+	NetworkTx   uint64  `json:"network_tx"`
+	BlockRead   uint64  `json:"block_read"`
+	BlockWrite  uint64  `json:"block_write"`
+	PIDs        uint64  `json:"pids"`
+}
+
+// MigrationManifest describes the daemon state in a migration bundle
+type MigrationManifest struct {
+	Version       int       `json:"version"`
+	DaemonVersion string    `json:"daemon_version"`
+	CreatedAt     time.Time `json:"created_at"`
+
+	// Deployment is the state of the deployment when it was exported
+	Deployment DeploymentStatus `json:"deployment"`
+}
+
 // DeploymentStatusWithVersions extends DeploymentStatus with a field that denotes
 // whether a new version is available
 type DeploymentStatusWithVersions struct {
@@ -100,3 +100,27 @@ func TestBaseResponse_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvVariable_String(t *testing.T) {
+	tests := []struct {
+		name string
+		v    EnvVariable
+		want string
+	}{
+		{"plain", EnvVariable{Name: "KEY", Value: "a=b"}, "KEY=a=b"},
+		{"encrypted", EnvVariable{Name: "KEY", Encrypted: true}, "KEY=[ENCRYPTED]"},
+		{"profile",
+			EnvVariable{Name: "KEY", Value: "value", Profile: "staging"},
+			"KEY=value (profile: staging)"},
+		{"profile and container",
+			EnvVariable{Name: "KEY", Encrypted: true, Profile: "staging", Container: "web"},
+			"KEY=[ENCRYPTED] (profile: staging, container: web)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.String(); got != tt.want {
+				t.Errorf("EnvVariable.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -21,6 +21,9 @@ type Project struct {
 	// Profiles tracks configured project profiles. It is a list instead of a map
 	// to better align with TOML best practices
 	Profiles []*Profile `toml:"profile"`
+
+	// Pipeline declares stages that deployments are promoted through, in order
+	Pipeline []*PipelineStage `toml:"pipeline,omitempty"`
 }
 
 // ValidateVersion checks if the given version is compatible with the project version. It errors if
@@ -68,6 +71,7 @@ type Profile struct {
 	Branch    string     `toml:"branch"`
 	Build     *Build     `toml:"build"`
 	Notifiers *Notifiers `toml:"notifiers"`
+	LogSinks  []*LogSink `toml:"log_sinks"`
 }
 
 // Identifier implements identity.Identifier
@@ -152,7 +156,66 @@ func (p *Project) RemoveProfile(name string) bool {
 	return ok
 }
 
+// PipelineStage denotes a remote, and the profile to deploy to it with, in a
+// promotion pipeline
+type PipelineStage struct {
+	Remote  string `toml:"remote"`
+	Profile string `toml:"profile,omitempty"`
+
+	// HealthCheck is a URL that must respond successfully before the deployment
+	// on this stage can be promoted to the next stage
+	HealthCheck string `toml:"health_check,omitempty"`
+
+	// Confirm requires manual confirmation before deploying to this stage
+	Confirm bool `toml:"confirm,omitempty"`
+}
+
+// GetPipelineStage retrieves the index of the pipeline stage for the given
+// remote
+func (p *Project) GetPipelineStage(remote string) (int, bool) {
+	for i, stage := range p.Pipeline {
+		if stage.Remote == remote {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+// ValidatePipeline checks that the configured pipeline is valid
+func (p *Project) ValidatePipeline() error {
+	if len(p.Pipeline) < 2 {
+		return errors.New("pipeline must have at least 2 stages")
+	}
+	var seen = make(map[string]bool, len(p.Pipeline))
+	for i, stage := range p.Pipeline {
+		if stage.Remote == "" {
+			return fmt.Errorf("pipeline stage %d has no remote", i+1)
+		}
+		if seen[stage.Remote] {
+			return fmt.Errorf("remote '%s' appears in pipeline more than once", stage.Remote)
+		}
+		seen[stage.Remote] = true
+		if stage.Profile != "" {
+			if _, ok := p.GetProfile(stage.Profile); !ok {
+				return fmt.Errorf("pipeline stage '%s' uses profile '%s', which does not exist",
+					stage.Remote, stage.Profile)
+			}
+		}
+	}
+	return nil
+}
+
 // Notifiers defines options for notifications on a profile
 type Notifiers struct {
 	SlackNotificationURL string `toml:"slack_notification_url"`
 }
+
+// LogSink defines an external destination that the daemon forwards container
+// and daemon logs to while a profile is deployed
+type LogSink struct {
+	// Type is one of "syslog", "http", or "loki"
+	Type    string            `toml:"type"`
+	URL     string            `toml:"url"`
+	Headers map[string]string `toml:"headers"`
+	Labels  map[string]string `toml:"labels"`
+}
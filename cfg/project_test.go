@@ -181,3 +181,35 @@ func TestProject_ValidateVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestProject_Pipeline(t *testing.T) {
+	var p = &Project{
+		Profiles: []*Profile{exampleProfile},
+		Pipeline: []*PipelineStage{
+			{Remote: "staging", Profile: "test"},
+			{Remote: "production", Confirm: true},
+		},
+	}
+	assert.NoError(t, p.ValidatePipeline())
+
+	i, found := p.GetPipelineStage("production")
+	assert.True(t, found)
+	assert.Equal(t, 1, i)
+	_, found = p.GetPipelineStage("dev")
+	assert.False(t, found)
+
+	tests := []struct {
+		name     string
+		pipeline []*PipelineStage
+	}{
+		{"too short", []*PipelineStage{{Remote: "staging"}}},
+		{"missing remote", []*PipelineStage{{Remote: "staging"}, {}}},
+		{"duplicate remote", []*PipelineStage{{Remote: "staging"}, {Remote: "staging"}}},
+		{"unknown profile", []*PipelineStage{{Remote: "staging"}, {Remote: "prod", Profile: "nope"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Error(t, (&Project{Profiles: []*Profile{exampleProfile}, Pipeline: tt.pipeline}).ValidatePipeline())
+		})
+	}
+}
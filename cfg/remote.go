@@ -2,6 +2,7 @@ package cfg
 
 import (
 	"errors"
+	"fmt"
 )
 
 // Remote contains parameters for the VPS
@@ -21,6 +22,22 @@ type SSH struct {
 	User         string `toml:"user"`
 	IdentityFile string `toml:"identityfile"`
 	SSHPort      string `toml:"ssh-port"`
+
+	// HostKey is the remote's SSH host key in authorized_keys format, recorded
+	// on first connection and verified on every later connection
+	HostKey string `toml:"host-key,omitempty"`
+
+	// HostAlias is the 'Host' entry in ~/.ssh/config to read connection options
+	// from - defaults to the remote's IP. Options set in Inertia's configuration
+	// take precedence over options set in ~/.ssh/config.
+	HostAlias string `toml:"host-alias,omitempty"`
+	// UseAgent enables authentication using keys held by ssh-agent
+	UseAgent bool `toml:"use-agent,omitempty"`
+	// ForwardAgent enables forwarding of ssh-agent to the remote
+	ForwardAgent bool `toml:"forward-agent,omitempty"`
+	// ProxyJump is a list of jump hosts, in the format '[user@]host[:port]', to
+	// connect to the remote through, in order
+	ProxyJump []string `toml:"proxy-jump,omitempty"`
 }
 
 // Daemon contains parameters for the Daemon
@@ -30,6 +47,32 @@ type Daemon struct {
 	User          string `toml:"user,omitempty"`
 	WebHookSecret string `toml:"webhook-secret"`
 	VerifySSL     bool   `toml:"verify-ssl"`
+
+	// Transport determines how the daemon is reached - see TransportDirect and
+	// TransportSSHTunnel. Defaults to TransportDirect.
+	Transport string `toml:"transport,omitempty"`
+}
+
+const (
+	// TransportDirect connects to the daemon on its public port
+	TransportDirect = "direct"
+	// TransportSSHTunnel connects to the daemon through an SSH tunnel, which
+	// allows the daemon to only listen on localhost
+	TransportSSHTunnel = "ssh-tunnel"
+)
+
+// UseSSHTunnel returns true if the daemon should be reached through an SSH tunnel
+func (d *Daemon) UseSSHTunnel() bool { return d != nil && d.Transport == TransportSSHTunnel }
+
+// ValidateTransport checks that the configured transport is supported
+func (d *Daemon) ValidateTransport() error {
+	switch d.Transport {
+	case "", TransportDirect, TransportSSHTunnel:
+		return nil
+	default:
+		return fmt.Errorf("unknown daemon transport '%s' - must be one of '%s', '%s'",
+			d.Transport, TransportDirect, TransportSSHTunnel)
+	}
 }
 
 // Identifier implements identity.Identifier
@@ -26,7 +26,7 @@ func SetProperty(name string, value string, obj interface{}) error {
 	for i := 0; i < val.NumField(); i++ {
 		var typeVal = val.Type().Field(i)
 		var fieldVal = val.Field(i)
-		if typeVal.Tag.Get("toml") == parts[0] {
+		if strings.Split(typeVal.Tag.Get("toml"), ",")[0] == parts[0] {
 			if len(parts) > 1 {
 				// recurse on nested property
 				var fieldPtr = fieldVal.Elem().Addr()
@@ -41,12 +41,24 @@ func SetProperty(name string, value string, obj interface{}) error {
 
 				// attempt to set boolean
 				case reflect.Bool:
-					if _, err := strconv.ParseBool(value); err == nil {
-						fieldVal.SetBool(true)
+					if b, err := strconv.ParseBool(value); err == nil {
+						fieldVal.SetBool(b)
 						return nil
 					}
 					break
 
+				// set comma-separated list of strings
+				case reflect.Slice:
+					if fieldVal.Type().Elem().Kind() != reflect.String {
+						break
+					}
+					var values []string
+					if value != "" {
+						values = strings.Split(value, ",")
+					}
+					fieldVal.Set(reflect.ValueOf(values))
+					return nil
+
 				default:
 					break
 				}
@@ -66,6 +66,30 @@ func TestSetProperty(t *testing.T) {
 				}
 				return nil
 			}},
+		{"ok: unset boolean",
+			args{"daemon.verify-ssl", "false", &Remote{
+				Daemon: &Daemon{VerifySSL: true},
+			}},
+			false,
+			func(d interface{}) error {
+				var remote = d.(*Remote)
+				if remote.Daemon.VerifySSL {
+					return fmt.Errorf("value not set (found '%t')", remote.Daemon.VerifySSL)
+				}
+				return nil
+			}},
+		{"ok: set list",
+			args{"ssh.proxy-jump", "bastion,jump@10.0.0.2:2222", &Remote{
+				SSH: &SSH{},
+			}},
+			false,
+			func(d interface{}) error {
+				var remote = d.(*Remote)
+				if len(remote.SSH.ProxyJump) != 2 || remote.SSH.ProxyJump[1] != "jump@10.0.0.2:2222" {
+					return fmt.Errorf("value not set (found '%v')", remote.SSH.ProxyJump)
+				}
+				return nil
+			}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
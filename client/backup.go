@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ubclaunchpad/inertia/api"
+)
+
+// ConfigureBackups updates how backups of persistent data are made on the
+// remote
+func (c *Client) ConfigureBackups(ctx context.Context, conf api.BackupConfiguration) error {
+	resp, err := c.post(ctx, "/backups/config", &conf)
+	if err != nil {
+		return fmt.Errorf("failed to make request: %s", err.Error())
+	}
+
+	base, err := c.unmarshal(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return fmt.Errorf("failed to read response: %s", err.Error())
+	}
+
+	return base.Error()
+}
+
+// GetBackupConfiguration retrieves the backup configuration of the remote, with
+// credentials redacted
+func (c *Client) GetBackupConfiguration(ctx context.Context) (*api.BackupConfiguration, error) {
+	resp, err := c.get(ctx, "/backups/config", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %s", err.Error())
+	}
+
+	var conf api.BackupConfiguration
+	base, err := c.unmarshal(resp.Body, api.KV{Key: "config", Value: &conf})
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %s", err.Error())
+	}
+
+	return &conf, base.Error()
+}
+
+// CreateBackup creates a backup of persistent data on the remote
+func (c *Client) CreateBackup(ctx context.Context) (*api.Backup, error) {
+	resp, err := c.post(ctx, "/backups", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %s", err.Error())
+	}
+
+	var backup api.Backup
+	base, err := c.unmarshal(resp.Body, api.KV{Key: "backup", Value: &backup})
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %s", err.Error())
+	}
+
+	return &backup, base.Error()
+}
+
+// ListBackups lists backups of persistent data on the remote, newest first
+func (c *Client) ListBackups(ctx context.Context) ([]api.Backup, error) {
+	resp, err := c.get(ctx, "/backups", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %s", err.Error())
+	}
+
+	var backups = make([]api.Backup, 0)
+	base, err := c.unmarshal(resp.Body, api.KV{Key: "backups", Value: &backups})
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %s", err.Error())
+	}
+
+	return backups, base.Error()
+}
+
+// RestoreBackup shuts down the project on the remote and replaces its persistent
+// data with the contents of the named backup
+func (c *Client) RestoreBackup(ctx context.Context, name string) error {
+	resp, err := c.post(ctx, "/backups/restore", &api.BackupRequest{Name: name})
+	if err != nil {
+		return fmt.Errorf("failed to make request: %s", err.Error())
+	}
+
+	base, err := c.unmarshal(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return fmt.Errorf("failed to read response: %s", err.Error())
+	}
+
+	return base.Error()
+}
@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-chi/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/res"
+)
+
+func TestClient_ConfigureBackups(t *testing.T) {
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/backups/config", r.URL.Path)
+		assert.Equal(t, "Bearer "+fakeAuth, r.Header.Get("Authorization"))
+		switch r.Method {
+		case "POST":
+			var conf api.BackupConfiguration
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&conf))
+			assert.Equal(t, api.BackupStorageLocal, conf.Storage)
+			assert.Equal(t, 5, conf.Keep)
+			render.Render(w, r, res.MsgOK("backup configuration updated"))
+		case "GET":
+			render.Render(w, r, res.MsgOK("backup configuration retrieved",
+				"config", api.BackupConfiguration{Storage: api.BackupStorageLocal, Keep: 5}))
+		}
+	}))
+	defer testServer.Close()
+
+	var d = newMockClient(t, testServer)
+	assert.NoError(t, d.ConfigureBackups(context.Background(), api.BackupConfiguration{
+		Storage: api.BackupStorageLocal,
+		Keep:    5,
+	}))
+	conf, err := d.GetBackupConfiguration(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 5, conf.Keep)
+}
+
+func TestClient_Backups(t *testing.T) {
+	var backup = api.Backup{
+		Name:      "persist-20201019T120000Z.tar.gz",
+		Size:      128,
+		CreatedAt: time.Date(2020, 10, 19, 12, 0, 0, 0, time.UTC),
+	}
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer "+fakeAuth, r.Header.Get("Authorization"))
+		switch r.URL.Path {
+		case "/backups":
+			if r.Method == "POST" {
+				render.Render(w, r, res.Msg("backup created", http.StatusCreated,
+					"backup", backup))
+			} else {
+				render.Render(w, r, res.MsgOK("backups retrieved",
+					"backups", []api.Backup{backup}))
+			}
+		case "/backups/restore":
+			var req api.BackupRequest
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			if req.Name != backup.Name {
+				render.Render(w, r, res.ErrBadRequest("invalid backup name"))
+				return
+			}
+			render.Render(w, r, res.MsgOK("backup restored"))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer testServer.Close()
+
+	var d = newMockClient(t, testServer)
+	created, err := d.CreateBackup(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, backup.Name, created.Name)
+	assert.True(t, backup.CreatedAt.Equal(created.CreatedAt))
+
+	backups, err := d.ListBackups(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, backups, 1)
+
+	assert.NoError(t, d.RestoreBackup(context.Background(), backup.Name))
+	assert.Error(t, d.RestoreBackup(context.Background(), "nope"))
+}
@@ -103,6 +103,11 @@ Note that by default, you will have to disable SSL verification in your webhook
 settings - Inertia uses self-signed certificates that GitHub won't be able to
 verify. Read more about it here: https://inertia.ubclaunchpad.com/#custom-ssl-certificate
 `, addr, c.Remote.Daemon.WebHookSecret)
+	if c.Remote.Daemon.UseSSHTunnel() {
+		fprintf(out, `:warning: Your daemon is only reachable through an SSH tunnel, so webhooks will
+not be able to reach it unless you expose it through a proxy on your remote.
+`)
+	}
 
 	// pretty divider
 	fmt.Fprint(out, "\n==========================================================\n")
@@ -32,8 +32,10 @@ type Client struct {
 	om  sync.Mutex
 	out io.Writer
 
-	ssh   runner.SSHSession
-	debug bool
+	ssh    runner.SSHSession
+	dial   dialFunc
+	tunnel dialFunc
+	debug  bool
 
 	Remote *cfg.Remote
 }
@@ -63,11 +65,27 @@ func NewClient(remote *cfg.Remote, opts Options) (*Client, error) {
 		}
 	}
 
-	return &Client{
+	var ssh = runner.NewSSHRunner(remote.IP, remote.SSH, opts.SSH)
+	var c = &Client{
 		out:    opts.Out,
 		Remote: remote,
-		ssh:    runner.NewSSHRunner(remote.IP, remote.SSH, opts.SSH),
-	}, nil
+		ssh:    ssh,
+	}
+	if ssh != nil {
+		c.tunnel = ssh.DialContext
+	}
+	if remote.Daemon != nil {
+		if err := remote.Daemon.ValidateTransport(); err != nil {
+			return nil, err
+		}
+		if remote.Daemon.UseSSHTunnel() {
+			if ssh == nil {
+				return nil, errors.New("SSH configuration is required to reach the daemon through an SSH tunnel")
+			}
+			c.dial = sshTunnelDialer(ssh, remote.Daemon.Port)
+		}
+	}
+	return c, nil
 }
 
 // WithWriter sets the given io.Writer as the client's default output
@@ -99,29 +117,57 @@ type UpRequest struct {
 	Project string
 	URL     string
 	Profile cfg.Profile
+
+	// Commit optionally pins the deployment to a specific commit on the
+	// profile's branch
+	Commit string
 }
 
-// Up brings the project up on the remote VPS instance specified
-// in the deployment object.
-func (c *Client) Up(ctx context.Context, req UpRequest) error {
+// logSinks returns the log sinks configured on the requested profile
+func (req UpRequest) logSinks() []api.LogSink {
+	var sinks = make([]api.LogSink, 0, len(req.Profile.LogSinks))
+	for _, s := range req.Profile.LogSinks {
+		if s == nil {
+			continue
+		}
+		sinks = append(sinks, api.LogSink{
+			Type:    s.Type,
+			URL:     s.URL,
+			Headers: s.Headers,
+			Labels:  s.Labels,
+		})
+	}
+	return sinks
+}
+
+// request assembles the daemon's representation of an UpRequest
+func (req UpRequest) request(webhookSecret string, stream bool) *api.UpRequest {
 	notif := req.Profile.Notifiers
 	if notif == nil {
 		notif = &cfg.Notifiers{}
 	}
-
-	resp, err := c.post(ctx, "/up", &api.UpRequest{
-		Stream:        false,
+	return &api.UpRequest{
+		Stream:        stream,
 		Project:       req.Project,
-		WebHookSecret: c.Remote.Daemon.WebHookSecret,
+		Profile:       req.Profile.Name,
+		WebHookSecret: webhookSecret,
 		BuildType:     string(req.Profile.Build.Type),
 		BuildFilePath: req.Profile.Build.BuildFilePath,
 		GitOptions: api.GitOptions{
 			RemoteURL: common.GetSSHRemoteURL(req.URL),
 			Branch:    req.Profile.Branch,
+			Commit:    req.Commit,
 		},
 		IntermediaryContainers: req.Profile.Build.IntermediaryContainers,
 		SlackNotificationURL:   notif.SlackNotificationURL,
-	})
+		LogSinks:               req.logSinks(),
+	}
+}
+
+// Up brings the project up on the remote VPS instance specified
+// in the deployment object.
+func (c *Client) Up(ctx context.Context, req UpRequest) error {
+	resp, err := c.post(ctx, "/up", req.request(c.Remote.Daemon.WebHookSecret, false))
 	if err != nil {
 		return fmt.Errorf("failed to make request: %s", err.Error())
 	}
@@ -135,17 +181,7 @@ func (c *Client) Up(ctx context.Context, req UpRequest) error {
 
 // UpWithOutput blocks and streams 'up' output to the client's io.Writer
 func (c *Client) UpWithOutput(ctx context.Context, req UpRequest) error {
-	resp, err := c.post(ctx, "/up", &api.UpRequest{
-		Stream:        true,
-		Project:       req.Project,
-		WebHookSecret: c.Remote.Daemon.WebHookSecret,
-		BuildType:     string(req.Profile.Build.Type),
-		BuildFilePath: req.Profile.Build.BuildFilePath,
-		GitOptions: api.GitOptions{
-			RemoteURL: common.GetSSHRemoteURL(req.URL),
-			Branch:    req.Profile.Branch,
-		},
-	})
+	resp, err := c.post(ctx, "/up", req.request(c.Remote.Daemon.WebHookSecret, true))
 	if err != nil {
 		return fmt.Errorf("failed to make request: %s", err.Error())
 	}
@@ -179,6 +215,24 @@ func (c *Client) UpWithOutput(ctx context.Context, req UpRequest) error {
 	}
 }
 
+// Plan describes what bringing the project up with the given request would
+// change, without changing the deployment
+func (c *Client) Plan(ctx context.Context, req UpRequest) (*api.DeploymentPlan, error) {
+	resp, err := c.post(ctx, "/plan", req.request("", false))
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %s", err.Error())
+	}
+
+	var plan api.DeploymentPlan
+	base, err := c.unmarshal(resp.Body, api.KV{Key: "plan", Value: &plan})
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %s", err.Error())
+	}
+
+	return &plan, base.Error()
+}
+
 // Token generates token on this remote.
 func (c *Client) Token(ctx context.Context) (token string, err error) {
 	resp, err := c.get(ctx, "/token", nil)
@@ -228,6 +282,23 @@ func (c *Client) Down(ctx context.Context) error {
 	return base.Error()
 }
 
+// Redeploy rebuilds and restarts the active deployment on the remote with its
+// current configuration.
+func (c *Client) Redeploy(ctx context.Context) error {
+	resp, err := c.post(ctx, "/redeploy", nil)
+	if err != nil {
+		return fmt.Errorf("failed to make request: %s", err.Error())
+	}
+
+	base, err := c.unmarshal(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return fmt.Errorf("failed to read response: %s", err.Error())
+	}
+
+	return base.Error()
+}
+
 // Status lists the currently active containers on the remote VPS instance
 func (c *Client) Status(ctx context.Context) (*api.DeploymentStatusWithVersions, error) {
 	resp, err := c.get(ctx, "/status", nil)
@@ -246,6 +317,25 @@ func (c *Client) Status(ctx context.Context) (*api.DeploymentStatusWithVersions,
 	return status, base.Error()
 }
 
+// History lists previous deployments of the project, most recent first
+func (c *Client) History(ctx context.Context) ([]api.Deployment, error) {
+	resp, err := c.get(ctx, "/history", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %s", err.Error())
+	}
+	defer resp.Body.Close()
+
+	var history []api.Deployment
+	base, err := c.unmarshal(resp.Body, api.KV{
+		Key: "history", Value: &history,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %s", err.Error())
+	}
+
+	return history, base.Error()
+}
+
 // Reset shuts down deployment and deletes the contents of the deployment's
 // project directory
 func (c *Client) Reset(ctx context.Context) error {
@@ -267,70 +357,93 @@ func (c *Client) Reset(ctx context.Context) error {
 type LogsRequest struct {
 	Container string
 	Entries   int
+
+	// Containers are additional containers to retrieve logs from, merged in
+	// timestamp order. If All is set, logs are retrieved from every project
+	// container instead.
+	Containers []string
+	All        bool
+
+	// Since and Until restrict logs to a time range, each given as an RFC3339
+	// or Unix timestamp, or as a duration before the present such as "10m"
+	Since string
+	Until string
+
+	// Grep restricts logs to lines that match a regular expression
+	Grep string
+
+	// Level restricts logs to lines at or above a level, such as "warn"
+	Level string
 }
 
-// Logs get logs of given container
-func (c *Client) Logs(ctx context.Context, req LogsRequest) ([]string, error) {
-	reqContent := map[string]string{api.Container: req.Container}
+func (req LogsRequest) params() map[string]string {
+	var params = map[string]string{}
+	if req.All {
+		params[api.All] = "true"
+	} else {
+		var containers []string
+		if req.Container != "" {
+			containers = append(containers, req.Container)
+		}
+		params[api.Container] = strings.Join(append(containers, req.Containers...), ",")
+	}
 	if req.Entries > 0 {
-		reqContent[api.Entries] = strconv.Itoa(req.Entries)
+		params[api.Entries] = strconv.Itoa(req.Entries)
+	}
+	for k, v := range map[string]string{
+		api.Since: req.Since,
+		api.Until: req.Until,
+		api.Grep:  req.Grep,
+		api.Level: req.Level,
+	} {
+		if v != "" {
+			params[k] = v
+		}
 	}
+	return params
+}
 
-	resp, err := c.get(ctx, "/logs", reqContent)
+// Logs get logs of given containers
+func (c *Client) Logs(ctx context.Context, req LogsRequest) ([]api.LogEntry, error) {
+	resp, err := c.get(ctx, "/logs", req.params())
 	if err != nil {
 		return nil, fmt.Errorf("failed to make request: %s", err.Error())
 	}
 
-	var logs = make([]string, 0)
-	b, err := c.unmarshal(resp.Body, api.KV{Key: "logs", Value: &logs})
+	var raw = make([]json.RawMessage, 0)
+	b, err := c.unmarshal(resp.Body, api.KV{Key: "logs", Value: &raw})
 	resp.Body.Close()
 	if err != nil {
 		return nil, fmt.Errorf("failed to read response: %s", err.Error())
 	}
 
+	var logs = make([]api.LogEntry, len(raw))
+	for i, r := range raw {
+		logs[i] = parseLogEntry(r)
+	}
 	return logs, b.Error()
 }
 
 // LogsWithOutput opens a websocket connection to given container's logs and
 // streams it to the given io.Writer
 func (c *Client) LogsWithOutput(ctx context.Context, req LogsRequest) error {
-	addr, err := c.Remote.DaemonAddr()
-	if err != nil {
-		return err
-	}
-	host, err := url.Parse(addr)
-	if err != nil {
-		return fmt.Errorf("invalid daemon address: %s", err.Error())
-	}
-
-	// Set up request
-	var url = &url.URL{Scheme: "wss", Host: host.Host, Path: "/logs"}
-	var params = map[string]string{
-		api.Container: req.Container,
-		api.Stream:    "true",
-	}
-	if req.Entries > 0 {
-		params[api.Entries] = strconv.Itoa(req.Entries)
-	}
-	encodeQuery(url, params)
-
-	// Set up authorization
-	var header = http.Header{}
-	header.Set("Authorization", "Bearer "+c.Remote.Daemon.Token)
+	return c.StreamLogs(ctx, req, func(e api.LogEntry) {
+		c.om.Lock()
+		fmt.Fprintln(c.out, strings.TrimRight(e.Message, "\n"))
+		c.om.Unlock()
+	})
+}
 
-	// set up websocket connection
-	c.debugf("request constructed: %s (authorized: %v, verified: %v)",
-		url.String(), c.Remote.Daemon.Token != "", c.Remote.Daemon.VerifySSL)
-	socket, resp, err := buildWebSocketDialer(c.Remote.Daemon.VerifySSL).
-		DialContext(ctx, url.String(), header)
-	if err == websocket.ErrBadHandshake {
-		return fmt.Errorf("websocket handshake failed with status %d", resp.StatusCode)
-	}
+// StreamLogs opens a websocket connection to the given containers' logs, and
+// calls fn with each entry until ctx is cancelled or the connection closes
+func (c *Client) StreamLogs(ctx context.Context, req LogsRequest, fn func(api.LogEntry)) error {
+	var params = req.params()
+	params[api.Stream] = "true"
+	socket, err := c.dialWebSocket(ctx, "/logs", params)
 	if err != nil {
-		return fmt.Errorf("failed to connect to daemon: %s", err.Error())
+		return err
 	}
 	defer socket.Close()
-	c.debugf("websocket connection established")
 
 	// read from socket until error
 	var errC = make(chan error, 1)
@@ -341,9 +454,7 @@ func (c *Client) LogsWithOutput(ctx context.Context, req LogsRequest) error {
 				errC <- fmt.Errorf("error occured while reading from socket: %s", err.Error())
 				return
 			}
-			c.om.Lock()
-			fmt.Fprint(c.out, string(line))
-			c.om.Unlock()
+			fn(parseLogEntry(line))
 		}
 	}()
 
@@ -360,10 +471,75 @@ func (c *Client) LogsWithOutput(ctx context.Context, req LogsRequest) error {
 	}
 }
 
+// parseLogEntry reads an entry sent by the daemon. Daemons that predate
+// structured logs send plain lines, which are used as the message.
+func parseLogEntry(raw []byte) api.LogEntry {
+	var entry api.LogEntry
+	if err := json.Unmarshal(raw, &entry); err == nil {
+		return entry
+	}
+	var line string
+	if err := json.Unmarshal(raw, &line); err == nil {
+		return api.LogEntry{Message: line}
+	}
+	return api.LogEntry{Message: string(raw)}
+}
+
+// dialWebSocket opens an authorized websocket connection to the given endpoint
+func (c *Client) dialWebSocket(ctx context.Context, endpoint string, params map[string]string) (*websocket.Conn, error) {
+	addr, err := c.Remote.DaemonAddr()
+	if err != nil {
+		return nil, err
+	}
+	host, err := url.Parse(addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid daemon address: %s", err.Error())
+	}
+
+	// Set up request
+	var url = &url.URL{Scheme: "wss", Host: host.Host,
+		Path: path.Join(host.Path, api.PathPrefix, endpoint)}
+	encodeQuery(url, params)
+
+	// Set up authorization
+	var header = http.Header{}
+	header.Set("Authorization", "Bearer "+c.Remote.Daemon.Token)
+
+	// set up websocket connection
+	c.debugf("request constructed: %s (authorized: %v, verified: %v)",
+		url.String(), c.Remote.Daemon.Token != "", c.Remote.Daemon.VerifySSL)
+	socket, resp, err := buildWebSocketDialer(c.Remote.Daemon.VerifySSL, c.dial).
+		DialContext(ctx, url.String(), header)
+	if err == websocket.ErrBadHandshake {
+		if err := checkAPIVersion(resp); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("websocket handshake failed with status %d", resp.StatusCode)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to daemon: %s", err.Error())
+	}
+	c.debugf("websocket connection established")
+	return socket, nil
+}
+
+// EnvScope restricts environment variables to deployments using a specific
+// profile and/or to a specific container or docker-compose service
+type EnvScope struct {
+	Profile   string
+	Container string
+}
+
 // UpdateEnv updates environment variable
 func (c *Client) UpdateEnv(ctx context.Context, name, value string, encrypt, remove bool) error {
+	return c.UpdateScopedEnv(ctx, EnvScope{}, name, value, encrypt, remove)
+}
+
+// UpdateScopedEnv updates an environment variable within the given scope
+func (c *Client) UpdateScopedEnv(ctx context.Context, scope EnvScope, name, value string, encrypt, remove bool) error {
 	resp, err := c.post(ctx, "/env", api.EnvRequest{
 		Name: name, Value: value, Encrypt: encrypt, Remove: remove,
+		Profile: scope.Profile, Container: scope.Container,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to make request: %s", err.Error())
@@ -379,13 +555,13 @@ func (c *Client) UpdateEnv(ctx context.Context, name, value string, encrypt, rem
 }
 
 // ListEnv lists environment variables currently set on remote
-func (c *Client) ListEnv(ctx context.Context) ([]string, error) {
+func (c *Client) ListEnv(ctx context.Context) ([]api.EnvVariable, error) {
 	resp, err := c.get(ctx, "/env", nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make request: %s", err.Error())
 	}
 
-	var variables = make([]string, 0)
+	var variables = make([]api.EnvVariable, 0)
 	base, err := c.unmarshal(resp.Body, api.KV{Key: "variables", Value: &variables})
 	resp.Body.Close()
 	if err != nil {
@@ -395,15 +571,135 @@ func (c *Client) ListEnv(ctx context.Context) ([]string, error) {
 	return variables, base.Error()
 }
 
+// ExportEnv retrieves all environment variables set on remote, encrypted using
+// the given passphrase. Use ImportEnv to load them onto another remote.
+func (c *Client) ExportEnv(ctx context.Context, passphrase string) ([]byte, error) {
+	resp, err := c.post(ctx, "/env/export", api.EnvTransferRequest{
+		Passphrase: passphrase,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %s", err.Error())
+	}
+
+	var data []byte
+	base, err := c.unmarshal(resp.Body, api.KV{Key: "data", Value: &data})
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %s", err.Error())
+	}
+
+	return data, base.Error()
+}
+
+// ImportEnv loads environment variables exported by ExportEnv onto remote, and
+// returns the number of variables imported.
+func (c *Client) ImportEnv(ctx context.Context, passphrase string, data []byte) (int, error) {
+	resp, err := c.post(ctx, "/env/import", api.EnvTransferRequest{
+		Passphrase: passphrase,
+		Data:       data,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to make request: %s", err.Error())
+	}
+
+	var count int
+	base, err := c.unmarshal(resp.Body, api.KV{Key: "count", Value: &count})
+	resp.Body.Close()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read response: %s", err.Error())
+	}
+
+	return count, base.Error()
+}
+
+// RotateEnvKey re-encrypts environment variables on remote with a new key
+func (c *Client) RotateEnvKey(ctx context.Context) error {
+	resp, err := c.post(ctx, "/env/rotate-key", nil)
+	if err != nil {
+		return fmt.Errorf("failed to make request: %s", err.Error())
+	}
+
+	base, err := c.unmarshal(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return fmt.Errorf("failed to read response: %s", err.Error())
+	}
+
+	return base.Error()
+}
+
+// UpdateSecret sets or removes a secret file on remote
+func (c *Client) UpdateSecret(ctx context.Context, name string, value []byte, remove bool) error {
+	resp, err := c.post(ctx, "/secrets", api.SecretRequest{
+		Name: name, Value: value, Remove: remove,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to make request: %s", err.Error())
+	}
+
+	base, err := c.unmarshal(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return fmt.Errorf("failed to read response: %s", err.Error())
+	}
+
+	return base.Error()
+}
+
+// ListSecrets lists the names of secret files currently set on remote
+func (c *Client) ListSecrets(ctx context.Context) ([]string, error) {
+	resp, err := c.get(ctx, "/secrets", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %s", err.Error())
+	}
+
+	var secrets = make([]string, 0)
+	base, err := c.unmarshal(resp.Body, api.KV{Key: "secrets", Value: &secrets})
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %s", err.Error())
+	}
+
+	return secrets, base.Error()
+}
+
 func (c *Client) do(req *http.Request) (*http.Response, error) {
-	resp, err := buildHTTPSClient(c.Remote.Daemon.VerifySSL).Do(req)
+	resp, err := buildHTTPSClient(c.Remote.Daemon.VerifySSL, c.dial).Do(req)
 	if err != nil {
 		// special error handling
 		if strings.Contains(err.Error(), "EOF") || strings.Contains(err.Error(), "refused") {
 			return resp, errors.New("daemon on remote appears offline or inaccessible")
 		}
+		return resp, err
+	}
+	if err := checkAPIVersion(resp); err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	c.debugf("daemon version %s, API version %s",
+		resp.Header.Get(api.DaemonVersionHeader), resp.Header.Get(api.APIVersionHeader))
+	return resp, nil
+}
+
+// checkAPIVersion returns an error if the response indicates that the daemon
+// does not serve the version of the API used by this client. Daemons that
+// predate API versioning do not report their API version at all.
+func checkAPIVersion(resp *http.Response) error {
+	if resp.StatusCode != http.StatusNotFound {
+		return nil
+	}
+	switch version := resp.Header.Get(api.APIVersionHeader); version {
+	case "":
+		return fmt.Errorf("the daemon on your remote is too old to serve API %s, which is used by this "+
+			"version of the Inertia client - try running 'inertia [remote] upgrade' to upgrade it",
+			api.Version)
+	case api.Version:
+		return nil
+	default:
+		return fmt.Errorf("the daemon on your remote (version %s) serves API %s, but this version of "+
+			"the Inertia client uses API %s - try upgrading either the client or the daemon",
+			resp.Header.Get(api.DaemonVersionHeader), version, api.Version)
 	}
-	return resp, err
 }
 
 // Sends a GET request. "queries" contains query string arguments.
@@ -465,7 +761,7 @@ func (c *Client) buildRequest(
 	if err != nil {
 		return nil, fmt.Errorf("invalid url configuration: %s", err.Error())
 	}
-	url.Path = path.Join(url.Path, endpoint)
+	url.Path = path.Join(url.Path, api.PathPrefix, endpoint)
 
 	// Assemble request
 	req, err := http.NewRequest(method, url.String(), payload)
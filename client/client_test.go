@@ -1,11 +1,13 @@
 package client
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -17,6 +19,7 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/assert"
 	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/api/openapi"
 	"github.com/ubclaunchpad/inertia/cfg"
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/res"
 )
@@ -61,6 +64,66 @@ func newMockClient(t *testing.T, ts *httptest.Server) *Client {
 	}
 }
 
+// newMockServer starts a test server that checks requests made to it, and the
+// data returned by handler, against the API's OpenAPI document. Like the
+// daemon, it reports its daemon and API versions in every response.
+func newMockServer(t *testing.T, handler http.Handler) *httptest.Server {
+	doc, err := openapi.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := doc.ValidateRequest(r); err != nil {
+			t.Errorf("request does not match API: %s", err.Error())
+		}
+		w.Header().Set(api.DaemonVersionHeader, "test")
+		w.Header().Set(api.APIVersionHeader, api.Version)
+
+		// handlers see paths as the daemon's routes do, without the prefix
+		var unversioned = r.Clone(r.Context())
+		unversioned.URL.Path = "/" + strings.TrimPrefix(
+			strings.TrimPrefix(r.URL.Path, api.PathPrefix), "/")
+
+		var rec = &specRecorder{ResponseWriter: w, status: http.StatusOK}
+		handler.ServeHTTP(rec, unversioned)
+		if rec.hijacked {
+			return
+		}
+		if err := doc.ValidateResponse(r, rec.status, w.Header(), rec.body.Bytes()); err != nil {
+			t.Errorf("response does not match API: %s", err.Error())
+		}
+	}))
+}
+
+// specRecorder records responses written by handlers given to newMockServer
+type specRecorder struct {
+	http.ResponseWriter
+	status   int
+	body     bytes.Buffer
+	hijacked bool
+}
+
+func (r *specRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *specRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+func (r *specRecorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (r *specRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	r.hijacked = true
+	return r.ResponseWriter.(http.Hijacker).Hijack()
+}
+
 func TestNewClient(t *testing.T) {
 	c, err := NewClient(&cfg.Remote{}, Options{})
 	assert.NoError(t, err)
@@ -88,10 +151,49 @@ func TestNewClient(t *testing.T) {
 		_, err := NewClient(&cfg.Remote{Version: "v0.6.1"}, Options{})
 		assert.Error(t, err)
 	})
+
+	t.Run("with unknown transport", func(t *testing.T) {
+		_, err := NewClient(&cfg.Remote{Daemon: &cfg.Daemon{Transport: "pigeon"}}, Options{})
+		assert.Error(t, err)
+	})
+
+	t.Run("with ssh tunnel transport", func(t *testing.T) {
+		_, err := NewClient(&cfg.Remote{
+			Daemon: &cfg.Daemon{Transport: cfg.TransportSSHTunnel},
+		}, Options{})
+		assert.Error(t, err, "ssh configuration should be required")
+
+		c, err := NewClient(&cfg.Remote{
+			SSH:    &cfg.SSH{},
+			Daemon: &cfg.Daemon{Transport: cfg.TransportSSHTunnel},
+		}, Options{})
+		assert.NoError(t, err)
+		assert.NotNil(t, c.dial)
+	})
+}
+
+func TestClient_dial(t *testing.T) {
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/prune", r.URL.Path)
+		render.Render(w, r, res.MsgOK("uwu"))
+	}))
+	defer testServer.Close()
+
+	// requests should be sent through the configured dialer, regardless of the
+	// remote's address
+	var d = newMockClient(t, testServer)
+	d.Remote.IP = "inertia.invalid"
+	var dialed int
+	d.dial = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		dialed++
+		return net.Dial(network, testServer.Listener.Addr().String())
+	}
+	assert.NoError(t, d.Prune(context.Background()))
+	assert.Equal(t, 1, dialed)
 }
 
 func TestClient_Up(t *testing.T) {
-	testServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
 		// Check request method
 		assert.Equal(t, "POST", r.Method)
@@ -107,6 +209,7 @@ func TestClient_Up(t *testing.T) {
 		assert.Equal(t, "arjan", upReq.WebHookSecret)
 		assert.Equal(t, "test_project", upReq.Project)
 		assert.Equal(t, "docker-compose", upReq.BuildType)
+		assert.Equal(t, []api.LogSink{{Type: api.LogSinkSyslog, URL: "tcp://127.0.0.1:514"}}, upReq.LogSinks)
 
 		// Check correct endpoint called
 		assert.Equal(t, "/up", r.URL.Path)
@@ -119,15 +222,22 @@ func TestClient_Up(t *testing.T) {
 
 	var d = newMockClient(t, testServer)
 	assert.False(t, d.Remote.Daemon.VerifySSL)
-	assert.NoError(t, d.Up(context.Background(), UpRequest{"test_project", "myremote.git", cfg.Profile{
+	assert.NoError(t, d.Up(context.Background(), UpRequest{Project: "test_project", URL: "myremote.git", Profile: cfg.Profile{
 		Build: &cfg.Build{
 			Type: cfg.DockerCompose,
 		},
+		LogSinks: []*cfg.LogSink{{Type: "syslog", URL: "tcp://127.0.0.1:514"}},
 	}}))
 }
 
 func TestClient_UpWithOutput(t *testing.T) {
-	testServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var upReq api.UpRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&upReq))
+		assert.True(t, upReq.Stream)
+		assert.Equal(t, []string{"migrate"}, upReq.IntermediaryContainers)
+		assert.Equal(t, "https://hooks.slack.com/services/wow", upReq.SlackNotificationURL)
+
 		fmt.Fprintln(w, "hello")
 		time.Sleep(10 * time.Millisecond)
 		fmt.Fprintln(w, "world")
@@ -146,17 +256,51 @@ func TestClient_UpWithOutput(t *testing.T) {
 		time.Sleep(1 * time.Second)
 		cancel()
 	}()
-	assert.NoError(t, d.UpWithOutput(ctx, UpRequest{"test_project", "myremote.git", cfg.Profile{
+	assert.NoError(t, d.UpWithOutput(ctx, UpRequest{Project: "test_project", URL: "myremote.git", Profile: cfg.Profile{
 		Build: &cfg.Build{
-			Type: cfg.DockerCompose,
+			Type:                   cfg.DockerCompose,
+			IntermediaryContainers: []string{"migrate"},
 		},
+		Notifiers: &cfg.Notifiers{SlackNotificationURL: "https://hooks.slack.com/services/wow"},
 	}}))
 	assert.Contains(t, buf.String(), "hello\nworld")
 	assert.Contains(t, buf.String(), "chicken rice")
 }
 
+func TestClient_Plan(t *testing.T) {
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/plan", r.URL.Path)
+
+		var upReq api.UpRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&upReq))
+		assert.Equal(t, "test_project", upReq.Project)
+		assert.Equal(t, "dev", upReq.GitOptions.Branch)
+		assert.Empty(t, upReq.WebHookSecret)
+
+		render.Render(w, r, res.MsgOK("deployment planned", "plan", api.DeploymentPlan{
+			Current: &api.Commit{Hash: "1234"},
+			Target:  api.Commit{Hash: "5678", Author: "bob"},
+			Commits: []api.Commit{{Hash: "5678", Author: "bob"}},
+		}))
+	}))
+	defer testServer.Close()
+
+	var d = newMockClient(t, testServer)
+	plan, err := d.Plan(context.Background(), UpRequest{Project: "test_project", URL: "myremote.git", Profile: cfg.Profile{
+		Branch: "dev",
+		Build: &cfg.Build{
+			Type: cfg.DockerCompose,
+		},
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, "1234", plan.Current.Hash)
+	assert.Equal(t, "5678", plan.Target.Hash)
+	assert.Len(t, plan.Commits, 1)
+}
+
 func TestClient_Prune(t *testing.T) {
-	testServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
 		// Check request method
 		assert.Equal(t, "POST", r.Method)
@@ -175,8 +319,21 @@ func TestClient_Prune(t *testing.T) {
 	assert.NoError(t, d.Prune(context.Background()))
 }
 
+func TestClient_Redeploy(t *testing.T) {
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/redeploy", r.URL.Path)
+		assert.Equal(t, "Bearer "+fakeAuth, r.Header.Get("Authorization"))
+		render.Render(w, r, res.Msg("Project startup initiated!", http.StatusCreated))
+	}))
+	defer testServer.Close()
+
+	var d = newMockClient(t, testServer)
+	assert.NoError(t, d.Redeploy(context.Background()))
+}
+
 func TestClient_Down(t *testing.T) {
-	testServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
 		// Check request method
 		assert.Equal(t, "POST", r.Method)
@@ -197,7 +354,7 @@ func TestClient_Down(t *testing.T) {
 
 func TestClient_Status(t *testing.T) {
 	t.Run("daemon online", func(t *testing.T) {
-		testServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
 			// Check request method
 			assert.Equal(t, "GET", r.Method)
@@ -227,8 +384,28 @@ func TestClient_Status(t *testing.T) {
 	})
 }
 
+func TestClient_History(t *testing.T) {
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/history", r.URL.Path)
+		render.Render(w, r, res.MsgOK("deployment history retrieved",
+			"history", []api.Deployment{{
+				Branch:     "master",
+				CommitHash: "5678",
+				Changes:    []api.Commit{{Hash: "5678", Author: "bob", Message: "fix things"}},
+			}}))
+	}))
+	defer testServer.Close()
+
+	var d = newMockClient(t, testServer)
+	history, err := d.History(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, history, 1)
+	assert.Equal(t, "bob", history[0].Changes[0].Author)
+}
+
 func TestClient_Reset(t *testing.T) {
-	testServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
 		// Check request method
 		assert.Equal(t, "POST", r.Method)
@@ -248,7 +425,8 @@ func TestClient_Reset(t *testing.T) {
 }
 
 func TestClient_Logs(t *testing.T) {
-	testServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	var entries = []api.LogEntry{{Stream: "stdout", Message: "hello"}, {Stream: "stdout", Message: "world"}}
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
 		// Check request method
 		assert.Equal(t, "GET", r.Method)
@@ -266,20 +444,58 @@ func TestClient_Logs(t *testing.T) {
 		assert.Equal(t, "Bearer "+fakeAuth, r.Header.Get("Authorization"))
 
 		// return response
-		render.Render(w, r, res.MsgOK("logs retrieved",
-			"logs", []string{"hello", "world"}))
+		render.Render(w, r, res.MsgOK("logs retrieved", "logs", entries))
+	}))
+	defer testServer.Close()
+
+	var d = newMockClient(t, testServer)
+	logs, err := d.Logs(context.Background(), LogsRequest{Container: "docker-compose", Entries: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, entries, logs)
+}
+
+func TestParseLogEntry(t *testing.T) {
+	assert.Equal(t, api.LogEntry{Container: "web", Message: "hello"},
+		parseLogEntry([]byte(`{"container":"web","message":"hello"}`)))
+
+	// older daemons send lines, as JSON strings or as plain text
+	assert.Equal(t, api.LogEntry{Message: "hello"}, parseLogEntry([]byte(`"hello"`)))
+	assert.Equal(t, api.LogEntry{Message: "hello world"}, parseLogEntry([]byte("hello world")))
+}
+
+func TestClient_LogsFiltered(t *testing.T) {
+	var entries = []api.LogEntry{
+		{Time: time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC), Container: "web", Stream: "stderr", Message: "level=warn msg=slow"},
+	}
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "web,db", q.Get(api.Container))
+		assert.Equal(t, "10m", q.Get(api.Since))
+		assert.Equal(t, "", q.Get(api.Until))
+		assert.Equal(t, "slow|timeout", q.Get(api.Grep))
+		assert.Equal(t, "warn", q.Get(api.Level))
+		assert.Equal(t, "", q.Get(api.All))
+		render.Render(w, r, res.MsgOK("logs retrieved", "logs", entries))
 	}))
 	defer testServer.Close()
 
 	var d = newMockClient(t, testServer)
-	logs, err := d.Logs(context.Background(), LogsRequest{"docker-compose", 10})
+	logs, err := d.Logs(context.Background(), LogsRequest{
+		Containers: []string{"web", "db"},
+		Since:      "10m",
+		Grep:       "slow|timeout",
+		Level:      "warn",
+	})
 	assert.NoError(t, err)
-	assert.Equal(t, []string{"hello", "world"}, logs)
+	assert.Equal(t, entries, logs)
+
+	// all containers
+	assert.Equal(t, map[string]string{api.All: "true"}, LogsRequest{All: true, Container: "web"}.params())
 }
 
 func TestClient_LogsWithOutput(t *testing.T) {
 	t.Run("daemon online", func(t *testing.T) {
-		testServer := httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		testServer := newMockServer(t, http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 			// Check request method
 			assert.Equal(t, "GET", req.Method)
 
@@ -315,7 +531,7 @@ func TestClient_LogsWithOutput(t *testing.T) {
 			time.Sleep(1 * time.Second)
 			cancel()
 		}()
-		assert.NoError(t, d.LogsWithOutput(ctx, LogsRequest{"docker-compose", 10}))
+		assert.NoError(t, d.LogsWithOutput(ctx, LogsRequest{Container: "docker-compose", Entries: 10}))
 		assert.Contains(t, buf.String(), "hello world")
 	})
 
@@ -325,7 +541,7 @@ func TestClient_LogsWithOutput(t *testing.T) {
 		testServer.Close()
 
 		var d = newMockClient(t, testServer)
-		var err = d.LogsWithOutput(context.Background(), LogsRequest{"docker-compose", 10})
+		var err = d.LogsWithOutput(context.Background(), LogsRequest{Container: "docker-compose", Entries: 10})
 		assert.Error(t, err)
 		assert.True(t,
 			strings.Contains(err.Error(), "connect: connection refused") ||
@@ -334,7 +550,7 @@ func TestClient_LogsWithOutput(t *testing.T) {
 }
 
 func TestClient_UpdateEnv(t *testing.T) {
-	testServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
 		// Check request method
 		assert.Equal(t, "POST", r.Method)
@@ -354,7 +570,7 @@ func TestClient_UpdateEnv(t *testing.T) {
 }
 
 func TestClient_ListEnv(t *testing.T) {
-	testServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
 		// Check request method
 		assert.Equal(t, "GET", r.Method)
@@ -366,18 +582,124 @@ func TestClient_ListEnv(t *testing.T) {
 		// Check auth
 		assert.Equal(t, "Bearer "+fakeAuth, r.Header.Get("Authorization"))
 		render.Render(w, r, res.Msg("configured environment variables retrieved", http.StatusOK,
-			"variables", []string{"hello", "world"}))
+			"variables", []api.EnvVariable{{Name: "hello", Value: "world"}, {Name: "wow", Encrypted: true}}))
 	}))
 	defer testServer.Close()
 
 	var d = newMockClient(t, testServer)
 	envs, err := d.ListEnv(context.Background())
 	assert.NoError(t, err)
-	assert.Equal(t, []string{"hello", "world"}, envs)
+	assert.Equal(t, []api.EnvVariable{{Name: "hello", Value: "world"}, {Name: "wow", Encrypted: true}}, envs)
+}
+
+func TestClient_ExportImportEnv(t *testing.T) {
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		// Check request method
+		assert.Equal(t, "POST", r.Method)
+
+		// Check auth
+		assert.Equal(t, "Bearer "+fakeAuth, r.Header.Get("Authorization"))
+
+		// Check request body
+		var req api.EnvTransferRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "hunter7", req.Passphrase)
+
+		switch r.URL.Path {
+		case "/env/export":
+			render.Render(w, r, res.MsgOK("environment variables exported",
+				"data", []byte("sekret")))
+		case "/env/import":
+			assert.Equal(t, []byte("sekret"), req.Data)
+			render.Render(w, r, res.Msg("environment variables imported", http.StatusAccepted,
+				"count", 2))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer testServer.Close()
+
+	var d = newMockClient(t, testServer)
+	data, err := d.ExportEnv(context.Background(), "hunter7")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("sekret"), data)
+
+	count, err := d.ImportEnv(context.Background(), "hunter7", data)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestClient_RotateEnvKey(t *testing.T) {
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		// Check request method
+		assert.Equal(t, "POST", r.Method)
+
+		// Check correct endpoint called
+		assert.Equal(t, "/env/rotate-key", r.URL.Path)
+
+		// Check auth
+		assert.Equal(t, "Bearer "+fakeAuth, r.Header.Get("Authorization"))
+
+		render.Render(w, r, res.MsgOK("uwu"))
+	}))
+	defer testServer.Close()
+
+	d := newMockClient(t, testServer)
+	assert.NoError(t, d.RotateEnvKey(context.Background()))
+}
+
+func TestClient_UpdateSecret(t *testing.T) {
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		// Check request method
+		assert.Equal(t, "POST", r.Method)
+
+		// Check correct endpoint called
+		assert.Equal(t, "/secrets", r.URL.Path)
+
+		// Check auth
+		assert.Equal(t, "Bearer "+fakeAuth, r.Header.Get("Authorization"))
+
+		// Check request body
+		var req api.SecretRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "tls.key", req.Name)
+		assert.Equal(t, []byte("sekret"), req.Value)
+
+		render.Render(w, r, res.MsgOK("uwu"))
+	}))
+	defer testServer.Close()
+
+	d := newMockClient(t, testServer)
+	assert.NoError(t, d.UpdateSecret(context.Background(), "tls.key", []byte("sekret"), false))
+}
+
+func TestClient_ListSecrets(t *testing.T) {
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		// Check request method
+		assert.Equal(t, "GET", r.Method)
+
+		// Check correct endpoint called
+		assert.Equal(t, "/secrets", r.URL.Path)
+
+		// Check auth
+		assert.Equal(t, "Bearer "+fakeAuth, r.Header.Get("Authorization"))
+		render.Render(w, r, res.Msg("configured secrets retrieved", http.StatusOK,
+			"secrets", []string{"sa.json", "tls.key"}))
+	}))
+	defer testServer.Close()
+
+	var d = newMockClient(t, testServer)
+	secrets, err := d.ListSecrets(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"sa.json", "tls.key"}, secrets)
 }
 
 func TestClient_Token(t *testing.T) {
-	testServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
 		// Check request method
 		assert.Equal(t, http.MethodGet, r.Method)
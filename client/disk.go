@@ -0,0 +1,41 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ubclaunchpad/inertia/api"
+)
+
+// ConfigureDisk updates how the remote responds to low disk space
+func (c *Client) ConfigureDisk(ctx context.Context, conf api.DiskConfiguration) error {
+	resp, err := c.post(ctx, "/disk/config", &conf)
+	if err != nil {
+		return fmt.Errorf("failed to make request: %s", err.Error())
+	}
+
+	base, err := c.unmarshal(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return fmt.Errorf("failed to read response: %s", err.Error())
+	}
+
+	return base.Error()
+}
+
+// GetDiskConfiguration retrieves the disk monitoring configuration of the remote
+func (c *Client) GetDiskConfiguration(ctx context.Context) (*api.DiskConfiguration, error) {
+	resp, err := c.get(ctx, "/disk/config", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %s", err.Error())
+	}
+
+	var conf api.DiskConfiguration
+	base, err := c.unmarshal(resp.Body, api.KV{Key: "config", Value: &conf})
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %s", err.Error())
+	}
+
+	return &conf, base.Error()
+}
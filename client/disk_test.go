@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/go-chi/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/res"
+)
+
+func TestClient_ConfigureDisk(t *testing.T) {
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/disk/config", r.URL.Path)
+		assert.Equal(t, "Bearer "+fakeAuth, r.Header.Get("Authorization"))
+		switch r.Method {
+		case "POST":
+			var conf api.DiskConfiguration
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&conf))
+			assert.Equal(t, "5GiB", conf.MinimumFree)
+			render.Render(w, r, res.MsgOK("disk configuration updated"))
+		case "GET":
+			render.Render(w, r, res.MsgOK("disk configuration retrieved",
+				"config", api.DiskConfiguration{MinimumFree: "5GiB"}))
+		}
+	}))
+	defer testServer.Close()
+
+	var d = newMockClient(t, testServer)
+	assert.NoError(t, d.ConfigureDisk(context.Background(), api.DiskConfiguration{
+		MinimumFree: "5GiB",
+	}))
+	conf, err := d.GetDiskConfiguration(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "5GiB", conf.MinimumFree)
+}
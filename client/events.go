@@ -0,0 +1,88 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ubclaunchpad/inertia/api"
+)
+
+// Events opens a stream of events from the remote, optionally restricted to
+// the given event types or prefixes such as "build". Events are sent on the
+// returned channel, which is closed when ctx is cancelled or the stream ends.
+// At most one error is sent if the stream could not be opened or read.
+func (c *Client) Events(ctx context.Context, types ...string) (<-chan api.Event, <-chan error) {
+	var (
+		eventsC = make(chan api.Event)
+		errC    = make(chan error, 1)
+	)
+	go func() {
+		defer close(eventsC)
+		defer close(errC)
+		if err := c.readEvents(ctx, types, eventsC); err != nil && ctx.Err() == nil {
+			c.debugf("error received: %s", err.Error())
+			errC <- err
+		}
+	}()
+	return eventsC, errC
+}
+
+// readEvents reads server-sent events from the remote until an error occurs,
+// sending each one to eventsC
+func (c *Client) readEvents(ctx context.Context, types []string, eventsC chan<- api.Event) error {
+	req, err := c.buildRequest(ctx, "GET", "/events", nil)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept", "text/event-stream")
+	if len(types) > 0 {
+		encodeQuery(req.URL, map[string]string{api.Type: strings.Join(types, ",")})
+	}
+	resp, err := c.do(req)
+	if err != nil {
+		return fmt.Errorf("failed to make request: %s", err.Error())
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		base, err := c.unmarshal(resp.Body)
+		if err != nil {
+			return fmt.Errorf("failed to read response: %s", err.Error())
+		}
+		return base.Error()
+	}
+
+	// each event is a set of fields terminated by a blank line - only the data
+	// field is needed, since it holds the entire event
+	var (
+		scan = bufio.NewScanner(resp.Body)
+		data strings.Builder
+	)
+	for scan.Scan() {
+		var line = scan.Text()
+		switch {
+		case strings.HasPrefix(line, "data:"):
+			data.WriteString(strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		case line == "" && data.Len() > 0:
+			var event api.Event
+			if err := json.Unmarshal([]byte(data.String()), &event); err != nil {
+				return fmt.Errorf("invalid event received: %s", err.Error())
+			}
+			data.Reset()
+			select {
+			case eventsC <- event:
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	}
+	if err := scan.Err(); err != nil {
+		return fmt.Errorf("error occured while reading events: %s", err.Error())
+	}
+	return errors.New("event stream closed by remote")
+}
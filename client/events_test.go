@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-chi/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/res"
+)
+
+func TestClient_Events(t *testing.T) {
+	var testEvent = api.Event{
+		ID:      3,
+		Type:    api.EventBuildStarted,
+		Time:    time.Date(2020, 10, 19, 12, 0, 0, 0, time.UTC),
+		Message: "build started",
+		Data:    map[string]string{"branch": "master"},
+	}
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/events", r.URL.Path)
+		assert.Equal(t, "build,env", r.URL.Query().Get(api.Type))
+		assert.Equal(t, "Bearer "+fakeAuth, r.Header.Get("Authorization"))
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		b, _ := json.Marshal(testEvent)
+		fmt.Fprint(w, ": keep-alive\n\n")
+		fmt.Fprintf(w, "id: 3\nevent: %s\ndata: %s\n\n", testEvent.Type, b)
+		fmt.Fprintf(w, "id: 4\nevent: %s\ndata: %s\n\n", testEvent.Type, b)
+	}))
+	defer testServer.Close()
+
+	var d = newMockClient(t, testServer)
+	events, errs := d.Events(context.Background(), "build", "env")
+	var received []api.Event
+	for e := range events {
+		received = append(received, e)
+	}
+	require.Len(t, received, 2)
+	assert.Equal(t, testEvent, received[0])
+	assert.Error(t, <-errs, "stream closed by the server should be reported")
+}
+
+func TestClient_Events_cancel(t *testing.T) {
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}))
+	defer testServer.Close()
+
+	var d = newMockClient(t, testServer)
+	ctx, cancel := context.WithCancel(context.Background())
+	events, errs := d.Events(ctx)
+	cancel()
+	for range events {
+	}
+	assert.NoError(t, <-errs)
+}
+
+func TestClient_Events_unauthorized(t *testing.T) {
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		render.Render(w, r, res.ErrUnauthorized("invalid token"))
+	}))
+	defer testServer.Close()
+
+	var d = newMockClient(t, testServer)
+	events, errs := d.Events(context.Background())
+	_, open := <-events
+	assert.False(t, open)
+	err := <-errs
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid token")
+}
@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/ubclaunchpad/inertia/api"
+)
+
+// ContainerAddress retrieves the address of the given container on the remote
+func (c *Client) ContainerAddress(ctx context.Context, container string) (string, error) {
+	resp, err := c.get(ctx, "/containers/address", map[string]string{
+		api.Container: container,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to make request: %s", err.Error())
+	}
+
+	var addr string
+	base, err := c.unmarshal(resp.Body, api.KV{Key: "address", Value: &addr})
+	resp.Body.Close()
+	if err != nil {
+		return "", fmt.Errorf("failed to read response: %s", err.Error())
+	}
+
+	return addr, base.Error()
+}
+
+// ForwardRequest denotes parameters for forwarding a local port to a container
+type ForwardRequest struct {
+	Container string
+	Port      string
+
+	// Listener accepts the local connections to forward
+	Listener net.Listener
+}
+
+// Forward resolves the address of the requested container and forwards
+// connections accepted on the request's listener to it over SSH, until the
+// given context is cancelled. The listener is closed when Forward returns.
+func (c *Client) Forward(ctx context.Context, req ForwardRequest) error {
+	defer req.Listener.Close()
+	if c.tunnel == nil {
+		return errors.New("client not configured for SSH access")
+	}
+
+	ip, err := c.ContainerAddress(ctx, req.Container)
+	if err != nil {
+		return err
+	}
+	var target = net.JoinHostPort(ip, req.Port)
+	c.debugf("forwarding %s to %s (%s)", req.Listener.Addr(), req.Container, target)
+
+	// close the listener to unblock Accept when we are done
+	var done = make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			req.Listener.Close()
+		case <-done:
+		}
+	}()
+
+	var conns sync.WaitGroup
+	defer conns.Wait()
+	for {
+		conn, err := req.Listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		conns.Add(1)
+		go func() {
+			defer conns.Done()
+			c.forwardConn(ctx, conn, target)
+		}()
+	}
+}
+
+// forwardConn pipes the given local connection to the target address on the
+// remote until either side closes or the context is cancelled
+func (c *Client) forwardConn(ctx context.Context, local net.Conn, target string) {
+	defer local.Close()
+	remote, err := c.tunnel(ctx, "tcp", target)
+	if err != nil {
+		fmt.Fprintf(c.out, "failed to connect to %s: %s\n", target, err.Error())
+		return
+	}
+	defer remote.Close()
+
+	var closed = make(chan struct{}, 2)
+	go func() { io.Copy(remote, local); closed <- struct{}{} }()
+	go func() { io.Copy(local, remote); closed <- struct{}{} }()
+	select {
+	case <-closed:
+	case <-ctx.Done():
+	}
+}
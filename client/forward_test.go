@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/go-chi/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/res"
+)
+
+func TestClient_ContainerAddress(t *testing.T) {
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/containers/address", r.URL.Path)
+		assert.Equal(t, "Bearer "+fakeAuth, r.Header.Get("Authorization"))
+		if r.URL.Query().Get(api.Container) != "db" {
+			render.Render(w, r, res.ErrNotFound("no such container"))
+			return
+		}
+		render.Render(w, r, res.MsgOK("container address resolved",
+			"address", "172.17.0.2"))
+	}))
+	defer testServer.Close()
+
+	var d = newMockClient(t, testServer)
+	addr, err := d.ContainerAddress(context.Background(), "db")
+	assert.NoError(t, err)
+	assert.Equal(t, "172.17.0.2", addr)
+
+	_, err = d.ContainerAddress(context.Background(), "web")
+	assert.Error(t, err)
+}
+
+func TestClient_Forward(t *testing.T) {
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		render.Render(w, r, res.MsgOK("container address resolved",
+			"address", "127.0.0.1"))
+	}))
+	defer testServer.Close()
+
+	// stand-in for the container, echoing everything it receives
+	echo, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer echo.Close()
+	go func() {
+		for {
+			conn, err := echo.Accept()
+			if err != nil {
+				return
+			}
+			go func() { io.Copy(conn, conn); conn.Close() }()
+		}
+	}()
+	_, port, _ := net.SplitHostPort(echo.Addr().String())
+
+	t.Run("no ssh", func(t *testing.T) {
+		var d = newMockClient(t, testServer)
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		assert.Error(t, d.Forward(context.Background(), ForwardRequest{
+			Container: "db", Port: port, Listener: l}))
+	})
+
+	t.Run("forward until cancelled", func(t *testing.T) {
+		var d = newMockClient(t, testServer)
+		var dialer net.Dialer
+		d.tunnel = dialer.DialContext
+
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		ctx, cancel := context.WithCancel(context.Background())
+		var errC = make(chan error)
+		go func() {
+			errC <- d.Forward(ctx, ForwardRequest{
+				Container: "db", Port: port, Listener: l})
+		}()
+
+		conn, err := net.Dial("tcp", l.Addr().String())
+		require.NoError(t, err)
+		_, err = conn.Write([]byte("hello"))
+		assert.NoError(t, err)
+		var buf = make([]byte, 5)
+		_, err = io.ReadFull(conn, buf)
+		assert.NoError(t, err)
+		assert.Equal(t, "hello", string(buf))
+
+		cancel()
+		assert.NoError(t, <-errC)
+		conn.Close()
+	})
+}
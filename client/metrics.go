@@ -0,0 +1,42 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ubclaunchpad/inertia/api"
+)
+
+// ConfigureMetrics updates who may access the remote's metrics endpoint
+func (c *Client) ConfigureMetrics(ctx context.Context, conf api.MetricsConfiguration) error {
+	resp, err := c.post(ctx, "/metrics/config", &conf)
+	if err != nil {
+		return fmt.Errorf("failed to make request: %s", err.Error())
+	}
+
+	base, err := c.unmarshal(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return fmt.Errorf("failed to read response: %s", err.Error())
+	}
+
+	return base.Error()
+}
+
+// GetMetricsConfiguration retrieves the metrics configuration of the remote,
+// with the scrape token redacted
+func (c *Client) GetMetricsConfiguration(ctx context.Context) (*api.MetricsConfiguration, error) {
+	resp, err := c.get(ctx, "/metrics/config", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %s", err.Error())
+	}
+
+	var conf api.MetricsConfiguration
+	base, err := c.unmarshal(resp.Body, api.KV{Key: "config", Value: &conf})
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %s", err.Error())
+	}
+
+	return &conf, base.Error()
+}
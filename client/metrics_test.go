@@ -0,0 +1,41 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/go-chi/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/res"
+)
+
+func TestClient_ConfigureMetrics(t *testing.T) {
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/metrics/config", r.URL.Path)
+		assert.Equal(t, "Bearer "+fakeAuth, r.Header.Get("Authorization"))
+		switch r.Method {
+		case "POST":
+			var conf api.MetricsConfiguration
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&conf))
+			assert.Equal(t, "scrapetoken", conf.ScrapeToken)
+			assert.Equal(t, []string{"10.0.0.0/8"}, conf.AllowedNetworks)
+			render.Render(w, r, res.MsgOK("metrics configuration updated"))
+		case "GET":
+			render.Render(w, r, res.MsgOK("metrics configuration retrieved",
+				"config", api.MetricsConfiguration{ScrapeToken: "<redacted>", AllowedNetworks: []string{"10.0.0.0/8"}}))
+		}
+	}))
+	defer testServer.Close()
+
+	var d = newMockClient(t, testServer)
+	assert.NoError(t, d.ConfigureMetrics(context.Background(), api.MetricsConfiguration{
+		ScrapeToken:     "scrapetoken",
+		AllowedNetworks: []string{"10.0.0.0/8"},
+	}))
+	conf, err := d.GetMetricsConfiguration(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.0/8"}, conf.AllowedNetworks)
+}
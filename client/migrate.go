@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/common"
+)
+
+// Migrate exports the daemon state of this client's remote - users, project
+// data, deploy key, and persistent data - and imports it on the target's remote.
+// The state is streamed between the remotes, encrypted with a one-time
+// passphrase.
+func (c *Client) Migrate(ctx context.Context, target *Client) (*api.MigrationManifest, error) {
+	passphrase, err := common.GenerateRandomString()
+	if err != nil {
+		return nil, err
+	}
+
+	// request state from source
+	export, err := c.post(ctx, "/migrate/export", &api.MigrationRequest{Passphrase: passphrase})
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request to '%s': %s", c.Remote.Name, err.Error())
+	}
+	defer export.Body.Close()
+	if export.StatusCode != http.StatusOK {
+		base, err := c.unmarshal(export.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response from '%s': %s", c.Remote.Name, err.Error())
+		}
+		if err = base.Error(); err == nil {
+			err = fmt.Errorf("unexpected status %d", export.StatusCode)
+		}
+		return nil, fmt.Errorf("failed to export state from '%s': %w", c.Remote.Name, err)
+	}
+
+	// stream it to the target
+	req, err := target.buildRequest(ctx, "POST", "/migrate/import", export.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set(api.MigrationPassphraseHeader, passphrase)
+	resp, err := target.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request to '%s': %s", target.Remote.Name, err.Error())
+	}
+
+	var manifest api.MigrationManifest
+	base, err := target.unmarshal(resp.Body, api.KV{Key: "manifest", Value: &manifest})
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response from '%s': %s", target.Remote.Name, err.Error())
+	}
+	if err := base.Error(); err != nil {
+		return nil, fmt.Errorf("failed to import state to '%s': %w", target.Remote.Name, err)
+	}
+	return &manifest, nil
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/go-chi/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/res"
+)
+
+func TestClient_Migrate(t *testing.T) {
+	var passphrase string
+	source := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/migrate/export", r.URL.Path)
+		assert.Equal(t, "Bearer "+fakeAuth, r.Header.Get("Authorization"))
+		var req api.MigrationRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if passphrase == "fail" {
+			render.Render(w, r, res.ErrInternalServer("failed to export", nil))
+			return
+		}
+		passphrase = req.Passphrase
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Write([]byte("bundle"))
+	}))
+	defer source.Close()
+	target := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/migrate/import", r.URL.Path)
+		assert.Equal(t, passphrase, r.Header.Get(api.MigrationPassphraseHeader))
+		body, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "bundle", string(body))
+		render.Render(w, r, res.MsgOK("state imported",
+			"manifest", api.MigrationManifest{
+				Version:    1,
+				Deployment: api.DeploymentStatus{CommitHash: "abcde"},
+			}))
+	}))
+	defer target.Close()
+
+	var src, dst = newMockClient(t, source), newMockClient(t, target)
+	manifest, err := src.Migrate(context.Background(), dst)
+	require.NoError(t, err)
+	assert.NotEmpty(t, passphrase)
+	assert.Equal(t, "abcde", manifest.Deployment.CommitHash)
+
+	passphrase = "fail"
+	_, err = src.Migrate(context.Background(), dst)
+	assert.Error(t, err)
+}
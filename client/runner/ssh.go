@@ -2,15 +2,22 @@ package runner
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
+	"sync"
 
 	"github.com/ubclaunchpad/inertia/cfg"
 	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/crypto/ssh/knownhosts"
 )
 
 // SSHSession can run remote commands over SSH
@@ -29,7 +36,17 @@ type SSHRunner struct {
 	ip      string
 	sshPort string
 
-	ident identity
+	ident     identity
+	agent     agentOptions
+	jumpHosts []sshHost
+
+	hostKey        string
+	onNewHostKey   func(string) error
+	knownHostsFile string
+
+	// tunnel is a persistent connection used by DialContext
+	tunnel  *ssh.Client
+	tunnelM sync.Mutex
 }
 
 // Identity denotes SSH identity options
@@ -38,30 +55,89 @@ type identity struct {
 	passphrase string
 }
 
+// agentOptions denotes ssh-agent options
+type agentOptions struct {
+	enabled bool
+	forward bool
+	socket  string
+	client  agent.ExtendedAgent
+}
+
 // SSHOptions denotes options for the SSH runner
 type SSHOptions struct {
 	KeyPassphrase string
+
+	// OnNewHostKey is called with the remote's host key, in authorized_keys
+	// format, the first time a remote with no recorded host key is connected to.
+	// It should persist the key so that it can be verified on later connections.
+	OnNewHostKey func(hostKey string) error
 }
 
-// NewSSHRunner returns a new SSHRunner
+// NewSSHRunner returns a new SSHRunner. Options not set in the given
+// configuration are read from ~/.ssh/config.
 func NewSSHRunner(ip string, cfg *cfg.SSH, opts SSHOptions) *SSHRunner {
 	if cfg != nil {
-		return &SSHRunner{
-			ip:      ip,
-			user:    cfg.User,
-			sshPort: cfg.SSHPort,
-			ident: identity{
-				filePath:   cfg.IdentityFile,
-				passphrase: opts.KeyPassphrase,
-			},
-		}
+		return newSSHRunner(ip, cfg, opts, loadUserSSHConfig())
 	}
 	return nil
 }
 
+func newSSHRunner(ip string, cfg *cfg.SSH, opts SSHOptions, conf *sshConfig) *SSHRunner {
+	var alias = firstOf(cfg.HostAlias, ip)
+	var r = &SSHRunner{
+		ip:      firstOf(conf.get(alias, "HostName"), ip),
+		user:    firstOf(cfg.User, conf.get(alias, "User")),
+		sshPort: firstOf(cfg.SSHPort, conf.get(alias, "Port"), "22"),
+		ident: identity{
+			filePath:   firstOf(cfg.IdentityFile, conf.getPath(alias, "IdentityFile")),
+			passphrase: opts.KeyPassphrase,
+		},
+		hostKey:      cfg.HostKey,
+		onNewHostKey: opts.OnNewHostKey,
+		knownHostsFile: firstOf(
+			strings.Fields(conf.getPath(alias, "UserKnownHostsFile"))...),
+	}
+	if r.knownHostsFile == "" {
+		r.knownHostsFile = expandHome("~/.ssh/known_hosts")
+	}
+
+	// set up ssh-agent - keys in the agent are used if enabled, or if no
+	// identity file is configured
+	r.agent.socket = conf.getPath(alias, "IdentityAgent")
+	switch r.agent.socket {
+	case "", "SSH_AUTH_SOCK":
+		r.agent.socket = os.Getenv("SSH_AUTH_SOCK")
+	case "none":
+		r.agent.socket = ""
+	}
+	r.agent.enabled = r.agent.socket != "" && (cfg.UseAgent || r.ident.filePath == "")
+	r.agent.forward = cfg.ForwardAgent || strings.EqualFold(conf.get(alias, "ForwardAgent"), "yes")
+
+	// set up jump hosts
+	var jumps = cfg.ProxyJump
+	if len(jumps) == 0 {
+		jumps = parseJumpHosts(conf.get(alias, "ProxyJump"))
+	}
+	for _, j := range jumps {
+		r.jumpHosts = append(r.jumpHosts, conf.resolveJumpHost(j, r.target()))
+	}
+
+	return r
+}
+
+// target returns connection options for the remote
+func (r *SSHRunner) target() sshHost {
+	return sshHost{
+		user:         r.user,
+		hostname:     r.ip,
+		port:         r.sshPort,
+		identityFile: r.ident.filePath,
+	}
+}
+
 // Run runs a command remotely.
 func (r *SSHRunner) Run(cmd string) (cmdout *bytes.Buffer, cmderr *bytes.Buffer, err error) {
-	session, err := getSSHSession(r.ident.filePath, r.ip, r.sshPort, r.user, r.ident.passphrase)
+	session, err := r.getSSHSession()
 	if err != nil {
 		return nil, nil, err
 	}
@@ -80,7 +156,7 @@ func (r *SSHRunner) Run(cmd string) (cmdout *bytes.Buffer, cmderr *bytes.Buffer,
 // RunStream remotely executes given command, streaming its output
 // and opening up an optionally interactive session
 func (r *SSHRunner) RunStream(cmd string, interactive bool) error {
-	session, err := getSSHSession(r.ident.filePath, r.ip, r.sshPort, r.user, r.ident.passphrase)
+	session, err := r.getSSHSession()
 	if err != nil {
 		return err
 	}
@@ -99,15 +175,41 @@ func (r *SSHRunner) RunStream(cmd string, interactive bool) error {
 
 // RunSession sets up a SSH shell to the remote
 func (r *SSHRunner) RunSession(commands ...string) error {
-	var (
-		target = fmt.Sprintf("%s@%s", r.user, r.ip)
-		args   = append([]string{
-			"-p", r.sshPort,
-			"-i", r.ident.filePath,
-			target},
-			commands...)
-		cmd = exec.Command("ssh", args...)
-	)
+	// make sure the host key has been recorded, and is provided to ssh to verify
+	if r.hostKey == "" {
+		session, err := r.getSSHSession()
+		if err != nil {
+			return err
+		}
+		session.Close()
+	}
+	knownHosts, err := r.writeKnownHosts()
+	if err != nil {
+		return err
+	}
+	defer os.Remove(knownHosts)
+
+	var args = []string{"-p", r.sshPort}
+	if r.ident.filePath != "" {
+		args = append(args, "-i", r.ident.filePath)
+	}
+	if r.agent.forward {
+		args = append(args, "-A")
+	}
+	if len(r.jumpHosts) > 0 {
+		var jumps = make([]string, len(r.jumpHosts))
+		for i, j := range r.jumpHosts {
+			jumps[i] = j.spec
+		}
+		args = append(args, "-J", strings.Join(jumps, ","))
+		// jump hosts are verified using the user's known hosts
+		knownHosts += " " + r.knownHostsFile
+	}
+	args = append(args,
+		"-o", "UserKnownHostsFile="+knownHosts,
+		"-o", "StrictHostKeyChecking=yes",
+		fmt.Sprintf("%s@%s", r.user, r.ip))
+	var cmd = exec.Command("ssh", append(args, commands...)...)
 	cmd.Stdout = os.Stdout
 	cmd.Stdin = os.Stdin
 	cmd.Stderr = os.Stderr
@@ -126,7 +228,7 @@ func (r *SSHRunner) CopyFile(file io.Reader, remotePath string, permissions stri
 	// Set up
 	filename := filepath.Base(remotePath)
 	directory := filepath.Dir(remotePath)
-	session, err := getSSHSession(r.ident.filePath, r.ip, r.sshPort, r.user, r.ident.passphrase)
+	session, err := r.getSSHSession()
 	if err != nil {
 		return err
 	}
@@ -144,31 +246,164 @@ func (r *SSHRunner) CopyFile(file io.Reader, remotePath string, permissions stri
 	return nil
 }
 
-// Stubbed out for testing.
-func getSSHSession(PEM, IP, sshPort, user, passphrase string) (*ssh.Session, error) {
-	privateKey, err := ioutil.ReadFile(PEM)
+// DialContext connects to the given address from the remote, over SSH. The SSH
+// connection is established on first use and reused for later connections.
+func (r *SSHRunner) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.tunnelM.Lock()
+	defer r.tunnelM.Unlock()
+	if r.tunnel != nil {
+		conn, err := r.tunnel.Dial(network, addr)
+		if err == nil {
+			return conn, nil
+		}
+		// the connection may have been dropped - try to reconnect
+		r.tunnel.Close()
+		r.tunnel = nil
+	}
+	client, err := r.dial()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open SSH tunnel: %s", err.Error())
+	}
+	r.tunnel = client
+	return client.Dial(network, addr)
+}
+
+// getSSHSession connects to the remote and starts a new session
+func (r *SSHRunner) getSSHSession() (*ssh.Session, error) {
+	client, err := r.dial()
 	if err != nil {
 		return nil, err
 	}
 
-	cfg, err := getSSHConfig(privateKey, user, passphrase)
+	// Create a session. It is one session per command.
+	session, err := client.NewSession()
 	if err != nil {
 		return nil, err
 	}
+	if r.agent.forward {
+		keyring, err := r.agentClient()
+		if err != nil {
+			session.Close()
+			return nil, fmt.Errorf("agent forwarding is enabled, but %s", err.Error())
+		}
+		if err := agent.ForwardToAgent(client, keyring); err != nil {
+			session.Close()
+			return nil, fmt.Errorf("failed to forward agent: %s", err.Error())
+		}
+		if err := agent.RequestAgentForwarding(session); err != nil {
+			session.Close()
+			return nil, fmt.Errorf("failed to forward agent: %s", err.Error())
+		}
+	}
+	return session, nil
+}
+
+// dial connects to the remote, through the configured jump hosts if any
+func (r *SSHRunner) dial() (*ssh.Client, error) {
+	var client *ssh.Client
+	for i, host := range append(r.jumpHosts, r.target()) {
+		var hostKeyCallback = r.verifyHostKey
+		if i < len(r.jumpHosts) {
+			// jump hosts are verified using the user's known hosts
+			cb, err := knownhosts.New(r.knownHostsFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read known hosts to verify jump host %s: %s",
+					host.spec, err.Error())
+			}
+			hostKeyCallback = cb
+		}
+		cfg, err := r.getSSHConfig(host, hostKeyCallback)
+		if err != nil {
+			return nil, err
+		}
+
+		var next *ssh.Client
+		if client == nil {
+			next, err = ssh.Dial("tcp", host.address(), cfg)
+		} else {
+			next, err = dialThrough(client, host.address(), cfg)
+		}
+		if err != nil {
+			if i < len(r.jumpHosts) {
+				return nil, fmt.Errorf("failed to connect to jump host %s: %s", host.spec, err.Error())
+			}
+			return nil, err
+		}
+		client = next
+	}
+	return client, nil
+}
 
-	client, err := ssh.Dial("tcp", IP+":"+sshPort, cfg)
+// dialThrough connects to addr through the given client
+func dialThrough(client *ssh.Client, addr string, cfg *ssh.ClientConfig) (*ssh.Client, error) {
+	conn, err := client.Dial("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	c, chans, reqs, err := ssh.NewClientConn(conn, addr, cfg)
 	if err != nil {
+		conn.Close()
 		return nil, err
 	}
+	return ssh.NewClient(c, chans, reqs), nil
+}
 
-	// Create a session. It is one session per command.
-	return client.NewSession()
+// getSSHConfig returns SSH configuration for the given host
+func (r *SSHRunner) getSSHConfig(host sshHost, hostKeyCallback ssh.HostKeyCallback) (*ssh.ClientConfig, error) {
+	var auth []ssh.AuthMethod
+	if r.agent.enabled {
+		keyring, err := r.agentClient()
+		if err != nil {
+			return nil, err
+		}
+		auth = append(auth, ssh.PublicKeysCallback(keyring.Signers))
+	}
+	if host.identityFile != "" {
+		key, err := getIdentity(host.identityFile, r.ident.passphrase)
+		if err != nil && !r.agent.enabled {
+			return nil, err
+		}
+		if key != nil {
+			auth = append(auth, ssh.PublicKeys(key))
+		}
+	}
+	if len(auth) == 0 {
+		return nil, errors.New("no identity file or ssh-agent available for authentication")
+	}
+
+	return &ssh.ClientConfig{
+		User:            host.user,
+		Auth:            auth,
+		HostKeyCallback: hostKeyCallback,
+	}, nil
 }
 
-// getSSHConfig returns SSH configuration for the remote.
-func getSSHConfig(privateKey []byte, user, passphrase string) (*ssh.ClientConfig, error) {
+// agentClient connects to the ssh-agent on first use. The agent is used for
+// forwarding even if it is not used for authentication.
+func (r *SSHRunner) agentClient() (agent.ExtendedAgent, error) {
+	if r.agent.client == nil {
+		if r.agent.socket == "" {
+			return nil, errors.New("no ssh-agent is available")
+		}
+		conn, err := net.Dial("unix", r.agent.socket)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to ssh-agent: %s", err.Error())
+		}
+		r.agent.client = agent.NewClient(conn)
+	}
+	return r.agent.client, nil
+}
+
+// getIdentity reads the private key at the given path
+func getIdentity(path, passphrase string) (ssh.Signer, error) {
+	privateKey, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
 	var key ssh.Signer
-	var err error
 	if passphrase == "" {
 		if key, err = ssh.ParsePrivateKey(privateKey); err != nil {
 			return nil, fmt.Errorf("failed to parse key without passphrase: %s", err.Error())
@@ -178,20 +413,65 @@ func getSSHConfig(privateKey []byte, user, passphrase string) (*ssh.ClientConfig
 			return nil, fmt.Errorf("failed to parse key with passphrase: %s", err.Error())
 		}
 	}
+	return key, nil
+}
 
-	// Authentication
-	return &ssh.ClientConfig{
-		User: user,
-		Auth: []ssh.AuthMethod{
-			ssh.PublicKeys(key),
-		},
-		// TODO: We need to replace this with a callback
-		// to verify the host key. A security vulnerability
-		// comes from the fact that we receive a public key
-		// from the server and we add it to our GitHub.
-		// This gives the server readonly access to our
-		// GitHub account. We need to know who we're
-		// connecting to.
-		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
-	}, nil
+// verifyHostKey implements ssh.HostKeyCallback. If no host key has been
+// recorded for this remote, the given key is recorded - otherwise, the given key
+// must match the recorded one.
+func (r *SSHRunner) verifyHostKey(hostname string, remote net.Addr, key ssh.PublicKey) error {
+	var presented = strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key)))
+	if r.hostKey == "" {
+		if r.onNewHostKey != nil {
+			if err := r.onNewHostKey(presented); err != nil {
+				return fmt.Errorf("failed to save host key: %s", err.Error())
+			}
+		}
+		r.hostKey = presented
+		return nil
+	}
+
+	expected, _, _, _, err := ssh.ParseAuthorizedKey([]byte(r.hostKey))
+	if err != nil {
+		return fmt.Errorf("recorded host key is invalid: %s", err.Error())
+	}
+	if !bytes.Equal(expected.Marshal(), key.Marshal()) {
+		return &HostKeyMismatchError{Host: hostname, Expected: expected, Actual: key}
+	}
+	return nil
+}
+
+// writeKnownHosts writes the recorded host key to a temporary file in the
+// known_hosts format, and returns the path to the file
+func (r *SSHRunner) writeKnownHosts() (string, error) {
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(r.hostKey))
+	if err != nil {
+		return "", fmt.Errorf("recorded host key is invalid: %s", err.Error())
+	}
+	f, err := ioutil.TempFile("", "inertia_known_hosts")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	var address = knownhosts.Normalize(net.JoinHostPort(r.ip, r.sshPort))
+	if _, err := fmt.Fprintln(f, knownhosts.Line([]string{address}, key)); err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+// HostKeyMismatchError is returned when a remote presents a host key that does
+// not match the recorded host key
+type HostKeyMismatchError struct {
+	Host     string
+	Expected ssh.PublicKey
+	Actual   ssh.PublicKey
+}
+
+func (e *HostKeyMismatchError) Error() string {
+	return fmt.Sprintf(`host key for %s has changed (expected %s, got %s)!
+Someone could be intercepting your connection. If the remote's host key was changed
+legitimately, update it with 'inertia remote set-hostkey' or 'inertia remote reset-hostkey'`,
+		e.Host, ssh.FingerprintSHA256(e.Expected), ssh.FingerprintSHA256(e.Actual))
 }
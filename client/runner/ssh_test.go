@@ -0,0 +1,423 @@
+package runner
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/kevinburke/ssh_config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	"github.com/ubclaunchpad/inertia/cfg"
+)
+
+// newTestSSHServer starts an SSH server that accepts any public key, and
+// responds to every command with "ok". If agent forwarding is requested, the
+// number of keys in the forwarded agent is appended to the response.
+func newTestSSHServer(t *testing.T) (addr string, hostKey ssh.Signer) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	hostKey, err = ssh.NewSignerFromKey(priv)
+	require.NoError(t, err)
+
+	var config = &ssh.ServerConfig{
+		PublicKeyCallback: func(ssh.ConnMetadata, ssh.PublicKey) (*ssh.Permissions, error) {
+			return nil, nil
+		},
+	}
+	config.AddHostKey(hostKey)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go serveTestSSHConn(conn, config)
+		}
+	}()
+	return l.Addr().String(), hostKey
+}
+
+func serveTestSSHConn(conn net.Conn, config *ssh.ServerConfig) {
+	sconn, chans, reqs, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		conn.Close()
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+	for newChannel := range chans {
+		if newChannel.ChannelType() == "direct-tcpip" {
+			go forwardTestSSHChannel(newChannel)
+			continue
+		}
+		if newChannel.ChannelType() != "session" {
+			newChannel.Reject(ssh.UnknownChannelType, "unsupported")
+			continue
+		}
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			continue
+		}
+		go func() {
+			defer channel.Close()
+			var forwarded bool
+			for req := range requests {
+				if req.Type == "auth-agent-req@openssh.com" {
+					forwarded = true
+					req.Reply(true, nil)
+					continue
+				}
+				if req.Type != "exec" {
+					req.Reply(false, nil)
+					continue
+				}
+				req.Reply(true, nil)
+				channel.Write([]byte("ok"))
+				if forwarded {
+					channel.Write([]byte(" " + countForwardedKeys(sconn)))
+				}
+				channel.SendRequest("exit-status", false, ssh.Marshal(struct{ Status uint32 }{0}))
+				return
+			}
+		}()
+	}
+}
+
+// countForwardedKeys lists the keys in the agent forwarded by the client
+func countForwardedKeys(conn ssh.Conn) string {
+	channel, reqs, err := conn.OpenChannel("auth-agent@openssh.com", nil)
+	if err != nil {
+		return err.Error()
+	}
+	defer channel.Close()
+	go ssh.DiscardRequests(reqs)
+	keys, err := agent.NewClient(channel).List()
+	if err != nil {
+		return err.Error()
+	}
+	return strconv.Itoa(len(keys))
+}
+
+// forwardTestSSHChannel serves a port forwarding request, allowing the test
+// server to be used as a jump host
+func forwardTestSSHChannel(newChannel ssh.NewChannel) {
+	var req struct {
+		DestAddr string
+		DestPort uint32
+		OrigAddr string
+		OrigPort uint32
+	}
+	if err := ssh.Unmarshal(newChannel.ExtraData(), &req); err != nil {
+		newChannel.Reject(ssh.ConnectionFailed, err.Error())
+		return
+	}
+	conn, err := net.Dial("tcp", net.JoinHostPort(req.DestAddr, strconv.Itoa(int(req.DestPort))))
+	if err != nil {
+		newChannel.Reject(ssh.ConnectionFailed, err.Error())
+		return
+	}
+	channel, requests, err := newChannel.Accept()
+	if err != nil {
+		conn.Close()
+		return
+	}
+	go ssh.DiscardRequests(requests)
+	go func() { io.Copy(channel, conn); channel.CloseWrite() }()
+	io.Copy(conn, channel)
+	conn.Close()
+}
+
+func writeTestIdentity(t *testing.T) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	dir, err := ioutil.TempDir("", "inertia-runner-ssh")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	var path = filepath.Join(dir, "id_rsa")
+	require.NoError(t, ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	}), 0600))
+	return path
+}
+
+func TestSSHRunner_HostKeyVerification(t *testing.T) {
+	addr, hostKey := newTestSSHServer(t)
+	host, port, err := net.SplitHostPort(addr)
+	require.NoError(t, err)
+	var sshCfg = &cfg.SSH{
+		User:         "inertia",
+		IdentityFile: writeTestIdentity(t),
+		SSHPort:      port,
+	}
+
+	// first connection should record host key
+	var recorded string
+	var r = NewSSHRunner(host, sshCfg, SSHOptions{
+		OnNewHostKey: func(key string) error { recorded = key; return nil },
+	})
+	stdout, _, err := r.Run("echo")
+	require.NoError(t, err)
+	assert.Equal(t, "ok", stdout.String())
+	require.NotEmpty(t, recorded)
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(recorded))
+	require.NoError(t, err)
+	assert.Equal(t, hostKey.PublicKey().Marshal(), key.Marshal())
+
+	// later connections should verify recorded host key
+	sshCfg.HostKey = recorded
+	r = NewSSHRunner(host, sshCfg, SSHOptions{
+		OnNewHostKey: func(string) error { t.Error("unexpected new host key"); return nil },
+	})
+	_, _, err = r.Run("echo")
+	assert.NoError(t, err)
+
+	// changed host key should fail loudly
+	otherAddr, _ := newTestSSHServer(t)
+	_, otherPort, err := net.SplitHostPort(otherAddr)
+	require.NoError(t, err)
+	sshCfg.SSHPort = otherPort
+	r = NewSSHRunner(host, sshCfg, SSHOptions{
+		OnNewHostKey: func(string) error { t.Error("unexpected new host key"); return nil },
+	})
+	_, _, err = r.Run("echo")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "host key for")
+	assert.Contains(t, err.Error(), "has changed")
+}
+
+func TestSSHRunner_ProxyJump(t *testing.T) {
+	jumpAddr, jumpKey := newTestSSHServer(t)
+	targetAddr, _ := newTestSSHServer(t)
+	targetHost, targetPort, err := net.SplitHostPort(targetAddr)
+	require.NoError(t, err)
+
+	var r = newSSHRunner(targetHost, &cfg.SSH{
+		User:         "inertia",
+		IdentityFile: writeTestIdentity(t),
+		SSHPort:      targetPort,
+		ProxyJump:    []string{"bastion@" + jumpAddr},
+	}, SSHOptions{}, &sshConfig{})
+	require.Len(t, r.jumpHosts, 1)
+	assert.Equal(t, "bastion", r.jumpHosts[0].user)
+
+	// jump host must be in known hosts
+	dir, err := ioutil.TempDir("", "inertia-runner-ssh")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	r.knownHostsFile = filepath.Join(dir, "known_hosts")
+	_, _, err = r.Run("echo")
+	assert.Error(t, err)
+
+	require.NoError(t, ioutil.WriteFile(r.knownHostsFile, []byte(
+		knownhosts.Line([]string{knownhosts.Normalize(jumpAddr)}, jumpKey.PublicKey())+"\n"), 0600))
+	stdout, _, err := r.Run("echo")
+	require.NoError(t, err)
+	assert.Equal(t, "ok", stdout.String())
+}
+
+// newTestAgent serves an ssh-agent holding a key, and returns its socket
+func newTestAgent(t *testing.T) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	var keyring = agent.NewKeyring()
+	require.NoError(t, keyring.Add(agent.AddedKey{PrivateKey: key}))
+	dir, err := ioutil.TempDir("", "inertia-runner-ssh")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	var socket = filepath.Join(dir, "agent.sock")
+	l, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go agent.ServeAgent(keyring, conn)
+		}
+	}()
+	return socket
+}
+
+func TestSSHRunner_Agent(t *testing.T) {
+	addr, _ := newTestSSHServer(t)
+	host, port, err := net.SplitHostPort(addr)
+	require.NoError(t, err)
+
+	// no identity file is configured, so agent should be used
+	var socket = newTestAgent(t)
+	conf, err := ssh_config.Decode(strings.NewReader("Host " + host + "\n  IdentityAgent " + socket + "\n"))
+	require.NoError(t, err)
+	var r = newSSHRunner(host, &cfg.SSH{
+		User:    "inertia",
+		SSHPort: port,
+	}, SSHOptions{}, &sshConfig{conf})
+	assert.True(t, r.agent.enabled)
+	stdout, _, err := r.Run("echo")
+	require.NoError(t, err)
+	assert.Equal(t, "ok", stdout.String())
+}
+
+func TestSSHRunner_ForwardAgent(t *testing.T) {
+	addr, _ := newTestSSHServer(t)
+	host, port, err := net.SplitHostPort(addr)
+	require.NoError(t, err)
+
+	t.Run("with identity file", func(t *testing.T) {
+		var socket = newTestAgent(t)
+		conf, err := ssh_config.Decode(strings.NewReader("Host " + host + "\n  IdentityAgent " + socket + "\n"))
+		require.NoError(t, err)
+		var r = newSSHRunner(host, &cfg.SSH{
+			User:         "inertia",
+			SSHPort:      port,
+			IdentityFile: writeTestIdentity(t),
+			ForwardAgent: true,
+		}, SSHOptions{}, &sshConfig{conf})
+		// agent is not used for authentication, but should still be forwarded
+		assert.False(t, r.agent.enabled)
+		stdout, _, err := r.Run("echo")
+		require.NoError(t, err)
+		assert.Equal(t, "ok 1", stdout.String())
+	})
+
+	t.Run("no agent available", func(t *testing.T) {
+		conf, err := ssh_config.Decode(strings.NewReader("Host " + host + "\n  IdentityAgent none\n"))
+		require.NoError(t, err)
+		var r = newSSHRunner(host, &cfg.SSH{
+			User:         "inertia",
+			SSHPort:      port,
+			IdentityFile: writeTestIdentity(t),
+			ForwardAgent: true,
+		}, SSHOptions{}, &sshConfig{conf})
+		_, _, err = r.Run("echo")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no ssh-agent is available")
+	})
+}
+
+func Test_newSSHRunner_sshConfig(t *testing.T) {
+	conf, err := ssh_config.Decode(strings.NewReader(`
+Host prod
+  HostName 10.0.0.5
+  User deploy
+  Port 2222
+  IdentityFile /keys/prod
+  ForwardAgent yes
+  ProxyJump jump@bastion:2200,inner
+
+Host inner
+  HostName 10.0.0.1
+  User admin
+`))
+	require.NoError(t, err)
+
+	t.Run("options read from ssh config", func(t *testing.T) {
+		var r = newSSHRunner("1.2.3.4", &cfg.SSH{HostAlias: "prod"}, SSHOptions{}, &sshConfig{conf})
+		assert.Equal(t, "10.0.0.5", r.ip)
+		assert.Equal(t, "deploy", r.user)
+		assert.Equal(t, "2222", r.sshPort)
+		assert.Equal(t, "/keys/prod", r.ident.filePath)
+		assert.True(t, r.agent.forward)
+		require.Len(t, r.jumpHosts, 2)
+		assert.Equal(t, sshHost{
+			spec:         "jump@bastion:2200",
+			user:         "jump",
+			hostname:     "bastion",
+			port:         "2200",
+			identityFile: "/keys/prod",
+		}, r.jumpHosts[0])
+		assert.Equal(t, sshHost{
+			spec:         "inner",
+			user:         "admin",
+			hostname:     "10.0.0.1",
+			port:         "22",
+			identityFile: "/keys/prod",
+		}, r.jumpHosts[1])
+	})
+
+	t.Run("inertia configuration takes precedence", func(t *testing.T) {
+		var r = newSSHRunner("1.2.3.4", &cfg.SSH{
+			HostAlias:    "prod",
+			User:         "root",
+			SSHPort:      "22",
+			IdentityFile: "/keys/inertia",
+			ProxyJump:    []string{"other"},
+		}, SSHOptions{}, &sshConfig{conf})
+		assert.Equal(t, "10.0.0.5", r.ip)
+		assert.Equal(t, "root", r.user)
+		assert.Equal(t, "22", r.sshPort)
+		assert.Equal(t, "/keys/inertia", r.ident.filePath)
+		require.Len(t, r.jumpHosts, 1)
+		assert.Equal(t, "other", r.jumpHosts[0].hostname)
+	})
+
+	t.Run("no matching host", func(t *testing.T) {
+		var r = newSSHRunner("1.2.3.4", &cfg.SSH{User: "root"}, SSHOptions{}, &sshConfig{conf})
+		assert.Equal(t, "1.2.3.4", r.ip)
+		assert.Equal(t, "22", r.sshPort)
+		assert.Empty(t, r.jumpHosts)
+	})
+}
+
+func TestSSHRunner_DialContext(t *testing.T) {
+	addr, _ := newTestSSHServer(t)
+	host, port, err := net.SplitHostPort(addr)
+	require.NoError(t, err)
+
+	// serve something to tunnel to
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			conn.Write([]byte("hello"))
+			conn.Close()
+		}
+	}()
+
+	var r = newSSHRunner(host, &cfg.SSH{
+		User:         "inertia",
+		IdentityFile: writeTestIdentity(t),
+		SSHPort:      port,
+	}, SSHOptions{}, &sshConfig{})
+	for i := 0; i < 2; i++ {
+		conn, err := r.DialContext(context.Background(), "tcp", l.Addr().String())
+		require.NoError(t, err)
+		b, err := ioutil.ReadAll(conn)
+		assert.NoError(t, err)
+		assert.Equal(t, "hello", string(b))
+		conn.Close()
+	}
+	assert.NotNil(t, r.tunnel)
+
+	// cancelled contexts should not connect
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = r.DialContext(ctx, "tcp", l.Addr().String())
+	assert.Error(t, err)
+}
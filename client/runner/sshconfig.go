@@ -0,0 +1,119 @@
+package runner
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kevinburke/ssh_config"
+)
+
+// sshConfig provides connection options from an OpenSSH client configuration
+// file, such as ~/.ssh/config
+type sshConfig struct {
+	c *ssh_config.Config
+}
+
+// loadUserSSHConfig reads the user's ~/.ssh/config. A missing or invalid file is
+// treated as an empty configuration.
+func loadUserSSHConfig() *sshConfig {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return &sshConfig{}
+	}
+	f, err := os.Open(filepath.Join(home, ".ssh", "config"))
+	if err != nil {
+		return &sshConfig{}
+	}
+	defer f.Close()
+	c, err := ssh_config.Decode(f)
+	if err != nil {
+		return &sshConfig{}
+	}
+	return &sshConfig{c}
+}
+
+// get returns the first value of the given key for the given host alias, or an
+// empty string if none is set
+func (s *sshConfig) get(alias, key string) (val string) {
+	if s == nil || s.c == nil {
+		return ""
+	}
+	// ssh_config panics on 'Match' directives, which it does not support
+	defer func() {
+		if recover() != nil {
+			val = ""
+		}
+	}()
+	val, _ = s.c.Get(alias, key)
+	return strings.TrimSpace(val)
+}
+
+// getPath returns the value of the given key as a path, with '~' expanded
+func (s *sshConfig) getPath(alias, key string) string {
+	return expandHome(s.get(alias, key))
+}
+
+// sshHost denotes connection options for a single host
+type sshHost struct {
+	// spec is the host as originally specified
+	spec string
+
+	user         string
+	hostname     string
+	port         string
+	identityFile string
+}
+
+func (h sshHost) address() string { return net.JoinHostPort(h.hostname, h.port) }
+
+// resolveJumpHost resolves connection options for a jump host, specified in the
+// format '[user@]host[:port]', using defaults from the given target host
+func (s *sshConfig) resolveJumpHost(spec string, target sshHost) sshHost {
+	var host = sshHost{spec: spec}
+	var alias = spec
+	if i := strings.LastIndex(alias, "@"); i >= 0 {
+		host.user, alias = alias[:i], alias[i+1:]
+	}
+	if h, p, err := net.SplitHostPort(alias); err == nil {
+		alias, host.port = h, p
+	}
+	host.hostname = firstOf(s.get(alias, "HostName"), alias)
+	host.user = firstOf(host.user, s.get(alias, "User"), target.user)
+	host.port = firstOf(host.port, s.get(alias, "Port"), "22")
+	host.identityFile = firstOf(s.getPath(alias, "IdentityFile"), target.identityFile)
+	return host
+}
+
+// parseJumpHosts splits a ProxyJump value into individual jump hosts
+func parseJumpHosts(proxyJump string) []string {
+	if proxyJump == "" || strings.EqualFold(proxyJump, "none") {
+		return nil
+	}
+	var hosts []string
+	for _, h := range strings.Split(proxyJump, ",") {
+		if h = strings.TrimSpace(h); h != "" {
+			hosts = append(hosts, h)
+		}
+	}
+	return hosts
+}
+
+func expandHome(path string) string {
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, strings.TrimPrefix(path, "~"))
+		}
+	}
+	return path
+}
+
+func firstOf(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
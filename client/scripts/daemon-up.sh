@@ -10,6 +10,7 @@ DAEMON_RELEASE="%[1]s"
 DAEMON_PORT="%[2]s"
 HOST_ADDRESS="%[3]s"
 WEBHOOK_SECRET="%[4]s"
+DAEMON_BIND_ADDRESS="%[5]s"
 
 # Inertia image details.
 DAEMON_NAME=inertia-daemon
@@ -35,6 +36,25 @@ mkdir -p "$HOME"/inertia/persist
 mkdir -p "$HOME"/.inertia
 mkdir -p "$HOME"/.inertia/ssl
 
+# Project secret files, kept in memory only
+sudo docker volume create \
+    --driver local \
+    --opt type=tmpfs \
+    --opt device=tmpfs \
+    --opt o=mode=0755 \
+    inertia-secrets > /dev/null
+
+# Only expose the daemon on the given address, if provided.
+if [ -n "$DAEMON_BIND_ADDRESS" ]; then
+    DAEMON_PUBLISH="$DAEMON_BIND_ADDRESS:$DAEMON_PORT:$CONTAINER_PORT"
+else
+    DAEMON_PUBLISH="$DAEMON_PORT:$CONTAINER_PORT"
+fi
+
+# Docker's data root, mounted read-only so that the daemon can monitor the disk
+# space available to builds.
+DOCKER_ROOT=$(sudo docker info --format '{{.DockerRootDir}}' 2> /dev/null || echo /var/lib/docker)
+
 # Check if already running and take down existing daemon.
 ALREADY_RUNNING=$(sudo docker ps -q --filter "name=$DAEMON_NAME")
 if [ ! -z "$ALREADY_RUNNING" ]; then
@@ -60,9 +80,11 @@ fi
 echo "Running daemon on port $DAEMON_PORT"
 sudo docker run -d \
     --restart unless-stopped \
-    -p "$DAEMON_PORT":"$CONTAINER_PORT" \
+    -p "$DAEMON_PUBLISH" \
     -v /var/run/docker.sock:/var/run/docker.sock \
     -v "$HOME":/app/host \
+    -v "$DOCKER_ROOT":/app/docker:ro \
+    -v inertia-secrets:/app/secrets \
     -e HOME="$HOME" \
     -e SSH_KNOWN_HOSTS='/app/host/.ssh/known_hosts' \
     --name "$DAEMON_NAME" \
@@ -34,8 +34,14 @@ func (s *SSHClient) DaemonUp() error {
 	if err != nil {
 		return fmt.Errorf("could not initialize script: %w", err)
 	}
+	// when reached through an SSH tunnel, the daemon does not need to be public
+	var bindAddress string
+	if s.remote.Daemon.UseSSHTunnel() {
+		bindAddress = "127.0.0.1"
+	}
 	var daemonCmdStr = fmt.Sprintf(string(scriptBytes),
-		s.remote.Version, s.remote.Daemon.Port, s.remote.IP, s.remote.Daemon.WebHookSecret)
+		s.remote.Version, s.remote.Daemon.Port, s.remote.IP, s.remote.Daemon.WebHookSecret,
+		bindAddress)
 	return s.ssh.RunStream(daemonCmdStr, false)
 }
 
@@ -57,7 +57,7 @@ func TestSSHClient_DaemonUp(t *testing.T) {
 	// Get original script for comparison
 	script, err := ioutil.ReadFile("scripts/daemon-up.sh")
 	assert.NoError(t, err)
-	actualCommand := fmt.Sprintf(string(script), "test", "4303", "127.0.0.1", "")
+	actualCommand := fmt.Sprintf(string(script), "test", "4303", "127.0.0.1", "", "")
 
 	// Get SSH runner
 	sshc, err := client.GetSSHClient()
@@ -75,6 +75,13 @@ func TestSSHClient_DaemonUp(t *testing.T) {
 	call, interact = session.RunStreamArgsForCall(1)
 	assert.False(t, interact)
 	assert.Contains(t, call, "sekret")
+
+	// Check with SSH tunnel transport, daemon should only bind to localhost
+	sshc.remote.Daemon.Transport = cfg.TransportSSHTunnel
+	assert.NoError(t, sshc.DaemonUp())
+	call, interact = session.RunStreamArgsForCall(2)
+	assert.False(t, interact)
+	assert.Contains(t, call, `DAEMON_BIND_ADDRESS="127.0.0.1"`)
 }
 
 func TestSSHClient_DaemonDown(t *testing.T) {
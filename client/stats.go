@@ -0,0 +1,71 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ubclaunchpad/inertia/api"
+)
+
+// Stats retrieves a snapshot of resource usage on the remote
+func (c *Client) Stats(ctx context.Context) (*api.Stats, error) {
+	resp, err := c.get(ctx, "/stats", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %s", err.Error())
+	}
+
+	var stats api.Stats
+	base, err := c.unmarshal(resp.Body, api.KV{Key: "stats", Value: &stats})
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %s", err.Error())
+	}
+
+	return &stats, base.Error()
+}
+
+// WatchStats opens a websocket connection to receive snapshots of resource
+// usage on the remote at the given interval, calling fn for each snapshot
+// until the context is cancelled
+func (c *Client) WatchStats(ctx context.Context, interval time.Duration, fn func(api.Stats)) error {
+	var params = map[string]string{api.Stream: "true"}
+	if interval > 0 {
+		params[api.Interval] = strconv.Itoa(int(interval.Seconds()))
+	}
+	socket, err := c.dialWebSocket(ctx, "/stats", params)
+	if err != nil {
+		return err
+	}
+	defer socket.Close()
+
+	// read from socket until error
+	var errC = make(chan error, 1)
+	go func() {
+		for {
+			_, msg, err := socket.ReadMessage()
+			if err != nil {
+				errC <- fmt.Errorf("error occured while reading from socket: %s", err.Error())
+				return
+			}
+			var stats api.Stats
+			if err := json.Unmarshal(msg, &stats); err != nil {
+				errC <- fmt.Errorf("invalid stats received: %s", err.Error())
+				return
+			}
+			fn(stats)
+		}
+	}()
+
+	// block until done
+	select {
+	case <-ctx.Done():
+		c.debugf("context cancelled, closing connection")
+		return nil
+	case err := <-errC:
+		c.debugf("error received: %s", err.Error())
+		return err
+	}
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-chi/render"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/res"
+)
+
+var testStats = api.Stats{
+	Host: api.HostStats{MemoryTotal: 2048, MemoryAvailable: 1024},
+	Containers: []api.ContainerStats{
+		{Name: "/web", CPUPercent: 12.5, MemoryUsage: 256, PIDs: 3},
+	},
+}
+
+func TestClient_Stats(t *testing.T) {
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/stats", r.URL.Path)
+		assert.Equal(t, "Bearer "+fakeAuth, r.Header.Get("Authorization"))
+		render.Render(w, r, res.MsgOK("stats retrieved", "stats", testStats))
+	}))
+	defer testServer.Close()
+
+	var d = newMockClient(t, testServer)
+	stats, err := d.Stats(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, testStats.Host, stats.Host)
+	assert.Equal(t, testStats.Containers, stats.Containers)
+}
+
+func TestClient_WatchStats(t *testing.T) {
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/stats", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get(api.Stream))
+		assert.Equal(t, "5", r.URL.Query().Get(api.Interval))
+		assert.Equal(t, "Bearer "+fakeAuth, r.Header.Get("Authorization"))
+
+		var socketUpgrader = websocket.Upgrader{}
+		socket, err := socketUpgrader.Upgrade(w, r, nil)
+		require.NoError(t, err)
+		b, _ := json.Marshal(testStats)
+		assert.NoError(t, socket.WriteMessage(websocket.TextMessage, b))
+		assert.NoError(t, socket.WriteMessage(websocket.TextMessage, b))
+	}))
+	defer testServer.Close()
+
+	var d = newMockClient(t, testServer)
+	var (
+		received = make(chan api.Stats, 2)
+		done     = make(chan struct{})
+	)
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cancel()
+	go func() {
+		// stop watching once both snapshots have arrived
+		<-received
+		<-received
+		close(done)
+		cancel()
+	}()
+	assert.NoError(t, d.WatchStats(ctx, 5*time.Second, func(s api.Stats) {
+		assert.Equal(t, testStats.Containers, s.Containers)
+		received <- s
+	}))
+	select {
+	case <-done:
+	default:
+		t.Error("expected two snapshots")
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
-	"net/http/httptest"
 	"testing"
 
 	"github.com/go-chi/render"
@@ -15,7 +14,7 @@ import (
 )
 
 func TestUserClient_AddUser(t *testing.T) {
-	testServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
 		// Check request method
 		assert.Equal(t, "POST", r.Method)
@@ -35,7 +34,7 @@ func TestUserClient_AddUser(t *testing.T) {
 }
 
 func TestUserClient_RemoveUser(t *testing.T) {
-	testServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
 		// Check request method
 		assert.Equal(t, "POST", r.Method)
@@ -55,7 +54,7 @@ func TestUserClient_RemoveUser(t *testing.T) {
 }
 
 func TestUserClient_ResetUser(t *testing.T) {
-	testServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
 		// Check request method
 		assert.Equal(t, "POST", r.Method)
@@ -75,7 +74,7 @@ func TestUserClient_ResetUser(t *testing.T) {
 }
 
 func TestUserClient_ListUsers(t *testing.T) {
-	testServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
 		// Check request method
 		assert.Equal(t, "GET", r.Method)
@@ -102,7 +101,7 @@ func TestUserClient_Authenticate(t *testing.T) {
 	password := "SomeKindo23asdfpassword"
 
 	t.Run("normal login", func(t *testing.T) {
-		testServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
 			// Check request method
 			assert.Equal(t, http.MethodPost, r.Method)
@@ -136,7 +135,7 @@ func TestUserClient_Authenticate(t *testing.T) {
 	})
 
 	t.Run("requires TOTP", func(t *testing.T) {
-		testServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			render.Render(w, r, res.Err("uwu", http.StatusPreconditionFailed))
 		}))
 		defer testServer.Close()
@@ -153,7 +152,7 @@ func TestUserClient_Authenticate(t *testing.T) {
 }
 
 func TestUserClient_EnableTotp(t *testing.T) {
-	testServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
 		// Check request method
 		assert.Equal(t, "POST", r.Method)
@@ -179,7 +178,7 @@ func TestUserClient_EnableTotp(t *testing.T) {
 }
 
 func TestUserClient_DisableTotp(t *testing.T) {
-	testServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	testServer := newMockServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
 		// Check request method
 		assert.Equal(t, "POST", r.Method)
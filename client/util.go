@@ -1,14 +1,21 @@
 package client
 
 import (
+	"context"
 	"crypto/tls"
+	"net"
 	"net/http"
 	"net/url"
 
 	"github.com/gorilla/websocket"
+
+	"github.com/ubclaunchpad/inertia/client/runner"
 )
 
-func buildHTTPSClient(verify bool) *http.Client {
+// dialFunc establishes network connections
+type dialFunc func(ctx context.Context, network, addr string) (net.Conn, error)
+
+func buildHTTPSClient(verify bool, dial dialFunc) *http.Client {
 	return &http.Client{Transport: &http.Transport{
 		// Our certificates are self-signed, so will raise
 		// a warning - currently, we ask our client to ignore
@@ -16,15 +23,31 @@ func buildHTTPSClient(verify bool) *http.Client {
 		TLSClientConfig: &tls.Config{
 			InsecureSkipVerify: !verify,
 		},
+		DialContext: dial,
 	}}
 }
 
-func buildWebSocketDialer(verify bool) *websocket.Dialer {
-	return &websocket.Dialer{
+func buildWebSocketDialer(verify bool, dial dialFunc) *websocket.Dialer {
+	var dialer = &websocket.Dialer{
 		TLSClientConfig: &tls.Config{
 			InsecureSkipVerify: !verify,
 		},
 	}
+	if dial != nil {
+		dialer.NetDial = func(network, addr string) (net.Conn, error) {
+			return dial(context.Background(), network, addr)
+		}
+	}
+	return dialer
+}
+
+// sshTunnelDialer returns a dialer that connects to the daemon on the given port
+// through an SSH tunnel to the remote, regardless of the requested address
+func sshTunnelDialer(r *runner.SSHRunner, port string) dialFunc {
+	var addr = net.JoinHostPort("127.0.0.1", port)
+	return func(ctx context.Context, network, _ string) (net.Conn, error) {
+		return r.DialContext(ctx, network, addr)
+	}
 }
 
 func encodeQuery(url *url.URL, queries map[string]string) {
@@ -45,30 +45,29 @@ func Fprintf(out io.Writer, format string, args ...interface{}) {
 	}
 }
 
-// Printf wraps formatters
+// Printf wraps formatters. Output is written to stderr if structured output
+// is enabled.
 func Printf(format string, args ...interface{}) {
-	if WithEmoji() {
-		emoji.Printf(format, args...)
-	} else {
-		fmt.Printf(format, args...)
-	}
+	Fprintf(TextOut(), format, args...)
 }
 
-// Println wraps formatters
+// Println wraps formatters. Output is written to stderr if structured output
+// is enabled.
 func Println(args ...interface{}) {
 	if WithEmoji() {
-		emoji.Println(args...)
+		emoji.Fprintln(TextOut(), args...)
 	} else {
-		fmt.Println(args...)
+		fmt.Fprintln(TextOut(), args...)
 	}
 }
 
-// Print wraps formatters
+// Print wraps formatters. Output is written to stderr if structured output is
+// enabled.
 func Print(args ...interface{}) {
 	if WithEmoji() {
-		emoji.Print(args...)
+		emoji.Fprint(TextOut(), args...)
 	} else {
-		fmt.Print(args...)
+		fmt.Fprint(TextOut(), args...)
 	}
 }
 
@@ -84,6 +83,10 @@ const (
 	GR ColorTraits = ColorTraits(color.FgGreen)
 	// YE = yellow
 	YE ColorTraits = ColorTraits(color.FgYellow)
+	// BL = blue
+	BL ColorTraits = ColorTraits(color.FgBlue)
+	// MA = magenta
+	MA ColorTraits = ColorTraits(color.FgMagenta)
 
 	// BO = bold
 	BO ColorTraits = ColorTraits(color.Bold)
@@ -0,0 +1,125 @@
+package out
+
+import (
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/cfg"
+)
+
+// StatusDocument is the structured output of 'inertia [remote] status'
+type StatusDocument struct {
+	Remote  string `json:"remote"`
+	Address string `json:"address"`
+	*api.DeploymentStatusWithVersions
+
+	// Changes is only set by 'inertia [remote] status --changes'
+	Changes *api.Deployment `json:"changes,omitempty"`
+}
+
+// PlanDocument is the structured output of 'inertia [remote] plan'
+type PlanDocument struct {
+	Remote string `json:"remote"`
+	*api.DeploymentPlan
+}
+
+// LogsDocument is the structured output of 'inertia [remote] logs --short'
+type LogsDocument struct {
+	Logs []api.LogEntry `json:"logs"`
+}
+
+// EnvDocument is the structured output of 'inertia [remote] env ls'
+type EnvDocument struct {
+	Variables []api.EnvVariable `json:"variables"`
+}
+
+// UsersDocument is the structured output of 'inertia [remote] user ls'
+type UsersDocument struct {
+	Users []string `json:"users"`
+}
+
+// RemotesDocument is the structured output of 'inertia remote ls'
+type RemotesDocument struct {
+	Remotes []RemoteDocument `json:"remotes"`
+}
+
+// RemoteDocument describes a configured remote, without its secrets
+type RemoteDocument struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	IP      string `json:"ip"`
+
+	Daemon *RemoteDaemonDocument `json:"daemon,omitempty"`
+	SSH    *RemoteSSHDocument    `json:"ssh,omitempty"`
+
+	Profiles map[string]string `json:"profiles,omitempty"`
+}
+
+// RemoteDaemonDocument describes how a remote's daemon is reached
+type RemoteDaemonDocument struct {
+	Port          string `json:"port"`
+	Authenticated bool   `json:"authenticated"`
+	VerifySSL     bool   `json:"verify_ssl"`
+	Transport     string `json:"transport,omitempty"`
+}
+
+// RemoteSSHDocument describes how a remote is reached over SSH
+type RemoteSSHDocument struct {
+	User         string   `json:"user"`
+	IdentityFile string   `json:"identity_file"`
+	Port         string   `json:"port"`
+	ProxyJump    []string `json:"proxy_jump,omitempty"`
+	HostKey      string   `json:"host_key,omitempty"`
+}
+
+// NewRemoteDocument describes the given remote, with the same details as
+// FormatRemoteDetails
+func NewRemoteDocument(remote cfg.Remote) RemoteDocument {
+	var doc = RemoteDocument{
+		Name:     remote.Name,
+		Version:  remote.Version,
+		IP:       remote.IP,
+		Profiles: remote.Profiles,
+	}
+	if remote.Daemon != nil {
+		doc.Daemon = &RemoteDaemonDocument{
+			Port:          remote.Daemon.Port,
+			Authenticated: remote.Daemon.Token != "",
+			VerifySSL:     remote.Daemon.VerifySSL,
+			Transport:     remote.Daemon.Transport,
+		}
+	}
+	if remote.SSH != nil {
+		doc.SSH = &RemoteSSHDocument{
+			User:         remote.SSH.User,
+			IdentityFile: remote.SSH.IdentityFile,
+			Port:         remote.SSH.SSHPort,
+			ProxyJump:    remote.SSH.ProxyJump,
+		}
+		if remote.SSH.HostKey != "" {
+			doc.SSH.HostKey = formatHostKey(remote.SSH.HostKey)
+		}
+	}
+	return doc
+}
+
+// ProfilesDocument is the structured output of 'inertia project profile ls'
+type ProfilesDocument struct {
+	Profiles []ProfileDocument `json:"profiles"`
+}
+
+// ProfileDocument describes a project profile
+type ProfileDocument struct {
+	Name      string `json:"name"`
+	Branch    string `json:"branch"`
+	BuildType string `json:"build_type,omitempty"`
+	BuildFile string `json:"build_file,omitempty"`
+}
+
+// NewProfileDocument describes the given profile
+func NewProfileDocument(pf cfg.Profile) ProfileDocument {
+	var doc = ProfileDocument{Name: pf.Name, Branch: pf.Branch}
+	if pf.Build != nil {
+		doc.BuildType = string(pf.Build.Type)
+		doc.BuildFile = pf.Build.BuildFilePath
+	}
+	return doc
+}
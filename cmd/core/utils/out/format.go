@@ -2,6 +2,10 @@ package out
 
 import (
 	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
 
 	"github.com/ubclaunchpad/inertia/api"
 	"github.com/ubclaunchpad/inertia/cfg"
@@ -72,14 +76,163 @@ func FormatRemoteDetails(remote cfg.Remote) string {
 		remoteString += fmt.Sprintf(":passport_control: Daemon.Port:          %s\n", remote.Daemon.Port)
 		remoteString += fmt.Sprintf(":lock: Daemon.Authenticated: %v\n", remote.Daemon.Token != "")
 		remoteString += fmt.Sprintf(":mag: Daemon.VerifySSL:     %v\n", remote.Daemon.VerifySSL)
+		if remote.Daemon.Transport != "" {
+			remoteString += fmt.Sprintf(":railway_track: Daemon.Transport:     %s\n", remote.Daemon.Transport)
+		}
 	}
 	if remote.SSH != nil {
 		remoteString += fmt.Sprintf(":ghost: SSH.User:             %s\n", remote.SSH.User)
 		remoteString += fmt.Sprintf(":key: SSH.IdentityFile:     %s\n", remote.SSH.IdentityFile)
 		remoteString += fmt.Sprintf(":customs: SSH.Port:             %s\n", remote.SSH.SSHPort)
+		if len(remote.SSH.ProxyJump) > 0 {
+			remoteString += fmt.Sprintf(":bridge_at_night: SSH.ProxyJump:        %s\n", strings.Join(remote.SSH.ProxyJump, ","))
+		}
+		if remote.SSH.HostKey != "" {
+			remoteString += fmt.Sprintf(":closed_lock_with_key: SSH.HostKey:          %s\n", formatHostKey(remote.SSH.HostKey))
+		}
 	}
 	if remote.Profiles != nil {
 		remoteString += fmt.Sprintf(":open_file_folder: Profiles:             %v", remote.Profiles)
 	}
 	return remoteString
 }
+
+// formatHostKey renders the fingerprint of the given authorized_keys-formatted
+// host key
+func formatHostKey(hostKey string) string {
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(hostKey))
+	if err != nil {
+		return "invalid host key"
+	}
+	return key.Type() + " " + ssh.FingerprintSHA256(key)
+}
+
+// FormatEvent renders the given event as a line of text, with its details
+// sorted by key
+func FormatEvent(e api.Event) string {
+	var color = NewColorer(CY)
+	switch {
+	case e.Type == api.EventContainerDied, e.Type == api.EventWebhookIgnored,
+		e.Data["status"] == "failed":
+		color = NewColorer(RD)
+	case e.Type == api.EventContainerStarted, e.Data["status"] == "succeeded":
+		color = NewColorer(GR)
+	}
+
+	var keys = make([]string, 0, len(e.Data))
+	for k, v := range e.Data {
+		if v != "" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	var details = make([]string, len(keys))
+	for i, k := range keys {
+		details[i] = k + "=" + e.Data[k]
+	}
+
+	var line = fmt.Sprintf("%s %s %s", e.Time.Local().Format("15:04:05"),
+		color.Sf("%-17s", e.Type), e.Message)
+	if len(details) > 0 {
+		line += " (" + strings.Join(details, ", ") + ")"
+	}
+	return line
+}
+
+// FormatPlan prints the given deployment plan
+func FormatPlan(p *api.DeploymentPlan) string {
+	var planString string
+	if p.Current == nil {
+		planString += " - Current: " + msgNoDeployment + "\n"
+	} else {
+		planString += " - Current: " + formatCommit(*p.Current) + "\n"
+	}
+	planString += " - Target:  " + formatCommit(p.Target) + "\n"
+
+	if p.Current != nil {
+		if len(p.Commits) == 0 {
+			planString += "No new commits.\n"
+		} else {
+			planString += "Commits:\n"
+			for _, c := range p.Commits {
+				planString += " - " + formatCommit(c) + "\n"
+			}
+			if p.CommitsTruncated {
+				planString += " - ...\n"
+			}
+		}
+	}
+
+	planString += "Settings:\n"
+	for _, s := range p.Settings {
+		var current, planned = s.Current, s.Planned
+		if current == "" {
+			current = "(none)"
+		}
+		if planned == "" {
+			planned = "(none)"
+		}
+		if s.Changed() {
+			planString += C(" ~ %-24s %s -> %s\n", YE).With(s.Name+":", current, planned).String()
+		} else {
+			planString += fmt.Sprintf("   %-24s %s\n", s.Name+":", planned)
+		}
+	}
+
+	if len(p.Env) > 0 {
+		planString += "Environment variables:\n"
+		for _, e := range p.Env {
+			var name = e.Name
+			if e.Container != "" {
+				name += " (container: " + e.Container + ")"
+			}
+			switch e.Change {
+			case api.EnvAdded:
+				planString += C(" + %s\n", GR).With(name).String()
+			case api.EnvRemoved:
+				planString += C(" - %s\n", RD).With(name).String()
+			default:
+				planString += C(" ~ %s\n", YE).With(name).String()
+			}
+		}
+	}
+
+	if len(p.Containers) > 0 {
+		planString += "Containers to be recreated:\n"
+		for _, container := range p.Containers {
+			planString += " - " + container + "\n"
+		}
+	}
+
+	return planString
+}
+
+// FormatChanges prints the commits shipped by the given deployment
+func FormatChanges(d *api.Deployment) string {
+	if d == nil || len(d.Changes) == 0 {
+		return "No changes recorded for this deployment.\n"
+	}
+	var changesString = "Changes in this deployment:\n"
+	for _, c := range d.Changes {
+		changesString += " - " + formatCommit(c) + "\n"
+	}
+	if d.ChangesTruncated {
+		changesString += " - ...\n"
+	}
+	return changesString
+}
+
+// formatCommit renders the given commit as its short hash, message, and author
+func formatCommit(c api.Commit) string {
+	var line = c.Hash
+	if len(line) > 7 {
+		line = line[:7]
+	}
+	if c.Message != "" {
+		line += " " + c.Message
+	}
+	if c.Author != "" {
+		line += " (" + c.Author + ")"
+	}
+	return line
+}
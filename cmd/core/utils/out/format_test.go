@@ -1,7 +1,9 @@
 package out
 
 import (
+	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/ubclaunchpad/inertia/api"
@@ -84,3 +86,71 @@ func TestFormatRemoteDetails(t *testing.T) {
 	out = FormatRemoteDetails(cfg.Remote{Name: "bob", IP: "0.0.0.0"})
 	assert.Contains(t, out, "0.0.0.0")
 }
+
+func TestFormatEvent(t *testing.T) {
+	os.Setenv(EnvColorToggle, "false")
+	defer os.Setenv(EnvColorToggle, "")
+
+	var out = FormatEvent(api.Event{
+		Type:    api.EventBuildFinished,
+		Time:    time.Date(2020, 10, 19, 12, 0, 0, 0, time.Local),
+		Message: "build failed",
+		Data:    map[string]string{"status": "failed", "branch": "master", "error": "oh no", "empty": ""},
+	})
+	assert.Equal(t, "12:00:00 build.finished    build failed (branch=master, error=oh no, status=failed)", out)
+
+	out = FormatEvent(api.Event{Type: api.EventUserLoggedIn, Message: "user bob logged in"})
+	assert.Contains(t, out, "user.logged_in    user bob logged in")
+	assert.NotContains(t, out, "(")
+}
+
+func TestFormatPlan(t *testing.T) {
+	os.Setenv(EnvColorToggle, "false")
+	defer os.Setenv(EnvColorToggle, "")
+
+	var out = FormatPlan(&api.DeploymentPlan{
+		Current: &api.Commit{Hash: "1234567890", Author: "bob", Message: "initial commit"},
+		Target:  api.Commit{Hash: "abcdefghij", Author: "alice", Message: "add feature"},
+		Commits: []api.Commit{
+			{Hash: "abcdefghij", Author: "alice", Message: "add feature"},
+		},
+		CommitsTruncated: true,
+		Settings: []api.PlanSetting{
+			{Name: "branch", Current: "master", Planned: "dev"},
+			{Name: "profile", Current: "default", Planned: "default"},
+		},
+		Env: []api.EnvChange{
+			{Name: "PORT", Change: api.EnvChanged},
+			{Name: "TOKEN", Container: "web", Change: api.EnvAdded},
+		},
+		Containers: []string{"/web"},
+	})
+	assert.Contains(t, out, "Current: 1234567 initial commit (bob)")
+	assert.Contains(t, out, "Target:  abcdefg add feature (alice)")
+	assert.Contains(t, out, " - ...\n")
+	assert.Contains(t, out, " ~ branch:                  master -> dev")
+	assert.Contains(t, out, "   profile:                 default")
+	assert.Contains(t, out, " ~ PORT\n")
+	assert.Contains(t, out, " + TOKEN (container: web)\n")
+	assert.Contains(t, out, "Containers to be recreated:\n - /web\n")
+
+	out = FormatPlan(&api.DeploymentPlan{Target: api.Commit{Hash: "abcdefghij"}})
+	assert.Contains(t, out, msgNoDeployment)
+	assert.NotContains(t, out, "Commits:")
+}
+
+func TestFormatChanges(t *testing.T) {
+	var out = FormatChanges(&api.Deployment{
+		CommitHash: "abcdefghij",
+		Changes: []api.Commit{
+			{Hash: "abcdefghij", Author: "alice", Message: "add feature"},
+			{Hash: "1234567890", Author: "bob", Message: "fix things"},
+		},
+		ChangesTruncated: true,
+	})
+	assert.Equal(t, "Changes in this deployment:\n"+
+		" - abcdefg add feature (alice)\n"+
+		" - 1234567 fix things (bob)\n"+
+		" - ...\n", out)
+	assert.Contains(t, FormatChanges(nil), "No changes recorded")
+}
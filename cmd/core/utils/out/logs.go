@@ -0,0 +1,54 @@
+package out
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ubclaunchpad/inertia/api"
+)
+
+// logPrefixColors are cycled through to tell containers apart in merged logs
+var logPrefixColors = []ColorTraits{CY, GR, YE, MA, BL}
+
+// LogFormatter formats container log entries. When logs from several
+// containers are shown, each entry is prefixed with its container's name, in a
+// colour of its own.
+type LogFormatter struct {
+	prefix bool
+	width  int
+	colors map[string]*Colorer
+}
+
+// NewLogFormatter creates a formatter for logs of the given containers, which
+// prefixes entries with their container if prefix is set
+func NewLogFormatter(prefix bool, containers ...string) *LogFormatter {
+	var f = &LogFormatter{prefix: prefix, colors: make(map[string]*Colorer)}
+	for _, c := range containers {
+		f.colorer(strings.TrimPrefix(c, "/"))
+	}
+	return f
+}
+
+// Format renders the given entry as a line of text, without a trailing newline
+func (f *LogFormatter) Format(e api.LogEntry) string {
+	var msg = strings.TrimRight(e.Message, "\r\n")
+	if !f.prefix || e.Container == "" {
+		return msg
+	}
+	var c = f.colorer(e.Container)
+	return c.S(fmt.Sprintf("%-*s |", f.width, e.Container)) + " " + msg
+}
+
+// colorer returns the colour assigned to the given container, assigning one
+// if it doesn't have one yet
+func (f *LogFormatter) colorer(container string) *Colorer {
+	if c, ok := f.colors[container]; ok {
+		return c
+	}
+	var c = NewColorer(logPrefixColors[len(f.colors)%len(logPrefixColors)])
+	f.colors[container] = c
+	if len(container) > f.width {
+		f.width = len(container)
+	}
+	return c
+}
@@ -0,0 +1,33 @@
+package out
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ubclaunchpad/inertia/api"
+)
+
+func TestLogFormatter(t *testing.T) {
+	os.Setenv(EnvColorToggle, "false")
+	defer os.Setenv(EnvColorToggle, "")
+
+	var f = NewLogFormatter(true, "/web", "database")
+	assert.Equal(t, "web      | hello :rocket:", f.Format(api.LogEntry{Container: "web", Message: "hello :rocket:\n"}))
+	assert.Equal(t, "database | hi", f.Format(api.LogEntry{Container: "database", Message: "hi"}))
+
+	// without prefixes, only the message is shown
+	f = NewLogFormatter(false, "web")
+	assert.Equal(t, "hello", f.Format(api.LogEntry{Container: "web", Message: "hello"}))
+}
+
+func TestLogFormatter_colors(t *testing.T) {
+	os.Setenv(EnvColorToggle, "true")
+	defer os.Setenv(EnvColorToggle, "")
+
+	var f = NewLogFormatter(true)
+	assert.Contains(t, f.Format(api.LogEntry{Container: "web", Message: "hello"}), "[36m")
+	assert.Contains(t, f.Format(api.LogEntry{Container: "db", Message: "hello"}), "[32m")
+	// colours should stay the same for each container
+	assert.Contains(t, f.Format(api.LogEntry{Container: "web", Message: "again"}), "[36m")
+}
@@ -0,0 +1,144 @@
+package out
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// EnvOutputFormat is the environment variable used to set the output format
+const EnvOutputFormat = "INERTIA_OUTPUT"
+
+// Output formats. Structured formats write documents to stdout, and all other
+// output to stderr.
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+)
+
+// Exit codes
+const (
+	// ExitError indicates that a command failed
+	ExitError = 1
+	// ExitUsage indicates that a command was used incorrectly, such as with
+	// unknown flags or the wrong number of arguments
+	ExitUsage = 2
+)
+
+// Error is the structured form of an error that caused a command to exit
+type Error struct {
+	Error string `json:"error"`
+	Code  int    `json:"code"`
+}
+
+// SetOutputFormat sets the output format for all output. Colour and emoji are
+// disabled for structured formats.
+func SetOutputFormat(format string) error {
+	switch format {
+	case FormatText:
+	case FormatJSON, FormatYAML:
+		os.Setenv(EnvColorToggle, "false")
+		os.Setenv(EnvEmojiToggle, "false")
+	default:
+		return fmt.Errorf("invalid output format %q - must be one of %q, %q, or %q",
+			format, FormatText, FormatJSON, FormatYAML)
+	}
+	return os.Setenv(EnvOutputFormat, format)
+}
+
+// OutputFormat returns the configured output format
+func OutputFormat() string {
+	switch format := os.Getenv(EnvOutputFormat); format {
+	case FormatJSON, FormatYAML:
+		return format
+	default:
+		return FormatText
+	}
+}
+
+// Structured checks if output should be written as structured documents
+func Structured() bool { return OutputFormat() != FormatText }
+
+// TextOut returns where human-readable output, such as progress updates, should
+// be written - stderr if structured output is enabled, and stdout otherwise
+func TextOut() io.Writer {
+	if Structured() {
+		return os.Stderr
+	}
+	return os.Stdout
+}
+
+// Render writes doc to stdout if structured output is enabled, and calls text
+// to write human-readable output otherwise
+func Render(doc interface{}, text func()) {
+	if !Structured() {
+		text()
+		return
+	}
+	if err := WriteDocument(os.Stdout, doc); err != nil {
+		Fatal(err)
+	}
+}
+
+// RenderStream is like Render, but for a document that is one of many in a
+// stream, such as a log entry. JSON documents are written one per line, and
+// YAML documents are separated by '---'.
+func RenderStream(doc interface{}, text func()) {
+	if !Structured() {
+		text()
+		return
+	}
+	var err error
+	if OutputFormat() == FormatJSON {
+		var b []byte
+		if b, err = json.Marshal(doc); err == nil {
+			_, err = fmt.Fprintf(os.Stdout, "%s\n", b)
+		}
+	} else {
+		if _, err = fmt.Fprintln(os.Stdout, "---"); err == nil {
+			err = WriteDocument(os.Stdout, doc)
+		}
+	}
+	if err != nil {
+		Fatal(err)
+	}
+}
+
+// WriteDocument writes doc to w in the configured structured format. Documents
+// are encoded as JSON first, so that keys are the same in every format.
+func WriteDocument(w io.Writer, doc interface{}) error {
+	b, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode output: %w", err)
+	}
+	if OutputFormat() == FormatYAML {
+		var v yaml.MapSlice
+		if err := yaml.Unmarshal(b, &v); err != nil {
+			// documents that are not objects, such as lists
+			var raw interface{}
+			if err := yaml.Unmarshal(b, &raw); err != nil {
+				return fmt.Errorf("failed to encode output: %w", err)
+			}
+			b, err = yaml.Marshal(raw)
+		} else {
+			b, err = yaml.Marshal(v)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to encode output: %w", err)
+		}
+		_, err = w.Write(b)
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", b)
+	return err
+}
+
+// writeError writes a structured error to stderr
+func writeError(code int, msg string) {
+	WriteDocument(os.Stderr, Error{Error: strings.TrimSpace(msg), Code: code})
+}
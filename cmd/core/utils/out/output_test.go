@@ -0,0 +1,105 @@
+package out
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/ubclaunchpad/inertia/cfg"
+)
+
+func withOutputFormat(t *testing.T, format string) {
+	var env = map[string]string{}
+	for _, k := range []string{EnvOutputFormat, EnvColorToggle, EnvEmojiToggle} {
+		env[k] = os.Getenv(k)
+	}
+	t.Cleanup(func() {
+		for k, v := range env {
+			os.Setenv(k, v)
+		}
+	})
+	require.NoError(t, SetOutputFormat(format))
+}
+
+func TestSetOutputFormat(t *testing.T) {
+	withOutputFormat(t, FormatText)
+	assert.False(t, Structured())
+
+	withOutputFormat(t, FormatYAML)
+	assert.True(t, Structured())
+	assert.Equal(t, FormatYAML, OutputFormat())
+	assert.False(t, WithColor())
+	assert.False(t, WithEmoji())
+
+	assert.Error(t, SetOutputFormat("xml"))
+	assert.Equal(t, FormatYAML, OutputFormat())
+}
+
+func TestWriteDocument(t *testing.T) {
+	var doc = UsersDocument{Users: []string{"bob", "alice"}}
+
+	t.Run("json", func(t *testing.T) {
+		withOutputFormat(t, FormatJSON)
+		var buf bytes.Buffer
+		require.NoError(t, WriteDocument(&buf, doc))
+		var got UsersDocument
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+		assert.Equal(t, doc, got)
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		withOutputFormat(t, FormatYAML)
+		var buf bytes.Buffer
+		require.NoError(t, WriteDocument(&buf, NewProfileDocument(cfg.Profile{
+			Name:   "staging",
+			Branch: "dev",
+			Build:  &cfg.Build{Type: cfg.DockerCompose, BuildFilePath: "docker-compose.yml"},
+		})))
+		// keys match JSON keys, in the order they are declared
+		assert.Equal(t, `name: staging
+branch: dev
+build_type: docker-compose
+build_file: docker-compose.yml
+`, buf.String())
+
+		buf.Reset()
+		require.NoError(t, WriteDocument(&buf, []string{"bob"}))
+		var got []string
+		require.NoError(t, yaml.Unmarshal(buf.Bytes(), &got))
+		assert.Equal(t, []string{"bob"}, got)
+	})
+}
+
+func TestRender(t *testing.T) {
+	withOutputFormat(t, FormatText)
+	var called bool
+	Render(UsersDocument{}, func() { called = true })
+	assert.True(t, called)
+
+	withOutputFormat(t, FormatJSON)
+	called = false
+	Render(UsersDocument{}, func() { called = true })
+	assert.False(t, called)
+}
+
+func TestNewRemoteDocument(t *testing.T) {
+	var doc = NewRemoteDocument(cfg.Remote{
+		Name:   "prod",
+		IP:     "127.0.0.1",
+		Daemon: &cfg.Daemon{Port: "4303", Token: "secret", WebHookSecret: "secret"},
+		SSH:    &cfg.SSH{User: "root", SSHPort: "22"},
+	})
+	assert.Equal(t, "prod", doc.Name)
+	assert.True(t, doc.Daemon.Authenticated)
+	assert.Equal(t, "22", doc.SSH.Port)
+
+	// secrets are never included
+	b, err := json.Marshal(doc)
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), "secret")
+}
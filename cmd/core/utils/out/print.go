@@ -1,18 +1,35 @@
 package out
 
 import (
+	"fmt"
 	"os"
 )
 
-// Fatal is a wrapper around fmt.Print that exits with status 1
+// Fatal is a wrapper around fmt.Print that exits with status ExitError. If
+// structured output is enabled, the error is written as a structured Error.
 func Fatal(args ...interface{}) {
-	Println(args...)
-	os.Exit(1)
+	FatalCode(ExitError, args...)
 }
 
-// Fatalf is a wrapper around out.Printf that exits with status 1
+// Fatalf is a wrapper around out.Printf that exits with status ExitError. If
+// structured output is enabled, the error is written as a structured Error.
 func Fatalf(format string, args ...interface{}) {
-	Printf(format, args...)
-	println()
-	os.Exit(1)
+	if Structured() {
+		writeError(ExitError, Sprintf(format, args...))
+	} else {
+		Printf(format, args...)
+		println()
+	}
+	os.Exit(ExitError)
+}
+
+// FatalCode is a wrapper around fmt.Print that exits with the given status. If
+// structured output is enabled, the error is written as a structured Error.
+func FatalCode(code int, args ...interface{}) {
+	if Structured() {
+		writeError(code, fmt.Sprintln(args...))
+	} else {
+		Println(args...)
+	}
+	os.Exit(code)
 }
@@ -137,17 +137,23 @@ func (p *ProfileCmd) attachListCmd() {
 				p.root.config.Profiles = make([]*cfg.Profile, 0)
 				local.Write(p.root.projectConfigPath, p.root.config)
 			}
+			var doc = out.ProfilesDocument{Profiles: make([]out.ProfileDocument, 0, len(p.root.config.Profiles))}
 			for _, pf := range p.root.config.Profiles {
-				if verbose {
-					out.Print(out.C("profile '%s'\n", out.BO, out.CY).With(pf.Name))
-					out.Printf(`:christmas_tree: Branch:              %s
+				doc.Profiles = append(doc.Profiles, out.NewProfileDocument(*pf))
+			}
+			out.Render(doc, func() {
+				for _, pf := range p.root.config.Profiles {
+					if verbose {
+						out.Print(out.C("profile '%s'\n", out.BO, out.CY).With(pf.Name))
+						out.Printf(`:christmas_tree: Branch:              %s
 :hammer: Build.Type:          %s
 :ledger: Build.BuildFile:     %s
 `, pf.Branch, pf.Build.Type, pf.Build.BuildFilePath)
-				} else {
-					out.Println(pf.Name)
+					} else {
+						out.Println(pf.Name)
+					}
 				}
-			}
+			})
 		},
 	}
 	ls.Flags().BoolVarP(&verbose, "verbose", "v", false, "print profile details")
@@ -166,11 +172,13 @@ func (p *ProfileCmd) attachShowCmd() {
 			if !ok {
 				out.Fatalf("profile '%s' not found", args[0])
 			}
-			out.Print(out.C("profile '%s'\n", out.BO, out.CY).With(args[0]))
-			out.Printf(`:christmas_tree: Branch:              %s
+			out.Render(out.NewProfileDocument(*pf), func() {
+				out.Print(out.C("profile '%s'\n", out.BO, out.CY).With(args[0]))
+				out.Printf(`:christmas_tree: Branch:              %s
 :hammer: Build.Type:          %s
 :ledger: Build.BuildFile:     %s
 `, pf.Branch, pf.Build.Type, pf.Build.BuildFilePath)
+			})
 		},
 	}
 	p.AddCommand(show)
@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ubclaunchpad/inertia/client"
+	"github.com/ubclaunchpad/inertia/cmd/core"
+	"github.com/ubclaunchpad/inertia/cmd/core/utils/input"
+	"github.com/ubclaunchpad/inertia/cmd/core/utils/out"
+	"github.com/ubclaunchpad/inertia/local"
+)
+
+func attachPromoteCmd(inertia *core.Cmd) {
+	const (
+		flagYes   = "yes"
+		flagShort = "short"
+	)
+	var promote = &cobra.Command{
+		Use:   "promote [remote]",
+		Short: "Promote a deployment to the next stage of your pipeline",
+		Long: `Promotes the commit currently deployed on the previous stage of your pipeline
+to the given remote, using the profile configured for the remote's stage.
+
+Pipelines are configured in your project configuration as a list of stages:
+
+    [[pipeline]]
+    remote = "staging"
+    profile = "staging"
+    health_check = "https://staging.my-app.com/health"
+
+    [[pipeline]]
+    remote = "production"
+    profile = "production"
+    confirm = true
+
+If a stage has a 'health_check', it must respond successfully before its
+deployment can be promoted. If a stage has 'confirm' set, you will be asked to
+confirm before anything is deployed to it.`,
+		Example: "inertia promote production",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			var (
+				yes, _   = cmd.Flags().GetBool(flagYes)
+				short, _ = cmd.Flags().GetBool(flagShort)
+			)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			input.CatchSigterm(cancel)
+
+			// load pipeline
+			project, err := local.GetProject(inertia.ProjectConfigPath)
+			if err != nil {
+				out.Fatal("no project found in current directory - try 'inertia init'")
+			}
+			if err := project.ValidatePipeline(); err != nil {
+				out.Fatalf("invalid pipeline in %s: %s", inertia.ProjectConfigPath, err.Error())
+			}
+			i, found := project.GetPipelineStage(args[0])
+			if !found {
+				out.Fatalf("remote '%s' is not a stage in your pipeline", args[0])
+			}
+			if i == 0 {
+				out.Fatalf("remote '%s' is the first stage in your pipeline - deploy to it with 'inertia %s up'",
+					args[0], args[0])
+			}
+			var from, to = project.Pipeline[i-1], project.Pipeline[i]
+
+			remotes, err := local.GetRemotes()
+			if err != nil {
+				out.Fatal(err)
+			}
+			var clients = make(map[string]*client.Client, 2)
+			for _, name := range []string{from.Remote, to.Remote} {
+				remote, found := remotes.GetRemote(name)
+				if !found {
+					out.Fatalf("remote '%s' does not exist", name)
+				}
+				c, err := client.NewClient(remote, client.Options{
+					SSH: local.SSHOptions(remote),
+					Out: out.TextOut(),
+				})
+				if err != nil {
+					out.Fatalf("failed to load remote '%s': %s", name, err.Error())
+				}
+				clients[name] = c
+			}
+
+			// determine what to promote
+			status, err := clients[from.Remote].Status(ctx)
+			if err != nil {
+				out.Fatalf("failed to get status of '%s': %s", from.Remote, err.Error())
+			}
+			if status.CommitHash == "" {
+				out.Fatalf("no deployment found on '%s' to promote", from.Remote)
+			}
+			out.Printf("promoting commit %s (%q) from '%s' to '%s'\n",
+				status.CommitHash, status.CommitMessage, from.Remote, to.Remote)
+
+			// check gates
+			if from.HealthCheck != "" {
+				out.Printf("checking health of '%s'...\n", from.Remote)
+				if err := checkHealth(ctx, from.HealthCheck); err != nil {
+					out.Fatalf("aborting: '%s' is not healthy: %s", from.Remote, err.Error())
+				}
+			}
+			if to.Confirm && !yes {
+				ok, err := input.NewPrompt(nil).
+					Prompt(out.C(":question: Deploy commit %s to '%s'? (y/N)", out.CY).
+						With(status.CommitHash, to.Remote)).
+					GetBool()
+				if err != nil || !ok {
+					out.Fatal("aborting")
+				}
+			}
+
+			// deploy to next stage
+			var target = clients[to.Remote]
+			var profileName = to.Profile
+			if profileName == "" {
+				profileName = target.Remote.GetProfile(project.Name)
+			}
+			profile, found := project.GetProfile(profileName)
+			if !found {
+				out.Fatalf("could not find profile '%s'", profileName)
+			}
+			var req = client.UpRequest{
+				Project: project.Name,
+				URL:     project.URL,
+				Profile: *profile,
+				Commit:  status.CommitHash,
+			}
+			if short {
+				err = target.Up(ctx, req)
+			} else {
+				err = target.UpWithOutput(ctx, req)
+			}
+			if err != nil {
+				out.Fatal(err)
+			}
+			out.Printf("commit %s successfully promoted to '%s'\n", status.CommitHash, to.Remote)
+		},
+	}
+	promote.Flags().BoolP(flagYes, "y", false, "skip confirmation gates")
+	promote.Flags().BoolP(flagShort, "s", false, "don't stream output from deployment")
+	inertia.AddCommand(promote)
+}
+
+// checkHealth errors if the given URL does not respond with a 2xx status
+func checkHealth(ctx context.Context, url string) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("health check responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_checkHealth(t *testing.T) {
+	var healthy = true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if healthy {
+			w.WriteHeader(http.StatusOK)
+		} else {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	assert.NoError(t, checkHealth(context.Background(), server.URL))
+	healthy = false
+	assert.Error(t, checkHealth(context.Background(), server.URL))
+	assert.Error(t, checkHealth(context.Background(), "http://127.0.0.1:0"))
+}
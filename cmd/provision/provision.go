@@ -1,14 +1,11 @@
 package provisioncmd
 
 import (
-	"os"
-
 	"github.com/spf13/cobra"
 
 	"github.com/ubclaunchpad/inertia/cfg"
 	"github.com/ubclaunchpad/inertia/client"
 	"github.com/ubclaunchpad/inertia/client/bootstrap"
-	"github.com/ubclaunchpad/inertia/client/runner"
 	"github.com/ubclaunchpad/inertia/cmd/core"
 	"github.com/ubclaunchpad/inertia/cmd/core/utils/input"
 	"github.com/ubclaunchpad/inertia/cmd/core/utils/out"
@@ -26,8 +23,9 @@ type ProvisionCmd struct {
 }
 
 const (
-	flagDaemonPort = "daemon.port"
-	flagPorts      = "ports"
+	flagDaemonPort      = "daemon.port"
+	flagDaemonTransport = "daemon.transport"
+	flagPorts           = "ports"
 )
 
 // AttachProvisionCmd attaches the 'provision' subcommands to the given parent
@@ -51,6 +49,8 @@ func AttachProvisionCmd(inertia *core.Cmd) {
 		},
 	}
 	prov.PersistentFlags().StringP(flagDaemonPort, "d", "4303", "daemon port")
+	prov.PersistentFlags().String(flagDaemonTransport, cfg.TransportDirect,
+		"how to reach the daemon - 'ssh-tunnel' avoids exposing the daemon port")
 	prov.PersistentFlags().StringArrayP(flagPorts, "p", []string{}, "ports your project uses")
 
 	// add children
@@ -111,7 +111,7 @@ This ensures that your project ports are properly exposed and externally accessi
 			var prov *provision.EC2Provisioner
 			var err error
 			if fromEnv {
-				prov, err = provision.NewEC2ProvisionerFromEnv(user, os.Stdout)
+				prov, err = provision.NewEC2ProvisionerFromEnv(user, out.TextOut())
 				if err != nil {
 					out.Fatal(err)
 				}
@@ -119,7 +119,7 @@ This ensures that your project ports are properly exposed and externally accessi
 				var profileUser, _ = cmd.Flags().GetString(flagProfileUser)
 				var profilePath, _ = cmd.Flags().GetString(flagProfilePath)
 				prov, err = provision.NewEC2ProvisionerFromProfile(
-					user, profileUser, profilePath, os.Stdout)
+					user, profileUser, profilePath, out.TextOut())
 				if err != nil {
 					out.Fatal(err)
 				}
@@ -128,7 +128,7 @@ This ensures that your project ports are properly exposed and externally accessi
 				if err != nil {
 					out.Fatal(err)
 				}
-				prov, err = provision.NewEC2Provisioner(user, keyID, key, os.Stdout)
+				prov, err = provision.NewEC2Provisioner(user, keyID, key, out.TextOut())
 				if err != nil {
 					out.Fatal(err)
 				}
@@ -175,11 +175,16 @@ This ensures that your project ports are properly exposed and externally accessi
 			// Create remote instance
 			var port, _ = cmd.Flags().GetString(flagDaemonPort)
 			var portDaemon, _ = common.ParseInt64(port)
+			var transport, _ = cmd.Flags().GetString(flagDaemonTransport)
+			if err := (&cfg.Daemon{Transport: transport}).ValidateTransport(); err != nil {
+				out.Fatal(err)
+			}
 			remote, err := prov.CreateInstance(provision.EC2CreateInstanceOptions{
-				Name:        args[0],
-				ProjectName: root.project.Name,
-				Ports:       ports,
-				DaemonPort:  portDaemon,
+				Name:            args[0],
+				ProjectName:     root.project.Name,
+				Ports:           ports,
+				DaemonPort:      portDaemon,
+				DaemonTransport: transport,
 
 				ImageID:      image,
 				InstanceType: instanceType,
@@ -195,7 +200,7 @@ This ensures that your project ports are properly exposed and externally accessi
 
 			// Create inertia client
 			inertia, err := client.NewClient(remote, client.Options{
-				SSH: runner.SSHOptions{KeyPassphrase: os.Getenv(local.EnvSSHPassphrase)},
+				SSH: local.SSHOptions(remote),
 			})
 			if err != nil {
 				out.Fatal((err))
@@ -206,7 +211,7 @@ This ensures that your project ports are properly exposed and externally accessi
 			var repo = common.ExtractRepository(common.GetSSHRemoteURL(root.project.URL))
 			if err := bootstrap.Bootstrap(inertia, bootstrap.Options{
 				RepoName: repo,
-				Out:      os.Stdout,
+				Out:      out.TextOut(),
 			}); err != nil {
 				out.Fatal(err.Error())
 			}
@@ -216,6 +221,7 @@ This ensures that your project ports are properly exposed and externally accessi
 			if err := local.SaveRemote(remote); err != nil {
 				out.Fatal(err.Error())
 			}
+			out.Render(out.NewRemoteDocument(*remote), func() {})
 		},
 	}
 	provEC2.Flags().StringP(flagType, "t",
@@ -11,6 +11,7 @@ import (
 
 	"github.com/BurntSushi/toml"
 	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh"
 	"golang.org/x/crypto/ssh/terminal"
 
 	"github.com/ubclaunchpad/inertia/cfg"
@@ -68,6 +69,8 @@ For example:
 	remote.attachRemoveCmd()
 	remote.attachUpgradeCmd()
 	remote.attachImportCmd()
+	remote.attachSetHostKeyCmd()
+	remote.attachResetHostKeyCmd()
 	remote.attachResetCmd()
 	remote.attachConfigPathCmd()
 
@@ -97,6 +100,7 @@ func (root *RemoteCmd) attachAddCmd() {
 		flagSSHKey          = "ssh.key"
 		flagSSHUser         = "ssh.user"
 		flagWebhookGenerate = "daemon.gen-secret"
+		flagDaemonTransport = "daemon.transport"
 	)
 	var (
 		daemonPort       string
@@ -129,7 +133,16 @@ Inertia commands.`,
 				sshPort, _ = cmd.Flags().GetString(flagSSHPort)
 				keyPath, _ = cmd.Flags().GetString(flagSSHKey)
 				user, _    = cmd.Flags().GetString(flagSSHUser)
+
+				transport, _ = cmd.Flags().GetString(flagDaemonTransport)
 			)
+			var daemon = &cfg.Daemon{
+				Port:      port,
+				Transport: transport,
+			}
+			if err := daemon.ValidateTransport(); err != nil {
+				out.Fatal(err)
+			}
 
 			out.Printf("creating new remote '%s'\n", args[0])
 			var highlight = out.NewColorer(out.CY)
@@ -183,6 +196,8 @@ Inertia commands.`,
 				}
 			}
 
+			daemon.WebHookSecret = webhookSecret
+
 			out.Println("saving new remote...")
 			if err := local.SaveRemote(&cfg.Remote{
 				Name:    args[0],
@@ -193,10 +208,7 @@ Inertia commands.`,
 					IdentityFile: keyPath,
 					SSHPort:      sshPort,
 				},
-				Daemon: &cfg.Daemon{
-					Port:          port,
-					WebHookSecret: webhookSecret,
-				},
+				Daemon:   daemon,
 				Profiles: make(map[string]string),
 			}); err != nil {
 				out.Fatal(err)
@@ -211,6 +223,8 @@ Inertia commands.`,
 	addRemote.Flags().StringVar(&sshPort, flagSSHPort, "22", "remote SSH port")
 	addRemote.Flags().String(flagSSHKey, "", "path to SSH key for remote")
 	addRemote.Flags().String(flagSSHUser, "", "user to use when accessing remote over SSH")
+	addRemote.Flags().String(flagDaemonTransport, cfg.TransportDirect,
+		"how to reach the daemon - 'ssh-tunnel' avoids exposing the daemon port")
 	addRemote.Flags().BoolVar(&genWebhookSecret, flagWebhookGenerate, true, "toggle webhook secret generation")
 	root.AddCommand(addRemote)
 }
@@ -258,7 +272,7 @@ func (root *RemoteCmd) attachLoginCmd() {
 			}
 
 			// set up client
-			c, err := client.NewClient(remoteCfg, client.Options{Out: os.Stdout})
+			c, err := client.NewClient(remoteCfg, client.Options{Out: out.TextOut()})
 			if err != nil {
 				out.Fatal(err.Error())
 			}
@@ -318,14 +332,20 @@ func (root *RemoteCmd) attachListCmd() {
 		Long:  `Lists all currently configured remotes.`,
 		Run: func(cmd *cobra.Command, args []string) {
 			var verbose, _ = cmd.Flags().GetBool(flagVerbose)
+			var doc = out.RemotesDocument{Remotes: make([]out.RemoteDocument, 0, len(root.remotes.Remotes))}
 			for _, remote := range root.remotes.Remotes {
-				if verbose {
-					out.Print(out.C("remote '%s'\n", out.BO, out.CY).With(remote.Name))
-					out.Println(out.FormatRemoteDetails(*remote))
-				} else {
-					out.Println(remote.Name)
-				}
+				doc.Remotes = append(doc.Remotes, out.NewRemoteDocument(*remote))
 			}
+			out.Render(doc, func() {
+				for _, remote := range root.remotes.Remotes {
+					if verbose {
+						out.Print(out.C("remote '%s'\n", out.BO, out.CY).With(remote.Name))
+						out.Println(out.FormatRemoteDetails(*remote))
+					} else {
+						out.Println(remote.Name)
+					}
+				}
+			})
 		},
 	}
 	list.Flags().BoolP(flagVerbose, "v", false, "enable verbose out")
@@ -361,12 +381,17 @@ func (root *RemoteCmd) attachShowCmd() {
 		Args:    cobra.ExactArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
 			remote, found := root.remotes.GetRemote(args[0])
-			if found {
-				out.Print(out.C("remote '%s'\n", out.BO, out.CY).With(remote.Name))
-				out.Println(out.FormatRemoteDetails(*remote))
-			} else {
+			if !found {
+				if out.Structured() {
+					out.Fatal("no remote '" + args[0] + "' currently configured")
+				}
 				out.Println("no remote '" + args[0] + "' currently configured")
+				return
 			}
+			out.Render(out.NewRemoteDocument(*remote), func() {
+				out.Print(out.C("remote '%s'\n", out.BO, out.CY).With(remote.Name))
+				out.Println(out.FormatRemoteDetails(*remote))
+			})
 		},
 	}
 	root.AddCommand(show)
@@ -491,6 +516,95 @@ remotes configuration.`,
 	root.AddCommand(importCmd)
 }
 
+func (root *RemoteCmd) attachSetHostKeyCmd() {
+	const flagFile = "file"
+	var setHostKey = &cobra.Command{
+		Use:   "set-hostkey [remote] [key]",
+		Short: "Set the SSH host key of a remote",
+		Long: `Sets the SSH host key that is expected when connecting to the given remote.
+The key can be provided directly in authorized_keys format (for example, the
+contents of '/etc/ssh/ssh_host_ed25519_key.pub' on your remote), or read from a
+file with '--file'. Output from 'ssh-keyscan' is also accepted.
+
+Use this when your remote's host key has been legitimately rotated.`,
+		Example: "inertia remote set-hostkey staging --file ssh_host_ed25519_key.pub",
+		Args:    cobra.RangeArgs(1, 2),
+		Run: func(cmd *cobra.Command, args []string) {
+			remote, found := root.remotes.GetRemote(args[0])
+			if !found {
+				out.Fatalf("remote '%s' does not exist", args[0])
+			}
+			var raw []byte
+			if path, _ := cmd.Flags().GetString(flagFile); path != "" {
+				var err error
+				if raw, err = ioutil.ReadFile(path); err != nil {
+					out.Fatal(err)
+				}
+			} else if len(args) == 2 {
+				raw = []byte(args[1])
+			} else {
+				out.Fatal("a host key or '--file' must be provided")
+			}
+			key, err := parseHostKey(raw)
+			if err != nil {
+				out.Fatalf("invalid host key: %s", err.Error())
+			}
+
+			if remote.SSH == nil {
+				remote.SSH = &cfg.SSH{}
+			}
+			remote.SSH.HostKey = strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key)))
+			if err := local.SaveRemote(remote); err != nil {
+				out.Fatal(err)
+			}
+			out.Printf("host key for remote '%s' set to %s %s\n",
+				remote.Name, key.Type(), ssh.FingerprintSHA256(key))
+		},
+	}
+	setHostKey.Flags().String(flagFile, "", "file to read host key from")
+	root.AddCommand(setHostKey)
+}
+
+func (root *RemoteCmd) attachResetHostKeyCmd() {
+	var resetHostKey = &cobra.Command{
+		Use:   "reset-hostkey [remote]",
+		Short: "Forget the SSH host key of a remote",
+		Long: `Removes the recorded SSH host key of the given remote. The host key presented
+on the next connection to the remote will be trusted and recorded.
+
+Only do this if you are sure your remote's host key has been legitimately changed,
+for example if the remote was reinstalled.`,
+		Example: "inertia remote reset-hostkey staging",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			remote, found := root.remotes.GetRemote(args[0])
+			if !found {
+				out.Fatalf("remote '%s' does not exist", args[0])
+			}
+			if remote.SSH == nil || remote.SSH.HostKey == "" {
+				out.Printf("no host key recorded for remote '%s'\n", remote.Name)
+				return
+			}
+			remote.SSH.HostKey = ""
+			if err := local.SaveRemote(remote); err != nil {
+				out.Fatal(err)
+			}
+			out.Printf("host key for remote '%s' removed - the next host key presented will be trusted\n",
+				remote.Name)
+		},
+	}
+	root.AddCommand(resetHostKey)
+}
+
+// parseHostKey reads a public key in either authorized_keys or known_hosts format
+func parseHostKey(raw []byte) (ssh.PublicKey, error) {
+	if key, _, _, _, err := ssh.ParseAuthorizedKey(raw); err == nil {
+		return key, nil
+	}
+	_, _, key, _, _, err := ssh.ParseKnownHosts(raw)
+	return key, err
+}
+
 func (root *RemoteCmd) attachResetCmd() {
 	var resetCmd = &cobra.Command{
 		Use:   "reset",
@@ -0,0 +1,300 @@
+package remotescmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/cmd/core/utils/input"
+	"github.com/ubclaunchpad/inertia/cmd/core/utils/out"
+)
+
+// BackupCmd is the parent class for the 'backup' subcommands
+type BackupCmd struct {
+	*cobra.Command
+	host *HostCmd
+}
+
+// AttachBackupCmd attaches the 'backup' subcommands to the given host
+func AttachBackupCmd(host *HostCmd) {
+	var backup = &BackupCmd{
+		Command: &cobra.Command{
+			Use:   "backup",
+			Short: "Manage backups of your project's persistent data",
+			Long: `Manages backups of your project's persistent data, which is available to
+project containers at '/persist'.
+
+Backups are compressed, optionally encrypted, and stored either on your remote or
+in an S3-compatible bucket. Use 'inertia [remote] backup configure' to set where
+backups are stored, how often they are made, and how many are kept.`,
+		},
+		host: host,
+	}
+
+	// attach children
+	backup.attachConfigureCmd()
+	backup.attachSettingsCmd()
+	backup.attachCreateCmd()
+	backup.attachListCmd()
+	backup.attachRestoreCmd()
+
+	// attach to parent
+	host.AddCommand(backup.Command)
+}
+
+// Context returns the root host command's context
+func (root *BackupCmd) Context() context.Context { return root.host.ctx }
+
+func (root *BackupCmd) attachConfigureCmd() {
+	const (
+		flagStorage      = "storage"
+		flagS3Endpoint   = "s3.endpoint"
+		flagS3Region     = "s3.region"
+		flagS3Bucket     = "s3.bucket"
+		flagS3Prefix     = "s3.prefix"
+		flagS3PathStyle  = "s3.path-style"
+		flagEncrypt      = "encrypt"
+		flagSchedule     = "schedule"
+		flagKeep         = "keep"
+		flagMaxAge       = "max-age"
+		envS3AccessKeyID = "AWS_ACCESS_KEY_ID"
+		envS3SecretKey   = "AWS_SECRET_ACCESS_KEY"
+	)
+	var configure = &cobra.Command{
+		Use:   "configure",
+		Short: "Configure backups of persistent data",
+		Long: `Configures how backups of your project's persistent data are made. This
+replaces any existing configuration.
+
+Backups are stored on your remote by default. To store backups in an S3-compatible
+bucket, use '--storage s3' and provide credentials through the AWS_ACCESS_KEY_ID
+and AWS_SECRET_ACCESS_KEY environment variables. Services such as MinIO generally
+require '--s3.path-style'.
+
+If '--encrypt' is set, you will be prompted for a passphrase used to encrypt
+backups - the passphrase is required to restore them, so keep it somewhere safe.`,
+		Example: `inertia production backup configure --schedule 24h --keep 7
+inertia production backup configure --storage s3 --s3.bucket my-backups --encrypt`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			var (
+				storage, _  = cmd.Flags().GetString(flagStorage)
+				encrypt, _  = cmd.Flags().GetBool(flagEncrypt)
+				schedule, _ = cmd.Flags().GetString(flagSchedule)
+				keep, _     = cmd.Flags().GetInt(flagKeep)
+				maxAge, _   = cmd.Flags().GetString(flagMaxAge)
+			)
+			var conf = api.BackupConfiguration{
+				Storage:  storage,
+				Schedule: schedule,
+				Keep:     keep,
+				MaxAge:   maxAge,
+			}
+			if storage == api.BackupStorageS3 {
+				var (
+					endpoint, _  = cmd.Flags().GetString(flagS3Endpoint)
+					region, _    = cmd.Flags().GetString(flagS3Region)
+					bucket, _    = cmd.Flags().GetString(flagS3Bucket)
+					prefix, _    = cmd.Flags().GetString(flagS3Prefix)
+					pathStyle, _ = cmd.Flags().GetBool(flagS3PathStyle)
+				)
+				if bucket == "" {
+					out.Fatalf("'--%s' is required for s3 storage", flagS3Bucket)
+				}
+				conf.S3 = &api.S3Configuration{
+					Endpoint:        endpoint,
+					Region:          region,
+					Bucket:          bucket,
+					Prefix:          prefix,
+					AccessKeyID:     os.Getenv(envS3AccessKeyID),
+					SecretAccessKey: os.Getenv(envS3SecretKey),
+					PathStyle:       pathStyle,
+				}
+			}
+			if encrypt {
+				passphrase, err := readPassphrase(true)
+				if err != nil {
+					out.Fatal(err)
+				}
+				conf.Passphrase = passphrase
+			}
+
+			if err := root.host.client.ConfigureBackups(root.Context(), conf); err != nil {
+				out.Fatal(err)
+			}
+			out.Println("backup configuration successfully updated")
+		},
+	}
+	configure.Flags().String(flagStorage, api.BackupStorageLocal,
+		"where to store backups - one of 'local', 's3'")
+	configure.Flags().String(flagS3Endpoint, "",
+		"endpoint of an S3-compatible service (default AWS S3)")
+	configure.Flags().String(flagS3Region, "", "region of the S3 bucket")
+	configure.Flags().String(flagS3Bucket, "", "name of the S3 bucket")
+	configure.Flags().String(flagS3Prefix, "", "prefix for backups in the S3 bucket")
+	configure.Flags().Bool(flagS3PathStyle, false, "use path-style S3 addressing")
+	configure.Flags().Bool(flagEncrypt, false, "encrypt backups with a passphrase")
+	configure.Flags().String(flagSchedule, "",
+		"interval between automatic backups, such as '24h' (default none)")
+	configure.Flags().Int(flagKeep, 0,
+		"number of most recent backups to keep (default all)")
+	configure.Flags().String(flagMaxAge, "",
+		"age after which backups are removed, such as '720h' (default none)")
+	root.AddCommand(configure)
+}
+
+func (root *BackupCmd) attachSettingsCmd() {
+	var settings = &cobra.Command{
+		Use:   "settings",
+		Short: "Show the backup configuration",
+		Long:  `Shows how backups of persistent data are configured. Credentials are not shown.`,
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			conf, err := root.host.client.GetBackupConfiguration(root.Context())
+			if err != nil {
+				out.Fatal(err)
+			}
+			out.Print(formatBackupConfiguration(*conf))
+		},
+	}
+	root.AddCommand(settings)
+}
+
+func (root *BackupCmd) attachCreateCmd() {
+	var create = &cobra.Command{
+		Use:   "create",
+		Short: "Back up persistent data now",
+		Long: `Creates a backup of your project's persistent data. Backups beyond the
+configured retention policy are removed afterwards.`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			backup, err := root.host.client.CreateBackup(root.Context())
+			if err != nil {
+				out.Fatal(err)
+			}
+			out.Printf("backup %q successfully created (%s)\n",
+				backup.Name, formatSize(backup.Size))
+		},
+	}
+	root.AddCommand(create)
+}
+
+func (root *BackupCmd) attachListCmd() {
+	var list = &cobra.Command{
+		Use:   "ls",
+		Short: "List backups of persistent data",
+		Long:  `Lists backups of your project's persistent data, newest first.`,
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			backups, err := root.host.client.ListBackups(root.Context())
+			if err != nil {
+				out.Fatal(err)
+			}
+			if len(backups) == 0 {
+				out.Println("no backups found")
+				return
+			}
+			out.Print(formatBackups(backups))
+		},
+	}
+	root.AddCommand(list)
+}
+
+func (root *BackupCmd) attachRestoreCmd() {
+	const flagYes = "yes"
+	var restore = &cobra.Command{
+		Use:   "restore [name]",
+		Short: "Restore persistent data from a backup",
+		Long: `Shuts down your project and replaces its persistent data with the contents
+of the given backup. If the backup can't be retrieved or decrypted, your project
+is left running. Run 'inertia [remote] up' afterwards to bring your project back
+online. Use 'inertia [remote] backup ls' to see available backups.`,
+		Example: "inertia production backup restore persist-20201019T120000.000Z.tar.gz",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			var yes, _ = cmd.Flags().GetBool(flagYes)
+			if !yes {
+				ok, err := input.NewPrompt(nil).
+					Prompt(out.C(":warning: This will shut down your project and replace its persistent data. Continue? (y/N)", out.CY)).
+					GetBool()
+				if err != nil || !ok {
+					out.Fatal("aborting")
+				}
+			}
+
+			if err := root.host.client.RestoreBackup(root.Context(), args[0]); err != nil {
+				out.Fatal(err)
+			}
+			out.Printf("backup %q successfully restored - run 'inertia %s up' to bring your project back online\n",
+				args[0], root.host.getRemote().Name)
+		},
+	}
+	restore.Flags().BoolP(flagYes, "y", false, "skip confirmation")
+	root.AddCommand(restore)
+}
+
+// formatBackups renders the given backups as a table
+func formatBackups(backups []api.Backup) string {
+	var (
+		buf = bytes.NewBuffer(nil)
+		tw  = tabwriter.NewWriter(buf, 0, 0, 2, ' ', 0)
+	)
+	fmt.Fprintln(tw, "NAME\tSIZE\tCREATED\tENCRYPTED")
+	for _, b := range backups {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%v\n",
+			b.Name, formatSize(b.Size), b.CreatedAt.Local().Format(time.RFC822), b.Encrypted)
+	}
+	tw.Flush()
+	return buf.String()
+}
+
+// formatBackupConfiguration renders the given backup configuration
+func formatBackupConfiguration(conf api.BackupConfiguration) string {
+	var b strings.Builder
+	var orDefault = func(v, def string) string {
+		if v == "" {
+			return def
+		}
+		return v
+	}
+	fmt.Fprintf(&b, "storage:    %s\n", orDefault(conf.Storage, api.BackupStorageLocal))
+	if conf.S3 != nil {
+		fmt.Fprintf(&b, "bucket:     %s\n", conf.S3.Bucket)
+		if conf.S3.Prefix != "" {
+			fmt.Fprintf(&b, "prefix:     %s\n", conf.S3.Prefix)
+		}
+		if conf.S3.Endpoint != "" {
+			fmt.Fprintf(&b, "endpoint:   %s\n", conf.S3.Endpoint)
+		}
+	}
+	fmt.Fprintf(&b, "encrypted:  %v\n", conf.Passphrase != "")
+	fmt.Fprintf(&b, "schedule:   %s\n", orDefault(conf.Schedule, "none"))
+	if conf.Keep > 0 {
+		fmt.Fprintf(&b, "keep:       %d\n", conf.Keep)
+	} else {
+		fmt.Fprintln(&b, "keep:       all")
+	}
+	fmt.Fprintf(&b, "max age:    %s\n", orDefault(conf.MaxAge, "none"))
+	return b.String()
+}
+
+// formatSize renders the given number of bytes in human-readable units
+func formatSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%dB", size)
+	}
+	var div, exp = int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,49 @@
+package remotescmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ubclaunchpad/inertia/api"
+)
+
+func Test_formatSize(t *testing.T) {
+	assert.Equal(t, "512B", formatSize(512))
+	assert.Equal(t, "1.5KiB", formatSize(1536))
+	assert.Equal(t, "2.0MiB", formatSize(2*1024*1024))
+}
+
+func Test_formatBackups(t *testing.T) {
+	var table = formatBackups([]api.Backup{
+		{Name: "persist-20201019T120000Z.tar.gz.enc", Size: 2048, CreatedAt: time.Now(), Encrypted: true},
+		{Name: "persist-20201018T120000Z.tar.gz", Size: 100, CreatedAt: time.Now()},
+	})
+	var lines = strings.Split(strings.TrimSpace(table), "\n")
+	assert.Len(t, lines, 3)
+	assert.Contains(t, lines[0], "NAME")
+	assert.Contains(t, lines[1], "2.0KiB")
+	assert.Contains(t, lines[1], "true")
+	assert.Contains(t, lines[2], "100B")
+}
+
+func Test_formatBackupConfiguration(t *testing.T) {
+	var details = formatBackupConfiguration(api.BackupConfiguration{})
+	assert.Contains(t, details, "storage:    local")
+	assert.Contains(t, details, "keep:       all")
+	assert.Contains(t, details, "encrypted:  false")
+
+	details = formatBackupConfiguration(api.BackupConfiguration{
+		Storage:    api.BackupStorageS3,
+		S3:         &api.S3Configuration{Bucket: "backups"},
+		Passphrase: "<redacted>",
+		Schedule:   "24h",
+		Keep:       7,
+	})
+	assert.Contains(t, details, "bucket:     backups")
+	assert.Contains(t, details, "encrypted:  true")
+	assert.Contains(t, details, "schedule:   24h")
+	assert.Contains(t, details, "keep:       7")
+	assert.NotContains(t, details, "redacted")
+}
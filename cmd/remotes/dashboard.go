@@ -0,0 +1,475 @@
+package remotescmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	ui "github.com/gizak/termui/v3"
+	"github.com/gizak/termui/v3/widgets"
+	"github.com/spf13/cobra"
+
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/client"
+	"github.com/ubclaunchpad/inertia/cmd/core/utils/out"
+)
+
+const (
+	// daemonContainer is the name of the Inertia daemon's container, whose
+	// logs are shown when the dashboard starts
+	daemonContainer = "/inertia-daemon"
+
+	// dashboardLogLines is the number of log lines the dashboard keeps
+	dashboardLogLines = 1000
+)
+
+func (root *HostCmd) attachDashboardCmd() {
+	const (
+		flagInterval = "interval"
+	)
+	var dash = &cobra.Command{
+		Use:   "dashboard",
+		Short: "Monitor and manage your remote from an interactive terminal dashboard",
+		Long: `Opens a full-screen dashboard that shows the status of your deployment, the
+resource usage of each container, and the logs of a selected container, all
+updated live.
+
+Key bindings:
+
+	up/down, k/j        select a container
+	enter               show logs of the selected container
+	pgup/pgdn, home     scroll logs
+	end                 scroll to and follow the latest logs
+	u                   deploy your project, as 'inertia [remote] up' does
+	r                   rebuild and restart the current deployment
+	d                   bring your project offline
+	p                   prune Docker assets and images
+	q, ctrl-c           exit the dashboard
+
+Actions that change your deployment ask for confirmation before they are run.`,
+		Example: `inertia production dashboard
+inertia production dashboard --interval 5`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			if out.Structured() {
+				out.FatalCode(out.ExitUsage, "the dashboard can only be shown with text output")
+			}
+			var interval, _ = cmd.Flags().GetInt(flagInterval)
+			if err := newDashboard(root, time.Duration(interval)*time.Second).
+				Run(root.ctx); err != nil {
+				out.Fatal(err)
+			}
+		},
+	}
+	dash.Flags().Int(flagInterval, 2, "seconds between updates of status and stats")
+	root.AddCommand(dash)
+}
+
+// dashboardAction is an operation that can be triggered from the dashboard
+type dashboardAction struct {
+	key     string
+	name    string
+	confirm string
+	run     func(context.Context) error
+}
+
+// dashboardSnapshot is a poll of the remote's status and stats
+type dashboardSnapshot struct {
+	status *api.DeploymentStatusWithVersions
+	stats  *api.Stats
+	err    error
+}
+
+// dashboardLog is a log entry from the container logs are being shown for
+type dashboardLog struct {
+	container string
+	entry     api.LogEntry
+	err       error
+}
+
+// dashboardResult is the outcome of a dashboardAction
+type dashboardResult struct {
+	action *dashboardAction
+	err    error
+}
+
+// dashboard is a terminal UI for monitoring a remote. All state is owned by
+// the goroutine running Run - background work reports back over channels.
+type dashboard struct {
+	host     *HostCmd
+	interval time.Duration
+	actions  []*dashboardAction
+
+	snapshot  dashboardSnapshot
+	selected  int
+	following string
+	logs      *logBuffer
+	follow    bool
+	pending   *dashboardAction
+	running   *dashboardAction
+	message   string
+
+	header     *widgets.Paragraph
+	containers *widgets.List
+	logPane    *widgets.List
+	footer     *widgets.Paragraph
+}
+
+func newDashboard(host *HostCmd, interval time.Duration) *dashboard {
+	var d = &dashboard{
+		host:      host,
+		interval:  interval,
+		following: daemonContainer,
+		logs:      newLogBuffer(dashboardLogLines),
+		follow:    true,
+	}
+	d.header = widgets.NewParagraph()
+	d.header.Title = " " + host.getRemote().Name + " "
+
+	d.containers = widgets.NewList()
+	d.containers.Title = " Containers "
+	d.containers.SelectedRowStyle = ui.NewStyle(ui.ColorBlack, ui.ColorCyan)
+
+	d.logPane = widgets.NewList()
+	d.logPane.SelectedRowStyle = d.logPane.TextStyle
+
+	d.footer = widgets.NewParagraph()
+
+	d.actions = []*dashboardAction{
+		{key: "u", name: "deploy", confirm: "deploy your project", run: func(ctx context.Context) error {
+			req, err := host.upRequest()
+			if err != nil {
+				return err
+			}
+			return host.client.Up(ctx, req)
+		}},
+		{key: "r", name: "restart", confirm: "rebuild and restart your project", run: host.client.Redeploy},
+		{key: "d", name: "down", confirm: "bring your project offline", run: host.client.Down},
+		{key: "p", name: "prune", confirm: "prune Docker assets and images", run: host.client.Prune},
+	}
+	return d
+}
+
+// Run shows the dashboard until the user exits or ctx is cancelled
+func (d *dashboard) Run(ctx context.Context) error {
+	if err := ui.Init(); err != nil {
+		return fmt.Errorf("failed to start dashboard: %s", err.Error())
+	}
+	defer ui.Close()
+	d.resize(ui.TerminalDimensions())
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		snapshots = make(chan dashboardSnapshot)
+		logs      = make(chan dashboardLog)
+		results   = make(chan dashboardResult, 1)
+		events    = ui.PollEvents()
+	)
+	go d.poll(ctx, snapshots)
+	var stopLogs = d.streamLogs(ctx, d.following, logs)
+	defer func() { stopLogs() }()
+
+	d.render()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case s := <-snapshots:
+			d.snapshot = s
+			if names := d.containerNames(); d.selected >= len(names) {
+				d.selected = len(names) - 1
+			}
+		case l := <-logs:
+			if l.container != d.following {
+				continue // from a stream that has been replaced
+			}
+			if l.err != nil {
+				d.logs.Add(fmt.Sprintf("[log stream closed: %s](fg:red)", escapeStyles(l.err.Error())))
+			} else {
+				d.logs.Add(escapeStyles(strings.TrimRight(l.entry.Message, "\r\n")))
+			}
+		case r := <-results:
+			d.running = nil
+			if r.err != nil {
+				d.message = fmt.Sprintf("[%s failed: %s](fg:red)", r.action.name, escapeStyles(r.err.Error()))
+			} else {
+				d.message = fmt.Sprintf("[%s succeeded](fg:green)", r.action.name)
+			}
+		case e := <-events:
+			if e.ID == "q" || e.ID == "<C-c>" {
+				return nil
+			}
+			if e.ID == "<Resize>" {
+				var payload = e.Payload.(ui.Resize)
+				d.resize(payload.Width, payload.Height)
+				ui.Clear()
+				break
+			}
+			var following = d.following
+			if action := d.handleKey(e.ID); action != nil {
+				d.running = action
+				d.message = fmt.Sprintf("running %s...", action.name)
+				go func() {
+					results <- dashboardResult{action: action, err: action.run(ctx)}
+				}()
+			}
+			if d.following != following {
+				stopLogs()
+				d.logs.Reset()
+				stopLogs = d.streamLogs(ctx, d.following, logs)
+			}
+		}
+		d.render()
+	}
+}
+
+// handleKey updates the dashboard's state in response to the given key, and
+// returns an action if one has been confirmed
+func (d *dashboard) handleKey(key string) *dashboardAction {
+	if d.pending != nil {
+		var action = d.pending
+		d.pending = nil
+		d.message = ""
+		if key == "y" {
+			return action
+		}
+		return nil
+	}
+
+	switch key {
+	case "<Up>", "k":
+		if d.selected > 0 {
+			d.selected--
+		}
+	case "<Down>", "j":
+		if d.selected < len(d.containerNames())-1 {
+			d.selected++
+		}
+	case "<Enter>":
+		d.following = d.containerNames()[d.selected]
+		d.follow = true
+	case "<PageUp>", "<MouseWheelUp>":
+		d.follow = false
+		d.logPane.ScrollPageUp()
+	case "<PageDown>", "<MouseWheelDown>":
+		d.logPane.ScrollPageDown()
+	case "<Home>":
+		d.follow = false
+		d.logPane.ScrollTop()
+	case "<End>":
+		d.follow = true
+	default:
+		for _, action := range d.actions {
+			if action.key != key {
+				continue
+			}
+			if d.running != nil {
+				d.message = fmt.Sprintf("[%s is still running](fg:yellow)", d.running.name)
+				return nil
+			}
+			d.pending = action
+			d.message = fmt.Sprintf("[%s? press 'y' to confirm, or any other key to cancel](fg:yellow)",
+				action.confirm)
+		}
+	}
+	return nil
+}
+
+// poll retrieves the remote's status and stats until ctx is cancelled
+func (d *dashboard) poll(ctx context.Context, snapshots chan<- dashboardSnapshot) {
+	var ticker = time.NewTicker(d.interval)
+	defer ticker.Stop()
+	for {
+		var s dashboardSnapshot
+		if s.status, s.err = d.host.client.Status(ctx); s.err == nil {
+			s.stats, s.err = d.host.client.Stats(ctx)
+		}
+		select {
+		case snapshots <- s:
+		case <-ctx.Done():
+			return
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// streamLogs streams logs of the given container in the background, and
+// returns a function that stops the stream
+func (d *dashboard) streamLogs(ctx context.Context, container string, logs chan<- dashboardLog) func() {
+	ctx, cancel := context.WithCancel(ctx)
+	var send = func(l dashboardLog) {
+		select {
+		case logs <- l:
+		case <-ctx.Done():
+		}
+	}
+	go func() {
+		var err = d.host.client.StreamLogs(ctx, client.LogsRequest{
+			Containers: []string{container},
+			Entries:    dashboardLogLines,
+		}, func(e api.LogEntry) {
+			send(dashboardLog{container: container, entry: e})
+		})
+		if err != nil {
+			send(dashboardLog{container: container, err: err})
+		}
+	}()
+	return cancel
+}
+
+// resize arranges the widgets to fill a terminal of the given size, with the
+// status and containers above the logs, and key bindings at the bottom
+func (d *dashboard) resize(width, height int) {
+	const (
+		topHeight    = 9 // fits every line of formatDashboardStatus
+		footerHeight = 3
+	)
+	d.header.SetRect(0, 0, width*2/5, topHeight)
+	d.containers.SetRect(width*2/5, 0, width, topHeight)
+	d.logPane.SetRect(0, topHeight, width, height-footerHeight)
+	d.footer.SetRect(0, height-footerHeight, width, height)
+}
+
+// render updates the widgets with the dashboard's state and draws them
+func (d *dashboard) render() {
+	d.header.Text = formatDashboardStatus(d.snapshot)
+
+	var names = d.containerNames()
+	d.containers.Rows = formatDashboardContainers(names, d.snapshot.stats)
+	d.containers.SelectedRow = d.selected
+
+	d.logPane.Rows = d.logs.Lines()
+	if len(d.logPane.Rows) == 0 {
+		// lists cannot be scrolled when empty
+		d.logPane.SelectedRow = 0
+	} else if d.follow || d.logPane.SelectedRow >= len(d.logPane.Rows) {
+		d.logPane.ScrollBottom()
+	}
+	d.logPane.Title = " Logs: " + strings.TrimPrefix(d.following, "/") + " "
+	if !d.follow {
+		d.logPane.Title += "(press end to follow) "
+	}
+
+	d.footer.Text = d.message
+	if d.footer.Text == "" {
+		d.footer.Text = "[u](fg:cyan) deploy  [r](fg:cyan) restart  [d](fg:cyan) down  " +
+			"[p](fg:cyan) prune  [enter](fg:cyan) show logs  [q](fg:cyan) quit"
+	}
+
+	ui.Render(d.header, d.containers, d.logPane, d.footer)
+}
+
+// containerNames lists the containers that can be selected - the daemon,
+// followed by the project's active containers
+func (d *dashboard) containerNames() []string {
+	var names = []string{daemonContainer}
+	if d.snapshot.status != nil {
+		for _, c := range d.snapshot.status.Containers {
+			if c != daemonContainer {
+				names = append(names, c)
+			}
+		}
+	}
+	return names
+}
+
+// formatDashboardStatus renders a summary of the deployment's status
+func formatDashboardStatus(s dashboardSnapshot) string {
+	if s.err != nil {
+		return fmt.Sprintf("[unable to reach remote: %s](fg:red)", escapeStyles(s.err.Error()))
+	}
+	if s.status == nil {
+		return "loading..."
+	}
+	var status = s.status
+	if status.Branch == "" && status.CommitHash == "" {
+		return fmt.Sprintf("No deployment found\nDaemon:  %s", status.InertiaVersion)
+	}
+	var commit = status.CommitHash
+	if len(commit) > 7 {
+		commit = commit[:7]
+	}
+	var state = "[running](fg:green)"
+	if status.BuildContainerActive && len(status.Containers) == 0 {
+		state = "[building](fg:yellow)"
+	} else if len(status.Containers) == 0 {
+		state = "[offline](fg:red)"
+	}
+	var text = fmt.Sprintf("State:   %s\nBranch:  %s\nCommit:  %s %s\nBuild:   %s\nDaemon:  %s",
+		state, status.Branch, commit, escapeStyles(status.CommitMessage),
+		status.BuildType, status.InertiaVersion)
+	if s.stats != nil && s.stats.Host.MemoryTotal > 0 {
+		var memUsed = s.stats.Host.MemoryTotal - s.stats.Host.MemoryAvailable
+		text += fmt.Sprintf("\nMemory:  %.1f%% of %s",
+			percent(memUsed, s.stats.Host.MemoryTotal), formatSize(int64(s.stats.Host.MemoryTotal)))
+	}
+	if s.stats != nil && s.stats.Host.DiskTotal > 0 {
+		var diskUsed = s.stats.Host.DiskTotal - s.stats.Host.DiskFree
+		text += fmt.Sprintf("\nDisk:    %.1f%% of %s",
+			percent(diskUsed, s.stats.Host.DiskTotal), formatSize(int64(s.stats.Host.DiskTotal)))
+	}
+	return text
+}
+
+// formatDashboardContainers renders a row for each of the given containers,
+// with resource usage if stats for the container are available
+func formatDashboardContainers(names []string, stats *api.Stats) []string {
+	var usage = make(map[string]api.ContainerStats)
+	if stats != nil {
+		for _, c := range stats.Containers {
+			usage[strings.TrimPrefix(c.Name, "/")] = c
+		}
+	}
+
+	var width = 0
+	for _, name := range names {
+		if n := len(strings.TrimPrefix(name, "/")); n > width {
+			width = n
+		}
+	}
+	var rows = make([]string, len(names))
+	for i, name := range names {
+		name = strings.TrimPrefix(name, "/")
+		rows[i] = fmt.Sprintf("%-*s", width, name)
+		if c, ok := usage[name]; ok {
+			rows[i] += fmt.Sprintf("  cpu %6.2f%%  mem %9s (%5.1f%%)  pids %d",
+				c.CPUPercent, formatSize(int64(c.MemoryUsage)),
+				percent(c.MemoryUsage, c.MemoryLimit), c.PIDs)
+		}
+	}
+	return rows
+}
+
+// escapeStyles prevents text from being parsed as termui style markup, which
+// takes the form "[text](style)"
+func escapeStyles(s string) string {
+	return strings.Replace(s, "](", "] (", -1)
+}
+
+// logBuffer holds the most recent lines of a log
+type logBuffer struct {
+	lines []string
+	max   int
+}
+
+func newLogBuffer(max int) *logBuffer { return &logBuffer{max: max} }
+
+// Add appends a line, discarding the oldest line if the buffer is full
+func (b *logBuffer) Add(line string) {
+	if len(b.lines) >= b.max {
+		b.lines = append(b.lines[:0], b.lines[len(b.lines)-b.max+1:]...)
+	}
+	b.lines = append(b.lines, line)
+}
+
+// Lines returns the buffered lines, oldest first
+func (b *logBuffer) Lines() []string { return b.lines }
+
+// Reset discards all buffered lines
+func (b *logBuffer) Reset() { b.lines = nil }
@@ -0,0 +1,122 @@
+package remotescmd
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/cfg"
+	"github.com/ubclaunchpad/inertia/client"
+)
+
+func newTestDashboard(t *testing.T) *dashboard {
+	c, err := client.NewClient(&cfg.Remote{Name: "production", IP: "127.0.0.1"}, client.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return newDashboard(&HostCmd{client: c}, time.Second)
+}
+
+func Test_dashboard_handleKey(t *testing.T) {
+	var d = newTestDashboard(t)
+	d.snapshot.status = &api.DeploymentStatusWithVersions{
+		DeploymentStatus: api.DeploymentStatus{Containers: []string{"/web", "/db"}},
+	}
+	assert.Equal(t, []string{"/inertia-daemon", "/web", "/db"}, d.containerNames())
+
+	// selection stays within the container list
+	d.handleKey("<Up>")
+	assert.Equal(t, 0, d.selected)
+	for i := 0; i < 5; i++ {
+		d.handleKey("j")
+	}
+	assert.Equal(t, 2, d.selected)
+	d.handleKey("k")
+	assert.Equal(t, 1, d.selected)
+
+	// enter switches logs to the selected container
+	d.follow = false
+	assert.Nil(t, d.handleKey("<Enter>"))
+	assert.Equal(t, "/web", d.following)
+	assert.True(t, d.follow)
+
+	// actions require confirmation
+	assert.Nil(t, d.handleKey("d"))
+	assert.NotNil(t, d.pending)
+	assert.Contains(t, d.message, "bring your project offline")
+	assert.Nil(t, d.handleKey("n"))
+	assert.Nil(t, d.pending)
+	assert.Empty(t, d.message)
+
+	assert.Nil(t, d.handleKey("p"))
+	var action = d.handleKey("y")
+	if assert.NotNil(t, action) {
+		assert.Equal(t, "prune", action.name)
+	}
+
+	// only one action runs at a time
+	d.running = action
+	assert.Nil(t, d.handleKey("r"))
+	assert.Nil(t, d.pending)
+	assert.Contains(t, d.message, "prune is still running")
+}
+
+func Test_formatDashboardStatus(t *testing.T) {
+	assert.Equal(t, "loading...", formatDashboardStatus(dashboardSnapshot{}))
+	assert.Contains(t, formatDashboardStatus(dashboardSnapshot{err: errors.New("[oh](no)")}),
+		"unable to reach remote: [oh] (no)")
+
+	var s = dashboardSnapshot{status: &api.DeploymentStatusWithVersions{
+		InertiaVersion: "v0.6.0",
+		DeploymentStatus: api.DeploymentStatus{
+			Branch:        "master",
+			CommitHash:    "1234567890",
+			CommitMessage: "fix things",
+			BuildType:     "docker-compose",
+			Containers:    []string{"/web"},
+		},
+	}, stats: &api.Stats{Host: api.HostStats{MemoryTotal: 4096, MemoryAvailable: 1024}}}
+	assert.Equal(t, `State:   [running](fg:green)
+Branch:  master
+Commit:  1234567 fix things
+Build:   docker-compose
+Daemon:  v0.6.0
+Memory:  75.0% of 4.0KiB`, formatDashboardStatus(s))
+
+	s.status.Containers = nil
+	assert.Contains(t, formatDashboardStatus(s), "[offline](fg:red)")
+	s.status.BuildContainerActive = true
+	assert.Contains(t, formatDashboardStatus(s), "[building](fg:yellow)")
+
+	s.status.DeploymentStatus = api.DeploymentStatus{}
+	assert.Contains(t, formatDashboardStatus(s), "No deployment found")
+}
+
+func Test_formatDashboardContainers(t *testing.T) {
+	var rows = formatDashboardContainers([]string{"/inertia-daemon", "/web"}, &api.Stats{
+		Containers: []api.ContainerStats{{
+			Name:        "/web",
+			CPUPercent:  12.5,
+			MemoryUsage: 256 * 1024 * 1024,
+			MemoryLimit: 1024 * 1024 * 1024,
+			PIDs:        7,
+		}},
+	})
+	assert.Equal(t, []string{
+		"inertia-daemon",
+		"web             cpu  12.50%  mem  256.0MiB ( 25.0%)  pids 7",
+	}, rows)
+}
+
+func Test_logBuffer(t *testing.T) {
+	var b = newLogBuffer(3)
+	for _, l := range []string{"a", "b", "c", "d"} {
+		b.Add(l)
+	}
+	assert.Equal(t, []string{"b", "c", "d"}, b.Lines())
+	b.Reset()
+	assert.Empty(t, b.Lines())
+}
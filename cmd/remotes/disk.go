@@ -0,0 +1,105 @@
+package remotescmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/cmd/core/utils/out"
+)
+
+// DiskCmd is the parent class for the 'disk' subcommands
+type DiskCmd struct {
+	*cobra.Command
+	host *HostCmd
+}
+
+// AttachDiskCmd attaches the 'disk' subcommands to the given host
+func AttachDiskCmd(host *HostCmd) {
+	var disk = &DiskCmd{
+		Command: &cobra.Command{
+			Use:   "disk",
+			Short: "Configure how your remote responds to low disk space",
+			Long: `Configures how your remote's daemon responds to low disk space.
+
+The daemon regularly checks free space on the filesystems holding Docker's data
+and Inertia's files. When free space drops below a threshold, it prunes unused
+Docker assets - keeping images of existing containers, so that previous
+deployments can be restored - and warns through your configured notifiers. If
+space is still low, builds are refused until space is freed.
+
+Use 'inertia [remote] stats' to see current disk usage.`,
+		},
+		host: host,
+	}
+
+	// attach children
+	disk.attachConfigureCmd()
+	disk.attachSettingsCmd()
+
+	// attach to parent
+	host.AddCommand(disk.Command)
+}
+
+// Context returns the root host command's context
+func (root *DiskCmd) Context() context.Context { return root.host.ctx }
+
+func (root *DiskCmd) attachConfigureCmd() {
+	const (
+		flagMinFree = "min-free"
+		flagDisable = "disable"
+	)
+	var configure = &cobra.Command{
+		Use:   "configure",
+		Short: "Configure the low disk space threshold",
+		Long: `Configures the amount of free space below which your remote's daemon prunes
+Docker assets, sends warnings, and refuses builds. This replaces any existing
+configuration - running it without flags restores the default threshold of 10%.
+
+The threshold can be a size, such as '2GiB', or a percentage of the filesystem,
+such as '15%'.`,
+		Example: `inertia production disk configure --min-free 2GiB
+inertia production disk configure --min-free 15%
+inertia production disk configure --disable`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			var (
+				minFree, _ = cmd.Flags().GetString(flagMinFree)
+				disable, _ = cmd.Flags().GetBool(flagDisable)
+			)
+			if err := root.host.client.ConfigureDisk(root.Context(), api.DiskConfiguration{
+				MinimumFree: minFree,
+				Disabled:    disable,
+			}); err != nil {
+				out.Fatal(err)
+			}
+			if disable {
+				out.Println("disk space monitoring disabled")
+				return
+			}
+			out.Println("disk configuration successfully updated")
+		},
+	}
+	configure.Flags().String(flagMinFree, "", "minimum free space, as a size or percentage")
+	configure.Flags().Bool(flagDisable, false, "disable disk space monitoring")
+	root.AddCommand(configure)
+}
+
+func (root *DiskCmd) attachSettingsCmd() {
+	var settings = &cobra.Command{
+		Use:   "settings",
+		Short: "Show the low disk space threshold",
+		Long:  `Shows how your remote's daemon is configured to respond to low disk space.`,
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			conf, err := root.host.client.GetDiskConfiguration(root.Context())
+			if err != nil {
+				out.Fatal(err)
+			}
+			out.Printf("monitoring:    %v\n", !conf.Disabled)
+			out.Printf("minimum free:  %s\n", conf.MinimumFree)
+		},
+	}
+	root.AddCommand(settings)
+}
@@ -2,10 +2,19 @@ package remotescmd
 
 import (
 	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"sort"
 	"strings"
+	"syscall"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh/terminal"
+
+	"github.com/ubclaunchpad/inertia/client"
 	"github.com/ubclaunchpad/inertia/cmd/core/utils/out"
+	"github.com/ubclaunchpad/inertia/common"
 )
 
 // EnvCmd is the parent class for the 'env' subcommands
@@ -27,6 +36,13 @@ as follows:
 
 - for docker-compose projects, variables are set for the docker-compose process
 - for Dockerfile projects, variables are set in the deployed container
+
+Variables can be scoped to a specific project profile using '--profile', and to a
+specific container (or docker-compose service) using '--container'. Scoped
+variables take precedence over unscoped ones with the same name.
+
+Variables can be moved between remotes using 'env export' and 'env import', which
+encrypt variables with a passphrase of your choice while they are in transit.
 `,
 		},
 		host: host,
@@ -34,8 +50,12 @@ as follows:
 
 	// attach children
 	env.attachSetCmd()
+	env.attachLoadCmd()
 	env.attachListCmd()
 	env.attachRemoveCmd()
+	env.attachExportCmd()
+	env.attachImportCmd()
+	env.attachRotateKeyCmd()
 
 	// attach to parent
 	host.AddCommand(env.Command)
@@ -50,12 +70,15 @@ func (root *EnvCmd) attachSetCmd() {
 		Use:   "set [name] [value]",
 		Short: "Set an environment variable on your remote",
 		Long: `Sets a persistent environment variable on your remote. Set environment
-variables are applied to all deployed containers.`,
-		Args: cobra.ExactArgs(2),
+variables are applied to all deployed containers unless scoped with '--profile'
+or '--container'.`,
+		Example: "inertia staging env set DB_PASSWORD hunter2 --encrypt --container db",
+		Args:    cobra.ExactArgs(2),
 		Run: func(cmd *cobra.Command, args []string) {
 			var encrypt, _ = cmd.Flags().GetBool(flagEncrypt)
-			if err := root.host.client.UpdateEnv(
+			if err := root.host.client.UpdateScopedEnv(
 				root.Context(),
+				readEnvScope(cmd),
 				args[0],
 				args[1],
 				encrypt,
@@ -67,9 +90,65 @@ variables are applied to all deployed containers.`,
 		},
 	}
 	set.Flags().BoolP(flagEncrypt, "e", false, "encrypt variable when stored")
+	attachEnvScopeFlags(set)
 	root.AddCommand(set)
 }
 
+func (root *EnvCmd) attachLoadCmd() {
+	const flagEncrypt = "encrypt"
+	var load = &cobra.Command{
+		Use:   "load [file]",
+		Short: "Set environment variables on your remote from a .env file",
+		Long: `Sets persistent environment variables on your remote from the given .env
+file. Each line of the file should be in the format 'NAME=value' - blank lines
+and lines starting with '#' are ignored.`,
+		Example: "inertia staging env load .env.staging --encrypt --profile staging",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			var encrypt, _ = cmd.Flags().GetBool(flagEncrypt)
+			f, err := os.Open(args[0])
+			if err != nil {
+				out.Fatal(err)
+			}
+			env, err := common.ParseEnvFile(f)
+			f.Close()
+			if err != nil {
+				out.Fatalf("failed to parse %q: %s", args[0], err.Error())
+			}
+
+			var names = make([]string, 0, len(env))
+			for name := range env {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			var scope = readEnvScope(cmd)
+			var count = 0
+			for _, name := range names {
+				if env[name] == "" {
+					out.Printf("skipping %q: no value provided\n", name)
+					continue
+				}
+				if err := root.host.client.UpdateScopedEnv(
+					root.Context(),
+					scope,
+					name,
+					env[name],
+					encrypt,
+					false,
+				); err != nil {
+					out.Fatalf("failed to set %q: %s", name, err.Error())
+				}
+				count++
+			}
+			out.Printf("%d env values successfully updated\n", count)
+		},
+	}
+	load.Flags().BoolP(flagEncrypt, "e", false, "encrypt variables when stored")
+	attachEnvScopeFlags(load)
+	root.AddCommand(load)
+}
+
 func (root *EnvCmd) attachRemoveCmd() {
 	var remove = &cobra.Command{
 		Use:   "rm [name]",
@@ -78,8 +157,9 @@ func (root *EnvCmd) attachRemoveCmd() {
 and persistent environment storage.`,
 		Args: cobra.ExactArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
-			if err := root.host.client.UpdateEnv(
+			if err := root.host.client.UpdateScopedEnv(
 				root.Context(),
+				readEnvScope(cmd),
 				args[0],
 				"",
 				false,
@@ -90,6 +170,7 @@ and persistent environment storage.`,
 			out.Println("env value successfully removed")
 		},
 	}
+	attachEnvScopeFlags(remove)
 	root.AddCommand(remove)
 }
 
@@ -105,12 +186,133 @@ variables are not be decrypted.`,
 				out.Fatal(err)
 			}
 
-			if len(variables) == 0 {
-				out.Println("no variables configured on remote")
-			} else {
-				out.Println(strings.Join(variables, "\n"))
-			}
+			out.Render(out.EnvDocument{Variables: variables}, func() {
+				if len(variables) == 0 {
+					out.Println("no variables configured on remote")
+				}
+				for _, v := range variables {
+					out.Println(v.String())
+				}
+			})
 		},
 	}
 	root.AddCommand(list)
 }
+
+func (root *EnvCmd) attachExportCmd() {
+	var export = &cobra.Command{
+		Use:   "export [file]",
+		Short: "Export environment variables from your remote",
+		Long: `Exports all environment variables set on your remote to the given file.
+
+Exported variables are encrypted with a passphrase you provide, and can be loaded
+onto another remote using 'inertia [remote] env import'.`,
+		Example: "inertia staging env export staging.env.enc",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			passphrase, err := readPassphrase(true)
+			if err != nil {
+				out.Fatal(err)
+			}
+			data, err := root.host.client.ExportEnv(root.Context(), passphrase)
+			if err != nil {
+				out.Fatal(err)
+			}
+			if err := ioutil.WriteFile(args[0], data, 0600); err != nil {
+				out.Fatal(err)
+			}
+			out.Printf("env values successfully exported to %q\n", args[0])
+		},
+	}
+	root.AddCommand(export)
+}
+
+func (root *EnvCmd) attachImportCmd() {
+	var imp = &cobra.Command{
+		Use:   "import [file]",
+		Short: "Import environment variables onto your remote",
+		Long: `Imports environment variables exported by 'inertia [remote] env export'
+onto your remote. Existing variables with the same name are overwritten.`,
+		Example: "inertia production env import staging.env.enc",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			data, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				out.Fatal(err)
+			}
+			passphrase, err := readPassphrase(false)
+			if err != nil {
+				out.Fatal(err)
+			}
+			count, err := root.host.client.ImportEnv(root.Context(), passphrase, data)
+			if err != nil {
+				out.Fatal(err)
+			}
+			out.Printf("%d env values successfully imported\n", count)
+		},
+	}
+	root.AddCommand(imp)
+}
+
+func (root *EnvCmd) attachRotateKeyCmd() {
+	var rotate = &cobra.Command{
+		Use:   "rotate-key",
+		Short: "Re-encrypt environment variables with a new key",
+		Long: `Generates a new key on your remote and re-encrypts all encrypted environment
+variables with it. The previous key is discarded.`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := root.host.client.RotateEnvKey(root.Context()); err != nil {
+				out.Fatal(err)
+			}
+			out.Println("env values successfully re-encrypted with a new key")
+		},
+	}
+	root.AddCommand(rotate)
+}
+
+const (
+	flagEnvProfile   = "profile"
+	flagEnvContainer = "container"
+)
+
+// attachEnvScopeFlags adds flags for scoping environment variables to cmd
+func attachEnvScopeFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagEnvProfile, "",
+		"only apply to deployments using this project profile")
+	cmd.Flags().String(flagEnvContainer, "",
+		"only apply to this container or docker-compose service")
+}
+
+// readEnvScope reads the scope set by flags attached by attachEnvScopeFlags
+func readEnvScope(cmd *cobra.Command) client.EnvScope {
+	var profile, _ = cmd.Flags().GetString(flagEnvProfile)
+	var container, _ = cmd.Flags().GetString(flagEnvContainer)
+	return client.EnvScope{Profile: profile, Container: container}
+}
+
+// readPassphrase prompts for a passphrase used to encrypt exported variables,
+// optionally asking for it twice
+func readPassphrase(confirm bool) (string, error) {
+	out.Print(out.C(":key: Enter a passphrase: ", out.CY))
+	passphrase, err := terminal.ReadPassword(int(syscall.Stdin))
+	out.Print("\n")
+	if err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(string(passphrase)) == "" {
+		return "", errors.New("invalid passphrase")
+	}
+	if confirm {
+		out.Print(out.C(":key: Confirm passphrase: ", out.CY))
+		confirmation, err := terminal.ReadPassword(int(syscall.Stdin))
+		out.Print("\n")
+		if err != nil {
+			return "", err
+		}
+		if string(confirmation) != string(passphrase) {
+			return "", errors.New("passphrases do not match")
+		}
+	}
+	return string(passphrase), nil
+}
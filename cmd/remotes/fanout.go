@@ -0,0 +1,362 @@
+package remotescmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ubclaunchpad/inertia/cfg"
+	"github.com/ubclaunchpad/inertia/client"
+	"github.com/ubclaunchpad/inertia/cmd/core"
+	"github.com/ubclaunchpad/inertia/cmd/core/utils/input"
+	"github.com/ubclaunchpad/inertia/cmd/core/utils/out"
+	"github.com/ubclaunchpad/inertia/local"
+)
+
+// FanOutCmd is the parent class for the 'all' subcommands, which execute
+// operations against multiple remotes at once
+type FanOutCmd struct {
+	*cobra.Command
+	project *cfg.Project
+	remotes []*cfg.Remote
+	ctx     context.Context
+}
+
+const (
+	// fanOutCmdName is the name of the 'all' command, which remotes cannot use
+	fanOutCmdName = "all"
+
+	flagRemotes     = "remotes"
+	flagConcurrency = "concurrency"
+	flagRolling     = "rolling"
+)
+
+// AttachFanOutCmd attaches the 'all' subcommands to the given parent
+func AttachFanOutCmd(inertia *core.Cmd, project *cfg.Project, remotes []*cfg.Remote) {
+	ctx, cancel := context.WithCancel(context.Background())
+	input.CatchSigterm(cancel)
+	var all = &FanOutCmd{
+		project: project,
+		remotes: remotes,
+		ctx:     ctx,
+	}
+	all.Command = &cobra.Command{
+		Use:   fanOutCmdName + " [command]",
+		Short: "Run a command against multiple remotes",
+		Long: `Runs a command against all configured remotes, or a subset of remotes specified
+with '--remotes'.
+
+By default, remotes are handled in parallel, up to the limit set by '--concurrency'.
+In rolling mode, remotes are handled one at a time in the order given, and no
+further remotes are handled after the first failure.
+
+Once all remotes have been handled, a summary of results is printed.`,
+		Example: "inertia all up --remotes staging-1,staging-2 --rolling",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			if all.project == nil {
+				out.Fatal("no project found in current directory - try 'inertia init'")
+			}
+		},
+	}
+	all.PersistentFlags().StringSlice(flagRemotes, nil,
+		"comma-separated remotes to target (default all remotes)")
+	all.PersistentFlags().Int(flagConcurrency, 4,
+		"maximum number of remotes to handle at once")
+	all.PersistentFlags().Bool(flagRolling, false,
+		"handle remotes one at a time, stopping at the first failure")
+
+	// attach children
+	all.attachUpCmd()
+	all.attachDownCmd()
+	all.attachStatusCmd()
+	all.attachPruneCmd()
+
+	// attach to parent
+	inertia.AddCommand(all.Command)
+}
+
+func (root *FanOutCmd) attachUpCmd() {
+	var up = &cobra.Command{
+		Use:   "up",
+		Short: "Bring project online on multiple remotes",
+		Long: `Builds and deploys your project on each targeted remote, using the profile
+applied to each remote.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			root.run(cmd, func(ctx context.Context, c *client.Client) (string, error) {
+				var profileName = c.Remote.GetProfile(root.project.Name)
+				profile, found := root.project.GetProfile(profileName)
+				if !found {
+					return "", fmt.Errorf("could not find profile '%s'", profileName)
+				}
+				if err := c.Up(ctx, client.UpRequest{
+					Project: root.project.Name,
+					URL:     root.project.URL,
+					Profile: *profile,
+				}); err != nil {
+					return "", err
+				}
+				return fmt.Sprintf("deployment started with profile '%s'", profileName), nil
+			})
+		},
+	}
+	root.AddCommand(up)
+}
+
+func (root *FanOutCmd) attachDownCmd() {
+	var down = &cobra.Command{
+		Use:   "down",
+		Short: "Bring project offline on multiple remotes",
+		Long:  `Stops your project on each targeted remote.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			root.run(cmd, func(ctx context.Context, c *client.Client) (string, error) {
+				if err := c.Down(ctx); err != nil {
+					return "", err
+				}
+				return "project shut down", nil
+			})
+		},
+	}
+	root.AddCommand(down)
+}
+
+func (root *FanOutCmd) attachStatusCmd() {
+	var status = &cobra.Command{
+		Use:   "status",
+		Short: "Print the status of the deployment on multiple remotes",
+		Long:  `Prints a summary of the status of the deployment on each targeted remote.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			root.run(cmd, func(ctx context.Context, c *client.Client) (string, error) {
+				status, err := c.Status(ctx)
+				if err != nil {
+					return "", err
+				}
+				if status.CommitHash == "" {
+					return "no deployment found", nil
+				}
+				var commit = status.CommitHash
+				if len(commit) > 7 {
+					commit = commit[:7]
+				}
+				var summary = fmt.Sprintf("%s@%s, %d containers active",
+					status.Branch, commit, len(status.Containers))
+				if status.BuildContainerActive {
+					summary += ", build in progress"
+				}
+				return summary, nil
+			})
+		},
+	}
+	root.AddCommand(status)
+}
+
+func (root *FanOutCmd) attachPruneCmd() {
+	var prune = &cobra.Command{
+		Use:   "prune",
+		Short: "Prune Docker assets and images on multiple remotes",
+		Long:  `Prunes Docker assets and images on each targeted remote.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			root.run(cmd, func(ctx context.Context, c *client.Client) (string, error) {
+				if err := c.Prune(ctx); err != nil {
+					return "", err
+				}
+				return "docker assets pruned", nil
+			})
+		},
+	}
+	root.AddCommand(prune)
+}
+
+// run executes op against each remote targeted by cmd's flags, then prints a
+// summary of results. Exits with an error if any remote failed.
+func (root *FanOutCmd) run(
+	cmd *cobra.Command,
+	op func(context.Context, *client.Client) (string, error),
+) {
+	var (
+		names, _       = cmd.Flags().GetStringSlice(flagRemotes)
+		concurrency, _ = cmd.Flags().GetInt(flagConcurrency)
+		rolling, _     = cmd.Flags().GetBool(flagRolling)
+	)
+	remotes, err := root.selectRemotes(names)
+	if err != nil {
+		out.Fatal(err)
+	}
+
+	var clients = make(map[string]*client.Client, len(remotes))
+	names = make([]string, 0, len(remotes))
+	for _, r := range remotes {
+		c, err := client.NewClient(r, client.Options{
+			SSH: local.SSHOptions(r),
+			Out: ioutil.Discard,
+		})
+		if err != nil {
+			out.Fatalf("failed to load remote %q: %s", r.Name, err.Error())
+		}
+		clients[r.Name] = c
+		names = append(names, r.Name)
+	}
+
+	if rolling {
+		out.Printf("running '%s' on %d remotes, one at a time\n", cmd.Name(), len(names))
+	} else {
+		out.Printf("running '%s' on %d remotes\n", cmd.Name(), len(names))
+	}
+	var results = fanOut(root.ctx, names, fanOutOptions{
+		Concurrency: concurrency,
+		Rolling:     rolling,
+	}, func(ctx context.Context, remote string) (string, error) {
+		return op(ctx, clients[remote])
+	})
+
+	out.Print(formatFanOutResults(results))
+	for _, r := range results {
+		if r.Status != fanOutStatusOK {
+			os.Exit(1)
+		}
+	}
+}
+
+// selectRemotes returns the configured remotes with the given names in the
+// given order, or all remotes if no names are given
+func (root *FanOutCmd) selectRemotes(names []string) ([]*cfg.Remote, error) {
+	if len(names) == 0 {
+		if len(root.remotes) == 0 {
+			return nil, errors.New("no remotes configured - try 'inertia remote add'")
+		}
+		return root.remotes, nil
+	}
+	var (
+		selected = make([]*cfg.Remote, 0, len(names))
+		seen     = make(map[string]bool, len(names))
+	)
+	for _, name := range names {
+		if seen[name] {
+			continue
+		}
+		var found *cfg.Remote
+		for _, r := range root.remotes {
+			if r.Name == name {
+				found = r
+				break
+			}
+		}
+		if found == nil {
+			return nil, fmt.Errorf("remote '%s' does not exist", name)
+		}
+		selected = append(selected, found)
+		seen[name] = true
+	}
+	return selected, nil
+}
+
+const (
+	fanOutStatusOK      = "ok"
+	fanOutStatusFailed  = "failed"
+	fanOutStatusSkipped = "skipped"
+)
+
+// fanOutOptions configures how operations are executed across remotes
+type fanOutOptions struct {
+	// Concurrency limits how many remotes are handled at once
+	Concurrency int
+	// Rolling handles remotes one at a time, stopping at the first failure
+	Rolling bool
+}
+
+// fanOutResult denotes the result of an operation on a single remote
+type fanOutResult struct {
+	Remote   string
+	Status   string
+	Detail   string
+	Duration time.Duration
+}
+
+// fanOut executes op for each of the given remotes, and returns results in the
+// same order as the given remotes
+func fanOut(
+	ctx context.Context,
+	remotes []string,
+	opts fanOutOptions,
+	op func(ctx context.Context, remote string) (string, error),
+) []fanOutResult {
+	var results = make([]fanOutResult, len(remotes))
+	var execute = func(i int) bool {
+		var start = time.Now()
+		detail, err := op(ctx, remotes[i])
+		results[i] = fanOutResult{
+			Remote:   remotes[i],
+			Status:   fanOutStatusOK,
+			Detail:   detail,
+			Duration: time.Since(start),
+		}
+		if err != nil {
+			results[i].Status = fanOutStatusFailed
+			results[i].Detail = err.Error()
+			return false
+		}
+		return true
+	}
+
+	if opts.Rolling {
+		var failed = false
+		for i := range remotes {
+			if failed || ctx.Err() != nil {
+				results[i] = fanOutResult{
+					Remote: remotes[i],
+					Status: fanOutStatusSkipped,
+					Detail: "skipped due to previous failure",
+				}
+				continue
+			}
+			failed = !execute(i)
+		}
+		return results
+	}
+
+	var concurrency = opts.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	var (
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, concurrency)
+	)
+	for i := range remotes {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() { <-sem; wg.Done() }()
+			execute(i)
+		}(i)
+	}
+	wg.Wait()
+	return results
+}
+
+// formatFanOutResults renders the given results as a table
+func formatFanOutResults(results []fanOutResult) string {
+	var (
+		buf = bytes.NewBuffer(nil)
+		tw  = tabwriter.NewWriter(buf, 0, 0, 2, ' ', 0)
+	)
+	fmt.Fprintln(tw, "REMOTE\tSTATUS\tDURATION\tDETAILS")
+	for _, r := range results {
+		var duration = "-"
+		if r.Status != fanOutStatusSkipped {
+			duration = r.Duration.Round(time.Millisecond).String()
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n",
+			r.Remote, r.Status, duration, strings.ReplaceAll(r.Detail, "\n", " "))
+	}
+	tw.Flush()
+	return buf.String()
+}
@@ -0,0 +1,101 @@
+package remotescmd
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ubclaunchpad/inertia/cfg"
+)
+
+func Test_fanOut(t *testing.T) {
+	var remotes = []string{"a", "b", "c", "d"}
+	var failB = func(ctx context.Context, remote string) (string, error) {
+		if remote == "b" {
+			return "", errors.New("oh no")
+		}
+		return "deployed " + remote, nil
+	}
+
+	t.Run("parallel", func(t *testing.T) {
+		results := fanOut(context.Background(), remotes, fanOutOptions{Concurrency: 4}, failB)
+		assert.Len(t, results, 4)
+		for i, r := range results {
+			assert.Equal(t, remotes[i], r.Remote)
+		}
+		assert.Equal(t, fanOutStatusOK, results[0].Status)
+		assert.Equal(t, fanOutStatusFailed, results[1].Status)
+		assert.Equal(t, "oh no", results[1].Detail)
+		assert.Equal(t, fanOutStatusOK, results[3].Status)
+	})
+
+	t.Run("concurrency limit", func(t *testing.T) {
+		var (
+			active int32
+			max    int32
+			mux    sync.Mutex
+		)
+		fanOut(context.Background(), remotes, fanOutOptions{Concurrency: 2},
+			func(ctx context.Context, remote string) (string, error) {
+				n := atomic.AddInt32(&active, 1)
+				mux.Lock()
+				if n > max {
+					max = n
+				}
+				mux.Unlock()
+				time.Sleep(10 * time.Millisecond)
+				atomic.AddInt32(&active, -1)
+				return "", nil
+			})
+		assert.LessOrEqual(t, max, int32(2))
+	})
+
+	t.Run("rolling", func(t *testing.T) {
+		var called []string
+		results := fanOut(context.Background(), remotes, fanOutOptions{Rolling: true, Concurrency: 4},
+			func(ctx context.Context, remote string) (string, error) {
+				called = append(called, remote)
+				return failB(ctx, remote)
+			})
+		assert.Equal(t, []string{"a", "b"}, called)
+		assert.Equal(t, fanOutStatusOK, results[0].Status)
+		assert.Equal(t, fanOutStatusFailed, results[1].Status)
+		assert.Equal(t, fanOutStatusSkipped, results[2].Status)
+		assert.Equal(t, fanOutStatusSkipped, results[3].Status)
+	})
+}
+
+func Test_formatFanOutResults(t *testing.T) {
+	var table = formatFanOutResults([]fanOutResult{
+		{Remote: "staging", Status: fanOutStatusOK, Detail: "deployed", Duration: time.Second},
+		{Remote: "prod", Status: fanOutStatusSkipped, Detail: "skipped"},
+	})
+	assert.Contains(t, table, "REMOTE")
+	assert.Contains(t, table, "staging  ok       1s        deployed")
+	assert.Contains(t, table, "prod     skipped  -         skipped")
+}
+
+func TestFanOutCmd_selectRemotes(t *testing.T) {
+	var root = &FanOutCmd{remotes: []*cfg.Remote{{Name: "a"}, {Name: "b"}, {Name: "c"}}}
+
+	all, err := root.selectRemotes(nil)
+	assert.NoError(t, err)
+	assert.Len(t, all, 3)
+
+	selected, err := root.selectRemotes([]string{"c", "a", "c"})
+	assert.NoError(t, err)
+	assert.Len(t, selected, 2)
+	assert.Equal(t, "c", selected[0].Name)
+	assert.Equal(t, "a", selected[1].Name)
+
+	_, err = root.selectRemotes([]string{"d"})
+	assert.Error(t, err)
+
+	_, err = (&FanOutCmd{}).selectRemotes(nil)
+	assert.Error(t, err)
+}
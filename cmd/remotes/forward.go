@@ -0,0 +1,71 @@
+package remotescmd
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ubclaunchpad/inertia/client"
+	"github.com/ubclaunchpad/inertia/cmd/core/utils/out"
+)
+
+func (root *HostCmd) attachForwardCmd() {
+	const flagAddress = "address"
+	var forward = &cobra.Command{
+		Use:   "forward [container] [localport:containerport]",
+		Short: "Forward a local port to a container on your remote",
+		Long: `Forwards connections to a local port to a port on one of your deployed
+containers, tunnelled over SSH, until interrupted. This is handy for reaching
+services that are not exposed publicly, such as databases or admin ports.
+
+If only one port is provided, the same port is used locally and on the
+container. Use 'inertia [remote] status' to see which containers are active.`,
+		Example: "inertia remote forward myproject_db_1 5433:5432",
+		Args:    cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			var address, _ = cmd.Flags().GetString(flagAddress)
+			local, remote, err := parsePortMapping(args[1])
+			if err != nil {
+				out.Fatal(err)
+			}
+
+			l, err := net.Listen("tcp", net.JoinHostPort(address, local))
+			if err != nil {
+				out.Fatalf("failed to listen on port %s: %s", local, err.Error())
+			}
+			out.Printf("forwarding %s to %s:%s - press Ctrl+C to stop\n",
+				l.Addr().String(), args[0], remote)
+			if err := root.client.Forward(root.ctx, client.ForwardRequest{
+				Container: args[0],
+				Port:      remote,
+				Listener:  l,
+			}); err != nil {
+				out.Fatal(err)
+			}
+			out.Println("port forwarding stopped")
+		},
+	}
+	forward.Flags().String(flagAddress, "127.0.0.1", "local address to listen on")
+	root.AddCommand(forward)
+}
+
+// parsePortMapping parses a 'localport:containerport' pair, or a single port
+// used for both
+func parsePortMapping(mapping string) (local, remote string, err error) {
+	var parts = strings.Split(mapping, ":")
+	if len(parts) > 2 {
+		return "", "", fmt.Errorf("invalid port mapping %q", mapping)
+	}
+	for _, p := range parts {
+		if n, err := strconv.Atoi(p); err != nil || n < 1 || n > 65535 {
+			return "", "", fmt.Errorf("invalid port %q in port mapping %q", p, mapping)
+		}
+	}
+	if len(parts) == 1 {
+		return parts[0], parts[0], nil
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,36 @@
+package remotescmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parsePortMapping(t *testing.T) {
+	tests := []struct {
+		name       string
+		mapping    string
+		wantLocal  string
+		wantRemote string
+		wantErr    bool
+	}{
+		{"single port", "5432", "5432", "5432", false},
+		{"pair", "5433:5432", "5433", "5432", false},
+		{"empty", "", "", "", true},
+		{"not a number", "db:5432", "", "", true},
+		{"out of range", "5433:70000", "", "", true},
+		{"too many parts", "1:2:3", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			local, remote, err := parsePortMapping(tt.mapping)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantLocal, local)
+			assert.Equal(t, tt.wantRemote, remote)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package remotescmd
+
+import (
+	"context"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/cmd/core/utils/out"
+	"github.com/ubclaunchpad/inertia/common"
+)
+
+// MetricsCmd is the parent class for the 'metrics' subcommands
+type MetricsCmd struct {
+	*cobra.Command
+	host *HostCmd
+}
+
+// AttachMetricsCmd attaches the 'metrics' subcommands to the given host
+func AttachMetricsCmd(host *HostCmd) {
+	var metrics = &MetricsCmd{
+		Command: &cobra.Command{
+			Use:   "metrics",
+			Short: "Manage access to your remote's Prometheus metrics",
+			Long: `Manages access to the '/metrics' endpoint on your remote's daemon, which
+exposes deployment, build, webhook, login, and container metrics in the
+Prometheus text format.
+
+Scrapers do not log in as users - instead, they must either provide a dedicated
+scrape token, or connect from an allowed IP address or network.`,
+		},
+		host: host,
+	}
+
+	// attach children
+	metrics.attachConfigureCmd()
+	metrics.attachSettingsCmd()
+
+	// attach to parent
+	host.AddCommand(metrics.Command)
+}
+
+// Context returns the root host command's context
+func (root *MetricsCmd) Context() context.Context { return root.host.ctx }
+
+func (root *MetricsCmd) attachConfigureCmd() {
+	const (
+		flagGenerateToken = "generate-token"
+		flagAllow         = "allow"
+	)
+	var configure = &cobra.Command{
+		Use:   "configure",
+		Short: "Configure who may scrape metrics",
+		Long: `Configures who may scrape your remote's metrics. This replaces any existing
+configuration - running it without flags disables access to metrics.
+
+Use '--generate-token' to create a new scrape token, which scrapers must provide
+as a bearer token, and '--allow' to allow scrapers from the given IP addresses or
+CIDR ranges without a token.`,
+		Example: `inertia production metrics configure --generate-token
+inertia production metrics configure --allow 10.0.0.0/8,192.168.1.10`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			var (
+				generate, _ = cmd.Flags().GetBool(flagGenerateToken)
+				allow, _    = cmd.Flags().GetStringSlice(flagAllow)
+			)
+			var conf = api.MetricsConfiguration{AllowedNetworks: allow}
+			if generate {
+				token, err := common.GenerateRandomString()
+				if err != nil {
+					out.Fatal(err)
+				}
+				conf.ScrapeToken = token
+			}
+
+			if err := root.host.client.ConfigureMetrics(root.Context(), conf); err != nil {
+				out.Fatal(err)
+			}
+			if conf.ScrapeToken == "" && len(conf.AllowedNetworks) == 0 {
+				out.Println("metrics access disabled")
+				return
+			}
+			out.Println("metrics configuration successfully updated")
+
+			addr, _ := root.host.getRemote().DaemonAddr()
+			out.Printf("metrics are available at %s/metrics\n", addr)
+			if conf.ScrapeToken != "" {
+				out.Printf("scrape token (this will not be shown again): %s\n", conf.ScrapeToken)
+			}
+		},
+	}
+	configure.Flags().Bool(flagGenerateToken, false, "generate a new scrape token")
+	configure.Flags().StringSlice(flagAllow, nil,
+		"IP addresses or CIDR ranges allowed to scrape without a token")
+	root.AddCommand(configure)
+}
+
+func (root *MetricsCmd) attachSettingsCmd() {
+	var settings = &cobra.Command{
+		Use:   "settings",
+		Short: "Show who may scrape metrics",
+		Long:  `Shows how access to metrics is configured. The scrape token is not shown.`,
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			conf, err := root.host.client.GetMetricsConfiguration(root.Context())
+			if err != nil {
+				out.Fatal(err)
+			}
+			var allowed = "none"
+			if len(conf.AllowedNetworks) > 0 {
+				allowed = strings.Join(conf.AllowedNetworks, ", ")
+			}
+			out.Printf("scrape token:  %v\n", conf.ScrapeToken != "")
+			out.Printf("allowed:       %s\n", allowed)
+		},
+	}
+	root.AddCommand(settings)
+}
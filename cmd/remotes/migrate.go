@@ -0,0 +1,128 @@
+package remotescmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ubclaunchpad/inertia/client"
+	"github.com/ubclaunchpad/inertia/cmd/core/utils/input"
+	"github.com/ubclaunchpad/inertia/cmd/core/utils/out"
+	"github.com/ubclaunchpad/inertia/local"
+)
+
+func (root *HostCmd) attachMigrateCmd() {
+	const (
+		flagYes      = "yes"
+		flagNoDeploy = "no-deploy"
+	)
+	var migrate = &cobra.Command{
+		Use:   "migrate [new-remote]",
+		Short: "Move daemon state to a new remote",
+		Long: `Moves everything the daemon on this remote knows about your project to the
+daemon on another remote - users, environment variables and secrets, the deploy
+key, the webhook secret, persistent data, deployment history, and the deployed
+commit - then redeploys the project there.
+
+The new remote must already be added and initialized with 'inertia remote add'
+and 'inertia [new-remote] init', and must not have a project deployed. Once the
+migration completes, this remote's configuration is updated to point at the new
+host and the new remote's entry is removed. The old host is left untouched, so
+shut it down once you have verified the migration.`,
+		Example: "inertia production migrate production-new",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			var (
+				yes, _      = cmd.Flags().GetBool(flagYes)
+				noDeploy, _ = cmd.Flags().GetBool(flagNoDeploy)
+				source      = root.getRemote()
+			)
+			if args[0] == source.Name {
+				out.Fatal("cannot migrate a remote to itself")
+			}
+			remotes, err := local.GetRemotes()
+			if err != nil {
+				out.Fatal(err)
+			}
+			targetCfg, found := remotes.GetRemote(args[0])
+			if !found {
+				out.Fatalf("remote '%s' not found - add it with 'inertia remote add'", args[0])
+			}
+			if targetCfg.Daemon == nil || targetCfg.Daemon.Token == "" {
+				out.Fatalf("remote '%s' has not been initialized - run 'inertia %s init' first",
+					targetCfg.Name, targetCfg.Name)
+			}
+			target, err := client.NewClient(targetCfg, client.Options{
+				SSH: local.SSHOptions(targetCfg),
+				Out: out.TextOut(),
+			})
+			if err != nil {
+				out.Fatal(err)
+			}
+
+			if !yes {
+				ok, err := input.NewPrompt(nil).
+					Prompt(out.C(":warning: This will copy all daemon state from '%s' to '%s' and point '%s' at the new host. Continue? (y/N)", out.CY).
+						With(source.Name, targetCfg.Name, source.Name)).
+					GetBool()
+				if err != nil || !ok {
+					out.Fatal("aborting")
+				}
+			}
+
+			out.Printf("migrating state from '%s' to '%s'...\n", source.Name, targetCfg.Name)
+			manifest, err := root.client.Migrate(root.ctx, target)
+			if err != nil {
+				out.Fatal(err)
+			}
+			out.Println("daemon state successfully migrated")
+
+			// carry over client-side state that the daemon does not track
+			targetCfg.Daemon.WebHookSecret = source.Daemon.WebHookSecret
+			targetCfg.Profiles = source.Profiles
+
+			if commit := manifest.Deployment.CommitHash; commit != "" && !noDeploy {
+				var profileName = source.GetProfile(root.project.Name)
+				profile, found := root.project.GetProfile(profileName)
+				if !found {
+					out.Fatalf("could not find profile '%s'", profileName)
+				}
+				out.Printf("deploying project '%s' at commit %s using profile '%s'\n",
+					root.project.Name, commit, profileName)
+				var req = client.UpRequest{
+					Project: root.project.Name,
+					URL:     root.project.URL,
+					Profile: *profile,
+					Commit:  commit,
+				}
+				var short, _ = cmd.Flags().GetBool(flagShort)
+				if short {
+					err = target.Up(root.ctx, req)
+				} else {
+					err = target.UpWithOutput(root.ctx, req)
+				}
+				if err != nil {
+					out.Printf(":warning: failed to deploy project on new host: %v\n", err)
+					out.Printf("run 'inertia %s up' to try again\n", source.Name)
+				}
+			}
+
+			// point the source remote at the new host
+			var targetName = targetCfg.Name
+			targetCfg.Name = source.Name
+			if err := local.SaveRemote(targetCfg); err != nil {
+				out.Fatal(err)
+			}
+			if err := local.RemoveRemote(targetName); err != nil {
+				out.Fatal(err)
+			}
+
+			out.Printf("remote '%s' now points to %s, and remote '%s' has been removed\n",
+				source.Name, targetCfg.IP, targetName)
+			out.Println("if you use continuous deployment, update your repository's webhook to point to the new host")
+			out.Printf("the project and daemon on the old host (%s) are still running - shut them down once you have verified the migration\n",
+				source.IP)
+		},
+	}
+	migrate.Flags().BoolP(flagYes, "y", false, "skip confirmation")
+	migrate.Flags().Bool(flagNoDeploy, false, "don't redeploy the project on the new host")
+	root.AddCommand(migrate)
+}
@@ -5,14 +5,13 @@ import (
 	"fmt"
 	"os"
 	"path"
-	"strings"
 
 	"github.com/spf13/cobra"
 
+	"github.com/ubclaunchpad/inertia/api"
 	"github.com/ubclaunchpad/inertia/cfg"
 	"github.com/ubclaunchpad/inertia/client"
 	"github.com/ubclaunchpad/inertia/client/bootstrap"
-	"github.com/ubclaunchpad/inertia/client/runner"
 	"github.com/ubclaunchpad/inertia/cmd/core"
 	"github.com/ubclaunchpad/inertia/cmd/core/utils/input"
 	"github.com/ubclaunchpad/inertia/cmd/core/utils/out"
@@ -51,17 +50,28 @@ func AttachRemotesCmds(root *core.Cmd, validateConfig bool) {
 		}
 	}
 
-	// parse and attach remotes
+	// parse and attach remotes - the 'all' command is always attached so that
+	// remotes cannot be added under its name
 	cfg, err := local.GetRemotes()
 	if err != nil {
+		AttachFanOutCmd(root, project, nil)
 		return
 	}
+	AttachFanOutCmd(root, project, cfg.Remotes)
 	var remotes = make(map[string]bool)
 	for _, r := range cfg.Remotes {
 		if _, ok := remotes[r.Name]; ok {
 			out.Fatalf("you have configured multiple remotes named '%s' - please rename one in %s",
 				r.Name, local.InertiaRemotesPath())
 		}
+		if r.Name == fanOutCmdName {
+			// remotes could be named 'all' before the command was added, so don't
+			// refuse to run - but its commands can't be reached until it is renamed
+			out.Println(out.C(":warning: Your remote named '%s' is hidden by the 'inertia %s' command - please rename it in %s",
+				out.YE, out.BO).With(r.Name, fanOutCmdName, local.InertiaRemotesPath()).String())
+			remotes[r.Name] = true
+			continue
+		}
 		for _, child := range root.Commands() {
 			if child.Name() == r.Name {
 				out.Fatalf("you have configured a remote named '%s', which is an Inertia command - please rename it in %s",
@@ -106,10 +116,8 @@ func AttachRemoteHostCmd(
 	ctx, cancel := context.WithCancel(context.Background())
 	input.CatchSigterm(cancel)
 	c, err := client.NewClient(opts.RemoteCfg, client.Options{
-		SSH: runner.SSHOptions{
-			KeyPassphrase: os.Getenv(local.EnvSSHPassphrase),
-		},
-		Out: os.Stdout,
+		SSH: local.SSHOptions(opts.RemoteCfg),
+		Out: out.TextOut(),
 	})
 	if err != nil {
 		out.Printf(":warning: Failed to load remote %q: %v\n", opts.RemoteCfg.Name, err)
@@ -164,13 +172,23 @@ Run 'inertia [remote] init' to gather this information.`,
 	// attach children
 	host.attachInitCmd()
 	host.attachUpCmd()
+	host.attachPlanCmd()
 	host.attachDownCmd()
 	host.attachStatusCmd()
 	host.attachLogsCmd()
+	host.attachStatsCmd()
+	host.attachWatchCmd()
+	host.attachDashboardCmd()
 	AttachUserCmd(host)
 	AttachEnvCmd(host)
+	AttachSecretCmd(host)
+	AttachBackupCmd(host)
+	AttachMetricsCmd(host)
+	AttachDiskCmd(host)
 	host.attachSendFileCmd()
 	host.attachSSHCmd()
+	host.attachForwardCmd()
+	host.attachMigrateCmd()
 	host.attachPruneCmd()
 	host.attachTokenCmd()
 	host.attachUpgradeCmd()
@@ -185,6 +203,7 @@ func (root *HostCmd) getRemote() *cfg.Remote { return root.client.Remote }
 func (root *HostCmd) attachUpCmd() {
 	const (
 		flagProfile = "profile"
+		flagDryRun  = "dry-run"
 	)
 	var up = &cobra.Command{
 		Use:   "up",
@@ -197,20 +216,16 @@ This requires an Inertia daemon to be active on your remote - do this by running
 		Run: func(cmd *cobra.Command, args []string) {
 			// Get flags and profile
 			var short, _ = cmd.Flags().GetBool(flagShort)
-			var profileName = root.getRemote().GetProfile(root.project.Name)
-			profile, found := root.project.GetProfile(profileName)
-			if !found {
-				out.Fatalf("could not find profile '%s'", profileName)
+			req, err := root.upRequest()
+			if err != nil {
+				out.Fatal(err)
 			}
-			out.Printf("deploying project '%s' using profile '%s'\n", root.project.Name, profileName)
-
-			// Make up request
-			var req = client.UpRequest{
-				Project: root.project.Name,
-				URL:     root.project.URL,
-				Profile: *profile}
+			if dryRun, _ := cmd.Flags().GetBool(flagDryRun); dryRun {
+				root.plan(req)
+				return
+			}
+			out.Printf("deploying project '%s' using profile '%s'\n", root.project.Name, req.Profile.Name)
 
-			var err error
 			if short {
 				err = root.client.Up(root.ctx, req)
 			} else {
@@ -225,9 +240,65 @@ This requires an Inertia daemon to be active on your remote - do this by running
 		},
 	}
 	up.Flags().StringP(flagProfile, "p", "", "specify a profile to deploy")
+	up.Flags().Bool(flagDryRun, false, "show what would be deployed without deploying - see 'inertia [remote] plan'")
 	root.AddCommand(up)
 }
 
+func (root *HostCmd) attachPlanCmd() {
+	var plan = &cobra.Command{
+		Use:   "plan",
+		Short: "Preview what 'inertia [remote] up' would change",
+		Long: `Previews what bringing your project online with 'inertia [remote] up' would
+change, without changing the deployment. This lists the currently deployed commit
+and the latest commit of your profile's branch, the commits in between, the
+settings that would be applied compared to the ones the daemon is running with,
+changed environment variables, and the containers that would be recreated.
+
+Values of environment variables and secrets are never shown.`,
+		Example: "inertia staging plan",
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			req, err := root.upRequest()
+			if err != nil {
+				out.Fatal(err)
+			}
+			root.plan(req)
+		},
+	}
+	root.AddCommand(plan)
+}
+
+// plan prints what deploying the project with the given request would change
+func (root *HostCmd) plan(req client.UpRequest) {
+	plan, err := root.client.Plan(root.ctx, req)
+	if err != nil {
+		out.Fatal(err)
+	}
+	out.Render(out.PlanDocument{
+		Remote:         root.getRemote().Name,
+		DeploymentPlan: plan,
+	}, func() {
+		out.Printf("planning deployment of project '%s' using profile '%s'\n",
+			root.project.Name, req.Profile.Name)
+		out.Print(out.FormatPlan(plan))
+	})
+}
+
+// upRequest assembles a request to deploy the project using the profile
+// applied to this remote
+func (root *HostCmd) upRequest() (client.UpRequest, error) {
+	var profileName = root.getRemote().GetProfile(root.project.Name)
+	profile, found := root.project.GetProfile(profileName)
+	if !found {
+		return client.UpRequest{}, fmt.Errorf("could not find profile '%s'", profileName)
+	}
+	return client.UpRequest{
+		Project: root.project.Name,
+		URL:     root.project.URL,
+		Profile: *profile,
+	}, nil
+}
+
 func (root *HostCmd) attachDownCmd() {
 	var down = &cobra.Command{
 		Use:   "down",
@@ -246,11 +317,17 @@ Requires project to be online - do this by running 'inertia [remote] up`,
 }
 
 func (root *HostCmd) attachStatusCmd() {
+	const (
+		flagChanges = "changes"
+	)
 	var stat = &cobra.Command{
 		Use:   "status",
 		Short: "Print the status of the deployment on this remote",
 		Long: `Prints the status of the deployment on this remote.
 
+Use --changes to also list the commits shipped by the current deployment since
+the previous one.
+
 Requires the Inertia daemon to be active on your remote - do this by running 'inertia [remote] up'`,
 		Run: func(cmd *cobra.Command, args []string) {
 			status, err := root.client.Status(root.ctx)
@@ -263,37 +340,89 @@ Requires the Inertia daemon to be active on your remote - do this by running 'in
 			if err != nil {
 				out.Fatal(err)
 			}
-			out.Printf("Inertia daemon on remote %q (%s) is online\n",
-				remote.Name, host)
-			out.Print(out.FormatStatus(remote.Name, status))
+			var doc = out.StatusDocument{
+				Remote:                       remote.Name,
+				Address:                      host,
+				DeploymentStatusWithVersions: status,
+			}
+
+			// The most recent deployment recorded is the current one, unless
+			// the deployment has been changed since
+			var changes, _ = cmd.Flags().GetBool(flagChanges)
+			if changes {
+				history, err := root.client.History(root.ctx)
+				if err != nil {
+					out.Fatal(err)
+				}
+				if len(history) > 0 && history[0].CommitHash == status.CommitHash {
+					doc.Changes = &history[0]
+				}
+			}
+
+			out.Render(doc, func() {
+				out.Printf("Inertia daemon on remote %q (%s) is online\n",
+					remote.Name, host)
+				out.Print(out.FormatStatus(remote.Name, status))
+				if changes {
+					out.Print(out.FormatChanges(doc.Changes))
+				}
+			})
 		},
 	}
+	stat.Flags().Bool(flagChanges, false, "list the commits shipped by the current deployment")
 	root.AddCommand(stat)
 }
 
 func (root *HostCmd) attachLogsCmd() {
-	const flagEntries = "entries"
+	const (
+		flagEntries = "entries"
+		flagAll     = "all"
+		flagSince   = "since"
+		flagUntil   = "until"
+		flagGrep    = "grep"
+		flagLevel   = "level"
+	)
 	var log = &cobra.Command{
-		Use:   "logs [container]",
+		Use:   "logs [containers...]",
 		Short: "Access logs of containers on your remote host",
 		Long: `Accesses logs of containers on your remote host.
 	
-By default, this command retrieves Inertia daemon logs, but you can provide an
-argument that specifies the name of the container you wish to retrieve logs for.
-Use 'inertia [remote] status' to see which containers are active.`,
+By default, this command retrieves Inertia daemon logs, but you can provide
+arguments that specify the names of the containers you wish to retrieve logs
+for, or use --all to retrieve logs from every project container. Logs from
+several containers are merged in timestamp order, and prefixed with the name of
+the container they came from. Use 'inertia [remote] status' to see which
+containers are active.
+
+Logs can be filtered by time, content, and level - filters are applied on your
+remote, so only matching lines are downloaded. Times can be given as RFC3339 or
+Unix timestamps, or as durations before the present such as '10m'. Levels are
+detected from common log formats, and lines without a recognizable level are
+treated as errors if they were written to stderr.`,
+		Example: `inertia remote logs web db --since 1h --level warn
+inertia remote logs --all --grep "GET /api/.*" --short`,
 		Run: func(cmd *cobra.Command, args []string) {
 			var short, _ = cmd.Flags().GetBool(flagShort)
 			var entries, _ = cmd.Flags().GetInt(flagEntries)
+			var all, _ = cmd.Flags().GetBool(flagAll)
 
 			// get daemon logs by default
-			var container = "/inertia-daemon"
-			if len(args) > 0 {
-				container = args[0]
+			var containers = args
+			if len(containers) == 0 && !all {
+				containers = []string{"/inertia-daemon"}
 			}
 
 			var req = client.LogsRequest{
-				Container: container,
-				Entries:   entries}
+				Containers: containers,
+				Entries:    entries,
+				All:        all,
+			}
+			req.Since, _ = cmd.Flags().GetString(flagSince)
+			req.Until, _ = cmd.Flags().GetString(flagUntil)
+			req.Grep, _ = cmd.Flags().GetString(flagGrep)
+			req.Level, _ = cmd.Flags().GetString(flagLevel)
+			// log lines are written as-is, without replacing emoji codes
+			var formatter = out.NewLogFormatter(all || len(containers) > 1, containers...)
 
 			if short {
 				// if short, just grab the last x log entries
@@ -301,16 +430,29 @@ Use 'inertia [remote] status' to see which containers are active.`,
 				if err != nil {
 					out.Fatal(err)
 				}
-				out.Println(strings.Join(logs, "\n"))
+				out.Render(out.LogsDocument{Logs: logs}, func() {
+					for _, entry := range logs {
+						fmt.Fprintln(out.TextOut(), formatter.Format(entry))
+					}
+				})
 			} else {
 				// if not short, open a websocket to stream logs
-				if err := root.client.LogsWithOutput(root.ctx, req); err != nil {
+				if err := root.client.StreamLogs(root.ctx, req, func(entry api.LogEntry) {
+					out.RenderStream(entry, func() {
+						fmt.Fprintln(out.TextOut(), formatter.Format(entry))
+					})
+				}); err != nil {
 					out.Fatal(err)
 				}
 			}
 		},
 	}
 	log.Flags().Int(flagEntries, 0, "Number of log entries to fetch")
+	log.Flags().Bool(flagAll, false, "Fetch logs of every project container")
+	log.Flags().String(flagSince, "", "Only show logs since a time, such as '10m' or '2020-01-02T15:04:05Z'")
+	log.Flags().String(flagUntil, "", "Only show logs before a time, such as '10m' or '2020-01-02T15:04:05Z'")
+	log.Flags().String(flagGrep, "", "Only show lines matching a regular expression")
+	log.Flags().String(flagLevel, "", "Only show lines at or above a level (debug, info, warn, error)")
 	root.AddCommand(log)
 }
 
@@ -418,7 +560,7 @@ webhook URL enables continuous deployment as your repository is updated.`,
 			var repo = common.ExtractRepository(common.GetSSHRemoteURL(root.project.URL))
 			if err := bootstrap.Bootstrap(root.client, bootstrap.Options{
 				RepoName: repo,
-				Out:      os.Stdout,
+				Out:      out.TextOut(),
 			}); err != nil {
 				out.Fatal(err.Error())
 			}
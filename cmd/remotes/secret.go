@@ -0,0 +1,122 @@
+package remotescmd
+
+import (
+	"context"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ubclaunchpad/inertia/cmd/core/utils/out"
+)
+
+// SecretCmd is the parent class for the 'secret' subcommands
+type SecretCmd struct {
+	*cobra.Command
+	host *HostCmd
+}
+
+// AttachSecretCmd attaches the 'secret' subcommands to the given host
+func AttachSecretCmd(host *HostCmd) {
+	var secret = &SecretCmd{
+		Command: &cobra.Command{
+			Use:   "secret",
+			Short: "Manage secret files on your remote",
+			Long: `Manages secret files on your remote through Inertia.
+
+Secrets are suited for credentials that do not fit well in environment variables,
+such as TLS keys or service account files. They are always encrypted when stored,
+and are written to an in-memory filesystem on your remote when your project is
+deployed. Each secret is available as a read-only file in all project containers:
+
+    /run/secrets/inertia/${secret_name}
+`,
+		},
+		host: host,
+	}
+
+	// attach children
+	secret.attachSetCmd()
+	secret.attachListCmd()
+	secret.attachRemoveCmd()
+
+	// attach to parent
+	host.AddCommand(secret.Command)
+}
+
+// Context returns the root host command's context
+func (root *SecretCmd) Context() context.Context { return root.host.ctx }
+
+func (root *SecretCmd) attachSetCmd() {
+	const flagFile = "file"
+	var set = &cobra.Command{
+		Use:   "set [name]",
+		Short: "Set a secret file on your remote",
+		Long: `Sets a secret file on your remote from the contents of the given file. The
+secret is applied the next time your project is deployed.`,
+		Example: "inertia production secret set tls.key --file ./certs/tls.key",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			var file, _ = cmd.Flags().GetString(flagFile)
+			value, err := ioutil.ReadFile(file)
+			if err != nil {
+				out.Fatal(err)
+			}
+			if err := root.host.client.UpdateSecret(
+				root.Context(),
+				args[0],
+				value,
+				false,
+			); err != nil {
+				out.Fatal(err)
+			}
+			out.Println("secret successfully updated")
+		},
+	}
+	set.Flags().StringP(flagFile, "f", "", "file to read secret from")
+	set.MarkFlagRequired(flagFile)
+	root.AddCommand(set)
+}
+
+func (root *SecretCmd) attachRemoveCmd() {
+	var remove = &cobra.Command{
+		Use:   "rm [name]",
+		Short: "Remove a secret file from your remote",
+		Long: `Removes the specified secret from persistent storage. It is removed from
+project containers the next time your project is deployed.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := root.host.client.UpdateSecret(
+				root.Context(),
+				args[0],
+				nil,
+				true,
+			); err != nil {
+				out.Fatal(err)
+			}
+			out.Println("secret successfully removed")
+		},
+	}
+	root.AddCommand(remove)
+}
+
+func (root *SecretCmd) attachListCmd() {
+	var list = &cobra.Command{
+		Use:   "ls",
+		Short: "List currently set secret files",
+		Long:  `Lists the names of currently set secret files. Secret values are not shown.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			secrets, err := root.host.client.ListSecrets(root.Context())
+			if err != nil {
+				out.Fatal(err)
+			}
+
+			if len(secrets) == 0 {
+				out.Println("no secrets configured on remote")
+			} else {
+				out.Println(strings.Join(secrets, "\n"))
+			}
+		},
+	}
+	root.AddCommand(list)
+}
@@ -0,0 +1,117 @@
+package remotescmd
+
+import (
+	"bytes"
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/cmd/core/utils/out"
+)
+
+// clearScreen moves the cursor to the top left and clears the terminal
+const clearScreen = "\033[H\033[2J"
+
+func (root *HostCmd) attachStatsCmd() {
+	const (
+		flagWatch    = "watch"
+		flagInterval = "interval"
+	)
+	var stats = &cobra.Command{
+		Use:   "stats",
+		Short: "Show resource usage of your project's containers and remote host",
+		Long: `Shows the CPU, memory, network, and block I/O usage and number of processes
+of each of your project's containers, as well as the memory usage of your remote
+host and the disk usage of the filesystem Inertia stores data on.
+
+CPU usage is relative to a single CPU, so containers using several CPUs may
+report usage over 100%.
+
+Use '--watch' to continuously update the display, and '--output json' to print
+raw snapshots instead - in watch mode, one snapshot is printed per line.`,
+		Example: `inertia production stats
+inertia production stats --watch --interval 5
+inertia production stats --output json`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			var (
+				watch, _    = cmd.Flags().GetBool(flagWatch)
+				interval, _ = cmd.Flags().GetInt(flagInterval)
+			)
+
+			var show = func(s api.Stats) {
+				if !watch {
+					out.Render(s, func() { out.Print(formatStats(s)) })
+					return
+				}
+				out.RenderStream(s, func() {
+					out.Print(clearScreen)
+					out.Print(formatStats(s))
+				})
+			}
+
+			if !watch {
+				s, err := root.client.Stats(root.ctx)
+				if err != nil {
+					out.Fatal(err)
+				}
+				show(*s)
+				return
+			}
+			if err := root.client.WatchStats(root.ctx,
+				time.Duration(interval)*time.Second, show); err != nil {
+				out.Fatal(err)
+			}
+		},
+	}
+	stats.Flags().BoolP(flagWatch, "w", false, "continuously stream updates")
+	stats.Flags().Int(flagInterval, 2, "seconds between updates when watching")
+	root.AddCommand(stats)
+}
+
+// formatStats renders the given stats as a summary of host usage followed by
+// a table of container usage
+func formatStats(s api.Stats) string {
+	var (
+		buf = bytes.NewBuffer(nil)
+		tw  = tabwriter.NewWriter(buf, 0, 0, 2, ' ', 0)
+	)
+	var memUsed = s.Host.MemoryTotal - s.Host.MemoryAvailable
+	fmt.Fprintf(buf, "host memory:  %s / %s (%.1f%%)\n",
+		formatSize(int64(memUsed)), formatSize(int64(s.Host.MemoryTotal)),
+		percent(memUsed, s.Host.MemoryTotal))
+	if s.Host.DiskPath != "" {
+		var diskUsed = s.Host.DiskTotal - s.Host.DiskFree
+		fmt.Fprintf(buf, "host disk:    %s / %s (%.1f%%) on %s\n",
+			formatSize(int64(diskUsed)), formatSize(int64(s.Host.DiskTotal)),
+			percent(diskUsed, s.Host.DiskTotal), s.Host.DiskPath)
+	}
+	fmt.Fprintln(buf)
+
+	if len(s.Containers) == 0 {
+		fmt.Fprintln(buf, "no project containers are running")
+		return buf.String()
+	}
+	fmt.Fprintln(tw, "CONTAINER\tCPU %\tMEM USAGE / LIMIT\tMEM %\tNET I/O\tBLOCK I/O\tPIDS")
+	for _, c := range s.Containers {
+		fmt.Fprintf(tw, "%s\t%.2f%%\t%s / %s\t%.2f%%\t%s / %s\t%s / %s\t%d\n",
+			c.Name, c.CPUPercent,
+			formatSize(int64(c.MemoryUsage)), formatSize(int64(c.MemoryLimit)),
+			percent(c.MemoryUsage, c.MemoryLimit),
+			formatSize(int64(c.NetworkRx)), formatSize(int64(c.NetworkTx)),
+			formatSize(int64(c.BlockRead)), formatSize(int64(c.BlockWrite)),
+			c.PIDs)
+	}
+	tw.Flush()
+	return buf.String()
+}
+
+func percent(n, total uint64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(n) / float64(total) * 100
+}
@@ -0,0 +1,45 @@
+package remotescmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ubclaunchpad/inertia/api"
+)
+
+func Test_formatStats(t *testing.T) {
+	var stats = api.Stats{
+		Host: api.HostStats{
+			MemoryTotal:     4 * 1024 * 1024 * 1024,
+			MemoryAvailable: 3 * 1024 * 1024 * 1024,
+			DiskPath:        "/app/host/inertia/data/",
+			DiskTotal:       100 * 1024 * 1024 * 1024,
+			DiskFree:        25 * 1024 * 1024 * 1024,
+		},
+		Containers: []api.ContainerStats{{
+			Name:        "/web",
+			CPUPercent:  12.5,
+			MemoryUsage: 256 * 1024 * 1024,
+			MemoryLimit: 1024 * 1024 * 1024,
+			NetworkRx:   1536,
+			NetworkTx:   512,
+			BlockRead:   2 * 1024 * 1024,
+			BlockWrite:  0,
+			PIDs:        7,
+		}},
+	}
+	assert.Equal(t, `host memory:  1.0GiB / 4.0GiB (25.0%)
+host disk:    75.0GiB / 100.0GiB (75.0%) on /app/host/inertia/data/
+
+CONTAINER  CPU %   MEM USAGE / LIMIT  MEM %   NET I/O        BLOCK I/O    PIDS
+/web       12.50%  256.0MiB / 1.0GiB  25.00%  1.5KiB / 512B  2.0MiB / 0B  7
+`, formatStats(stats))
+
+	stats.Host.DiskPath = ""
+	stats.Containers = nil
+	assert.Equal(t, `host memory:  1.0GiB / 4.0GiB (25.0%)
+
+no project containers are running
+`, formatStats(stats))
+}
@@ -205,7 +205,9 @@ func (root *UserCmd) attachListCmd() {
 			if err != nil {
 				out.Fatal(err)
 			}
-			out.Println(strings.Join(users, "\n"))
+			out.Render(out.UsersDocument{Users: users}, func() {
+				out.Println(strings.Join(users, "\n"))
+			})
 		},
 	}
 	root.AddCommand(list)
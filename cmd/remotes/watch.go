@@ -0,0 +1,40 @@
+package remotescmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ubclaunchpad/inertia/cmd/core/utils/out"
+)
+
+func (root *HostCmd) attachWatchCmd() {
+	const flagType = "type"
+	var watch = &cobra.Command{
+		Use:   "watch",
+		Short: "Stream events from your remote as they happen",
+		Long: `Streams events from your remote as they happen, such as webhooks being
+received or ignored, builds starting and finishing, containers starting and
+dying, environment variables changing, and users logging in.
+
+Use '--type' to only show certain kinds of events - types can be given in full,
+such as 'container.died', or by prefix, such as 'build'. Use '--output json' to
+print each event as a line of JSON instead.`,
+		Example: `inertia production watch
+inertia production watch --type build --type container.died
+inertia production watch --output json`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			var types, _ = cmd.Flags().GetStringSlice(flagType)
+			var events, errs = root.client.Events(root.ctx, types...)
+			for event := range events {
+				out.RenderStream(event, func() {
+					out.Println(out.FormatEvent(event))
+				})
+			}
+			if err := <-errs; err != nil {
+				out.Fatal(err)
+			}
+		},
+	}
+	watch.Flags().StringSlice(flagType, nil, "only show events of the given types or prefixes")
+	root.AddCommand(watch)
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -20,6 +21,13 @@ func getVersion(version string) string {
 	return version
 }
 
+// setOutputFormat configures the output format, and exits if it is invalid
+func setOutputFormat(format string) {
+	if err := out.SetOutputFormat(format); err != nil {
+		out.FatalCode(out.ExitUsage, err)
+	}
+}
+
 // NewInertiaCmd is a new Inertia command
 func NewInertiaCmd(version, inertiaConfigPath string, validateConfig bool) *core.Cmd {
 	cobra.EnableCommandSorting = false
@@ -36,6 +44,13 @@ func NewInertiaCmd(version, inertiaConfigPath string, validateConfig bool) *core
 	// persistent flags across all children
 	root.PersistentFlags().StringVar(&root.ProjectConfigPath, "config", "inertia.toml", "specify relative path to Inertia project configuration")
 	root.PersistentFlags().Bool("simple", false, "disable colour and emoji output")
+	root.PersistentFlags().String("output", out.FormatText,
+		"output format - one of 'text', 'json', or 'yaml'. Structured formats write documents to stdout and all other output to stderr")
+	// apply an output format set in the environment the same way as --output,
+	// which takes precedence
+	if format := os.Getenv(out.EnvOutputFormat); format != "" {
+		setOutputFormat(format)
+	}
 	// hack in flag parsing - this must be done because we need to initialize the
 	// host commands properly when Cobra first constructs the command tree, which
 	// occurs before the built-in flag parser
@@ -48,6 +63,16 @@ func NewInertiaCmd(version, inertiaConfigPath string, validateConfig bool) *core
 			os.Setenv(out.EnvColorToggle, "false")
 			os.Setenv(out.EnvEmojiToggle, "false")
 		}
+		if arg == "--output" && i+1 < len(os.Args) {
+			setOutputFormat(os.Args[i+1])
+		} else if strings.HasPrefix(arg, "--output=") {
+			setOutputFormat(strings.TrimPrefix(arg, "--output="))
+		}
+	}
+	if out.Structured() {
+		// errors are written as structured errors by the caller instead
+		root.SilenceErrors = true
+		root.SilenceUsage = true
 	}
 
 	// generate rendered output *after* setting --simple if required
@@ -74,6 +99,7 @@ Global inertia configuration is stored in '%s'.
 	projectcmd.AttachProjectCmd(root)
 	remotecmd.AttachRemoteCmd(root)
 	provisioncmd.AttachProvisionCmd(root)
+	attachPromoteCmd(root)
 	attachContribPlugins(root)
 
 	// attach configured remotes last
@@ -0,0 +1,61 @@
+package common
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ParseEnvFile parses environment variables from a .env file. Blank lines and
+// lines starting with '#' are ignored, and values may optionally be quoted.
+func ParseEnvFile(r io.Reader) (map[string]string, error) {
+	var (
+		env  = map[string]string{}
+		scan = bufio.NewScanner(r)
+		line = 0
+	)
+	for scan.Scan() {
+		line++
+		text := strings.TrimSpace(scan.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		text = strings.TrimSpace(strings.TrimPrefix(text, "export "))
+
+		parts := strings.SplitN(text, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("line %d: expected format NAME=value", line)
+		}
+		name := strings.TrimSpace(parts[0])
+		if name == "" || strings.ContainsAny(name, " \t") {
+			return nil, fmt.Errorf("line %d: invalid variable name %q", line, name)
+		}
+
+		value := strings.TrimSpace(parts[1])
+		if len(value) >= 2 {
+			switch value[0] {
+			case '"':
+				if value[len(value)-1] != '"' {
+					return nil, fmt.Errorf("line %d: unterminated quote", line)
+				}
+				unquoted, err := strconv.Unquote(value)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %s", line, err.Error())
+				}
+				value = unquoted
+			case '\'':
+				if value[len(value)-1] != '\'' {
+					return nil, fmt.Errorf("line %d: unterminated quote", line)
+				}
+				value = value[1 : len(value)-1]
+			}
+		}
+		env[name] = value
+	}
+	if err := scan.Err(); err != nil {
+		return nil, err
+	}
+	return env, nil
+}
@@ -0,0 +1,39 @@
+package common
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseEnvFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		want    map[string]string
+		wantErr bool
+	}{
+		{"empty", "", map[string]string{}, false},
+		{"comments and blank lines", "# comment\n\nA=1\n", map[string]string{"A": "1"}, false},
+		{"export prefix", "export A=1", map[string]string{"A": "1"}, false},
+		{"double quotes", `A="hello world\n"`, map[string]string{"A": "hello world\n"}, false},
+		{"single quotes", `A='hello $world'`, map[string]string{"A": "hello $world"}, false},
+		{"value with equals", "A=b=c", map[string]string{"A": "b=c"}, false},
+		{"empty value", "A=", map[string]string{"A": ""}, false},
+		{"missing equals", "A", nil, true},
+		{"invalid name", "A B=1", nil, true},
+		{"unterminated quote", `A="hello`, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseEnvFile(strings.NewReader(tt.file))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
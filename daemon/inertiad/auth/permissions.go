@@ -2,11 +2,17 @@ package auth
 
 import (
 	"context"
+	"crypto/subtle"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
+	"sort"
 	"strings"
+	"sync"
 
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/res"
 
@@ -15,9 +21,19 @@ import (
 	"github.com/go-chi/chi/middleware"
 	"github.com/go-chi/cors"
 	"github.com/go-chi/render"
+	"github.com/sirupsen/logrus"
 
 	"github.com/ubclaunchpad/inertia/api"
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/crypto"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/log"
+)
+
+// Levels of access required for paths
+const (
+	AccessPublic  = "public"
+	AccessUser    = "user"
+	AccessAdmin   = "admin"
+	AccessScraper = "scraper"
 )
 
 // ctxKey represents keys used in request contexts
@@ -28,21 +44,33 @@ const (
 )
 
 // PermissionsHandler handles users, permissions, and sessions on top
-// of an http.ServeMux. It is used for Inertia Web.
+// of an http.ServeMux. Sessions are identified by a bearer token, or by a
+// session cookie for browser clients such as the dashboard.
 type PermissionsHandler struct {
 	domain     string
 	users      *userManager
 	sessions   *sessionManager
 	mux        *chi.Mux
+	handler    http.Handler
 	userPaths  []string
 	adminPaths []string
+
+	// paths restricted to metrics scrapers, which authenticate with a
+	// dedicated token or by address rather than with a user session
+	scrapePaths    []string
+	scrapeMux      sync.RWMutex
+	scrapeToken    string
+	scrapeNetworks []*net.IPNet
+
+	onLoginFailure func()
+	onLogin        func(user string)
 }
 
 // NewPermissionsHandler returns a new handler for authenticating users and
 // handling user administration. It also serves as the primary server for the
-// Inertia daemon.
+// Inertia daemon, and logs every request it receives to the given logger.
 func NewPermissionsHandler(
-	dbPath, hostDomain string, timeout int,
+	dbPath, hostDomain string, timeout int, logger *logrus.Entry,
 	keyLookup ...func(*jwt.Token) (interface{}, error),
 ) (*PermissionsHandler, error) {
 	// Set up user manager
@@ -79,18 +107,32 @@ func NewPermissionsHandler(
 			"/user/list"},
 	}
 
-	// Register useful middleware
+	// Register useful middleware - requests are identified and logged before
+	// permissions are checked, so that rejected requests are logged as well.
+	// Client-supplied forwarding headers are not trusted, since remote
+	// addresses are used to restrict access to metrics.
+	h.handler = chi.Chain(
+		middleware.RequestID,
+		middleware.RequestLogger(log.NewRequestFormatter(logger)),
+		middleware.Recoverer,
+	).HandlerFunc(h.serve)
 	h.mux.Use(
 		cors.New(cors.Options{
 			AllowedOrigins:   []string{"*"},
 			AllowedMethods:   []string{"HEAD", "GET", "POST", "PUT", "PATCH", "DELETE"},
 			AllowedHeaders:   []string{"*"},
 			AllowCredentials: true,
-		}).Handler,
-		middleware.RequestID,
-		middleware.RealIP,
-		// TODO: logging middleware
-		middleware.Recoverer)
+		}).Handler)
+
+	h.mux.NotFound(func(w http.ResponseWriter, r *http.Request) {
+		render.Render(w, r, res.ErrNotFound(
+			fmt.Sprintf("%s %s is not supported by this daemon", r.Method, r.URL.Path)))
+	})
+	h.mux.MethodNotAllowed(func(w http.ResponseWriter, r *http.Request) {
+		render.Render(w, r, res.Err(
+			fmt.Sprintf("%s %s is not supported by this daemon", r.Method, r.URL.Path),
+			http.StatusMethodNotAllowed))
+	})
 
 	// Register all user-related routes that managed by the permissions handler
 	h.mux.Route("/user", func(r chi.Router) {
@@ -120,8 +162,56 @@ func (h *PermissionsHandler) Close() error {
 	return h.users.Close()
 }
 
-// nolint: gocyclo
+// ExportUsers writes all registered users to w, for use with ImportUsers
+func (h *PermissionsHandler) ExportUsers(w io.Writer) error {
+	return h.users.Export(w)
+}
+
+// ImportUsers registers users written by ExportUsers, overwriting existing
+// users with the same name, and returns the number of users imported
+func (h *PermissionsHandler) ImportUsers(r io.Reader) (int, error) {
+	return h.users.Import(r)
+}
+
+// CheckUsers returns an error if ImportUsers would refuse the users written to r
+func CheckUsers(r io.Reader) error {
+	_, err := decodeUsers(r)
+	return err
+}
+
+// SetScrapeAccess configures who may access paths attached with
+// AttachScrapeRestrictedHandler - requests must either provide the given
+// token, or originate from one of the given IP addresses or CIDR ranges. If
+// neither is set, scrape-restricted paths are unavailable.
+func (h *PermissionsHandler) SetScrapeAccess(token string, allowed []string) error {
+	var networks = make([]*net.IPNet, 0, len(allowed))
+	for _, a := range allowed {
+		network, err := parseNetwork(a)
+		if err != nil {
+			return err
+		}
+		networks = append(networks, network)
+	}
+	h.scrapeMux.Lock()
+	h.scrapeToken = token
+	h.scrapeNetworks = networks
+	h.scrapeMux.Unlock()
+	return nil
+}
+
+// OnLoginFailure registers a function to call whenever a login attempt fails
+func (h *PermissionsHandler) OnLoginFailure(fn func()) { h.onLoginFailure = fn }
+
+// OnLogin registers a function to call whenever a user logs in
+func (h *PermissionsHandler) OnLogin(fn func(user string)) { h.onLogin = fn }
+
 func (h *PermissionsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.handler.ServeHTTP(w, r)
+}
+
+// serve checks permissions before serving the request
+// nolint: gocyclo
+func (h *PermissionsHandler) serve(w http.ResponseWriter, r *http.Request) {
 	// http.StripPrefix removes the leading slash, but in the interest of
 	// maintaining similar behaviour to stdlib handler functions, we manually
 	// add a leading "/" here instead of having users not add a leading "/" on
@@ -132,22 +222,20 @@ func (h *PermissionsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		r.URL.Path = path
 	}
 
-	// Check if this path is restricted
-	adminRestricted := false
-	for _, prefix := range h.adminPaths {
-		if strings.HasPrefix(path, prefix) {
-			adminRestricted = true
-		}
-	}
-	userRestricted := false
-	for _, prefix := range h.userPaths {
-		if strings.HasPrefix(path, prefix) {
-			userRestricted = true
+	var access = h.access(path)
+
+	// Check scraper access separately, since scrapers do not have sessions
+	if access == AccessScraper {
+		if !h.isScrapeAllowed(r) {
+			render.Render(w, r, res.ErrForbidden("scraper access not allowed"))
+			return
 		}
+		h.mux.ServeHTTP(w, r)
+		return
 	}
 
 	// Serve directly if path is public
-	if !userRestricted && !adminRestricted {
+	if access == AccessPublic {
 		h.mux.ServeHTTP(w, r)
 		return
 	}
@@ -165,8 +253,10 @@ func (h *PermissionsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	log.SetRequestUser(r, claims.User)
+
 	// Check if user has sufficient permissions for path
-	if adminRestricted {
+	if access == AccessAdmin {
 		admin, err := h.users.IsAdmin(claims.User)
 		switch {
 		case err != nil:
@@ -185,6 +275,65 @@ func (h *PermissionsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	h.mux.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// access returns the level of access required for the given path
+func (h *PermissionsHandler) access(path string) string {
+	for _, p := range h.scrapePaths {
+		if path == p {
+			return AccessScraper
+		}
+	}
+	for _, prefix := range h.adminPaths {
+		if strings.HasPrefix(path, prefix) {
+			return AccessAdmin
+		}
+	}
+	for _, prefix := range h.userPaths {
+		if strings.HasPrefix(path, prefix) {
+			return AccessUser
+		}
+	}
+	return AccessPublic
+}
+
+// Route describes a path served by the PermissionsHandler
+type Route struct {
+	// Method is "*" if the path is served for any method
+	Method string
+	Path   string
+	Access string
+}
+
+// Routes lists every path served by the PermissionsHandler, and the level of
+// access required for each
+func (h *PermissionsHandler) Routes() []Route {
+	var routes []Route
+	var walk func(prefix string, r chi.Routes)
+	walk = func(prefix string, r chi.Routes) {
+		for _, route := range r.Routes() {
+			var path = strings.TrimSuffix(prefix+route.Pattern, "/*")
+			if route.SubRoutes != nil {
+				walk(path, route.SubRoutes)
+				continue
+			}
+			if _, all := route.Handlers["*"]; all {
+				routes = append(routes, Route{"*", path, h.access(path)})
+				continue
+			}
+			for method := range route.Handlers {
+				routes = append(routes, Route{method, path, h.access(path)})
+			}
+		}
+	}
+	walk("", h.mux)
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].Path == routes[j].Path {
+			return routes[i].Method < routes[j].Method
+		}
+		return routes[i].Path < routes[j].Path
+	})
+	return routes
+}
+
 // AttachPublicHandler attaches given path and handler and makes it publicly available
 func (h *PermissionsHandler) AttachPublicHandler(path string, handler http.Handler) {
 	h.mux.Handle(path, handler)
@@ -219,6 +368,67 @@ func (h *PermissionsHandler) AttachAdminRestrictedHandlerFunc(
 	h.register(path, handler, methods)
 }
 
+// AttachScrapeRestrictedHandler attaches and restricts given path and handler
+// to metrics scrapers - see SetScrapeAccess.
+func (h *PermissionsHandler) AttachScrapeRestrictedHandler(path string, handler http.Handler) {
+	h.scrapePaths = append(h.scrapePaths, path)
+	h.mux.Method(http.MethodGet, path, handler)
+}
+
+// isScrapeAllowed checks if the request has the scrape token or comes from an
+// allowed address
+func (h *PermissionsHandler) isScrapeAllowed(r *http.Request) bool {
+	h.scrapeMux.RLock()
+	defer h.scrapeMux.RUnlock()
+	if h.scrapeToken != "" {
+		var bearer = strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(bearer), []byte(h.scrapeToken)) == 1 {
+			return true
+		}
+	}
+	if len(h.scrapeNetworks) > 0 {
+		host, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			host = r.RemoteAddr
+		}
+		if ip := net.ParseIP(host); ip != nil {
+			for _, n := range h.scrapeNetworks {
+				if n.Contains(ip) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// parseNetwork parses an IP address or CIDR range
+func parseNetwork(s string) (*net.IPNet, error) {
+	if strings.Contains(s, "/") {
+		_, network, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid network '%s': %s", s, err.Error())
+		}
+		return network, nil
+	}
+	var ip = net.ParseIP(s)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid address '%s'", s)
+	}
+	var bits = 8 * net.IPv6len
+	if v4 := ip.To4(); v4 != nil {
+		ip, bits = v4, 8*net.IPv4len
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+}
+
+// loginFailed records a failed login attempt
+func (h *PermissionsHandler) loginFailed() {
+	if h.onLoginFailure != nil {
+		h.onLoginFailure()
+	}
+}
+
 func (h *PermissionsHandler) register(path string, handler http.HandlerFunc, methods []string) {
 	if len(methods) == 0 {
 		h.mux.HandleFunc(path, handler)
@@ -384,6 +594,7 @@ func (h *PermissionsHandler) loginHandler(w http.ResponseWriter, r *http.Request
 		render.Render(w, r, res.ErrBadRequest(err.Error()))
 		return
 	}
+	log.SetRequestUser(r, userReq.Username)
 
 	// Check the password is correct
 	props, correct, err := h.users.IsCorrectCredentials(
@@ -393,6 +604,7 @@ func (h *PermissionsHandler) loginHandler(w http.ResponseWriter, r *http.Request
 		render.Render(w, r, res.ErrBadRequest(err.Error()))
 		return
 	case !correct || err == errUserNotFound:
+		h.loginFailed()
 		render.Render(w, r, res.ErrUnauthorized("invalid credentials provided"))
 		return
 	case err != nil:
@@ -422,17 +634,23 @@ func (h *PermissionsHandler) loginHandler(w http.ResponseWriter, r *http.Request
 				render.Render(w, r, res.ErrInternalServer("unable to verify TOTP", err))
 				return
 			} else if !validBackup {
+				h.loginFailed()
 				render.Render(w, r, res.ErrUnauthorized("invalid credentials provided"))
 				return
 			}
 		}
 	}
 
-	_, token, err := h.sessions.BeginSession(userReq.Username, props.Admin)
+	claims, token, err := h.sessions.BeginSession(userReq.Username, props.Admin)
 	if err != nil {
 		render.Render(w, r, res.ErrInternalServer("failed to create session", err))
 		return
 	}
+	h.sessions.SetCookie(w, claims, token)
+
+	if h.onLogin != nil {
+		h.onLogin(userReq.Username)
+	}
 
 	render.Render(w, r, res.MsgOK("session created",
 		"token", token))
@@ -444,12 +662,21 @@ func (h *PermissionsHandler) logoutHandler(w http.ResponseWriter, r *http.Reques
 		render.Render(w, r, res.ErrInternalServer("failed to end session", err))
 		return
 	}
+	h.sessions.ClearCookie(w)
 
 	render.Render(w, r, res.MsgOK("session ended"))
 }
 
 func (h *PermissionsHandler) validateHandler(w http.ResponseWriter, r *http.Request) {
-	render.Render(w, r, res.MsgOK("hi there!"))
+	username := r.Context().Value(ctxUsername).(string)
+	admin, err := h.users.IsAdmin(username)
+	if err != nil {
+		render.Render(w, r, res.ErrInternalServer("failed to check admin status", err))
+		return
+	}
+	render.Render(w, r, res.MsgOK("hi there!",
+		"user", username,
+		"admin", admin))
 }
 
 func readCredentials(r *http.Request) (api.UserRequest, error) {
@@ -14,9 +14,13 @@ import (
 	"time"
 
 	"github.com/pquerna/otp/totp"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/ubclaunchpad/inertia/api"
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/crypto"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/log"
 )
 
 func getTokenFromResponse(body io.ReadCloser) (token string) {
@@ -30,9 +34,10 @@ func getTestPermissionsHandler(dir string) (*PermissionsHandler, error) {
 	if err != nil {
 		return nil, err
 	}
+	logger, _ := test.NewNullLogger()
 	return NewPermissionsHandler(
 		path.Join(dir, "users.db"),
-		"127.0.0.1", 3000,
+		"127.0.0.1", 3000, logrus.NewEntry(logger),
 		crypto.GetFakeAPIKey,
 	)
 }
@@ -157,6 +162,89 @@ func TestServeHTTPWithUserLoginAndLogout(t *testing.T) {
 	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
 }
 
+func TestServeHTTPWithSessionCookie(t *testing.T) {
+	dir := "./test_perm_cookie"
+	ts := httptest.NewServer(nil)
+	defer ts.Close()
+
+	// Set up permission handler
+	ph, err := getTestPermissionsHandler(dir)
+	defer os.RemoveAll(dir)
+	assert.NoError(t, err)
+	defer ph.Close()
+	ts.Config.Handler = ph
+
+	// Register user
+	err = ph.users.AddUser("bobheadxi", "wowgreat", true)
+	assert.NoError(t, err)
+
+	// Login in as user, and get session cookie
+	user := &api.UserRequest{Username: "bobheadxi", Password: "wowgreat"}
+	body, err := json.Marshal(user)
+	assert.NoError(t, err)
+	loginResp, err := http.Post(ts.URL+"/user/login", "application/json", bytes.NewReader(body))
+	assert.NoError(t, err)
+	defer loginResp.Body.Close()
+	assert.Equal(t, http.StatusOK, loginResp.StatusCode)
+	var cookie *http.Cookie
+	for _, c := range loginResp.Cookies() {
+		if c.Name == sessionCookie {
+			cookie = c
+		}
+	}
+	require.NotNil(t, cookie)
+	assert.True(t, cookie.HttpOnly)
+	assert.True(t, cookie.Secure)
+	assert.Equal(t, http.SameSiteStrictMode, cookie.SameSite)
+	assert.Equal(t, getTokenFromResponse(loginResp.Body), cookie.Value)
+
+	// Attempt to validate with cookie
+	req, err := http.NewRequest("GET", ts.URL+"/user/validate", nil)
+	assert.NoError(t, err)
+	req.AddCookie(cookie)
+	req.Header.Set("Origin", ts.URL)
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var (
+		username string
+		admin    bool
+	)
+	_, err = api.Unmarshal(resp.Body, api.KV{Key: "user", Value: &username},
+		api.KV{Key: "admin", Value: &admin})
+	assert.NoError(t, err)
+	assert.Equal(t, "bobheadxi", username)
+	assert.True(t, admin)
+
+	// Cookies are rejected from other origins
+	req.Header.Set("Origin", "https://example.com")
+	resp, err = http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	// Log out, which clears the cookie
+	req, err = http.NewRequest("POST", ts.URL+"/user/logout", nil)
+	assert.NoError(t, err)
+	req.AddCookie(cookie)
+	logoutResp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	defer logoutResp.Body.Close()
+	assert.Equal(t, http.StatusOK, logoutResp.StatusCode)
+	require.Len(t, logoutResp.Cookies(), 1)
+	assert.Equal(t, "", logoutResp.Cookies()[0].Value)
+
+	// Attempt to validate again
+	req, err = http.NewRequest("GET", ts.URL+"/user/validate", nil)
+	assert.NoError(t, err)
+	req.AddCookie(cookie)
+	resp, err = http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
 func TestServeHTTPWithUserLoginAndAccept(t *testing.T) {
 	dir := "./test_perm_loginaccept"
 	ts := httptest.NewServer(nil)
@@ -223,6 +311,47 @@ func TestServeHTTPWithUserLoginAndAccept(t *testing.T) {
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 }
 
+func TestServeHTTPLogsRequests(t *testing.T) {
+	dir, err := ioutil.TempDir("", "test_perm_logs")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// Set up permission handler
+	var logs bytes.Buffer
+	ph, err := NewPermissionsHandler(path.Join(dir, "users.db"), "127.0.0.1", 3000,
+		log.NewLogger(&logs, logrus.InfoLevel, &logrus.TextFormatter{}), crypto.GetFakeAPIKey)
+	assert.NoError(t, err)
+	defer ph.Close()
+	ph.AttachUserRestrictedHandlerFunc("/test", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}), http.MethodPost)
+	assert.NoError(t, ph.users.AddUser("bobheadxi", "wowgreat", false))
+
+	// Rejected requests should be logged
+	ph.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("POST", "/test", nil))
+	assert.Contains(t, logs.String(), "level=warning")
+	assert.Contains(t, logs.String(), "method=POST path=/test")
+	assert.Contains(t, logs.String(), "status=401")
+
+	// Log in
+	body, err := json.Marshal(&api.UserRequest{Username: "bobheadxi", Password: "wowgreat"})
+	assert.NoError(t, err)
+	var login = httptest.NewRecorder()
+	ph.ServeHTTP(login, httptest.NewRequest("POST", "/user/login", bytes.NewReader(body)))
+	assert.Equal(t, http.StatusOK, login.Code)
+	token := getTokenFromResponse(ioutil.NopCloser(login.Body))
+
+	// Accepted requests should be logged with the user
+	logs.Reset()
+	req := httptest.NewRequest("POST", "/test", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	ph.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Contains(t, logs.String(), "level=info")
+	assert.Contains(t, logs.String(), "method=POST path=/test")
+	assert.Contains(t, logs.String(), "user=bobheadxi")
+	assert.Contains(t, logs.String(), "status=200")
+}
+
 func TestServeHTTPDenyNonAdmin(t *testing.T) {
 	dir := "./test_perm_denynonadmin"
 	ts := httptest.NewServer(nil)
@@ -515,7 +644,8 @@ func TestPermissionsHandler_loginHandler(t *testing.T) {
 		body   interface{}
 	}
 	type want struct {
-		status int
+		status   int
+		failures int
 	}
 	tests := []struct {
 		name   string
@@ -523,15 +653,20 @@ func TestPermissionsHandler_loginHandler(t *testing.T) {
 		args   args
 		want   want
 	}{
-		{"missing body", fields{}, args{"POST", "/", nil}, want{http.StatusBadRequest}},
+		{"missing body", fields{}, args{"POST", "/", nil}, want{http.StatusBadRequest, 0}},
 		{"invalid user", fields{}, args{"POST", "/", api.UserRequest{
 			Username: "bobhead", Password: "lunchpad",
-		}}, want{http.StatusUnauthorized}},
+		}}, want{http.StatusUnauthorized, 1}},
 		{"valid user, wrong creds", fields{api.UserRequest{
 			Username: "bobhead", Password: "breakfastpad",
 		}}, args{"POST", "/", api.UserRequest{
 			Username: "bobhead", Password: "lunchpad",
-		}}, want{http.StatusUnauthorized}},
+		}}, want{http.StatusUnauthorized, 1}},
+		{"valid user, correct creds", fields{api.UserRequest{
+			Username: "bobhead", Password: "lunchpad",
+		}}, args{"POST", "/", api.UserRequest{
+			Username: "bobhead", Password: "lunchpad",
+		}}, want{http.StatusOK, 0}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -546,6 +681,10 @@ func TestPermissionsHandler_loginHandler(t *testing.T) {
 			var testUser = tt.fields.user
 			ph.users.AddUser(testUser.Username, testUser.Password, testUser.Admin)
 			// todo: test totp situations?
+			var failures int
+			ph.OnLoginFailure(func() { failures++ })
+			var loggedIn []string
+			ph.OnLogin(func(user string) { loggedIn = append(loggedIn, user) })
 
 			// test handler
 			var (
@@ -560,6 +699,110 @@ func TestPermissionsHandler_loginHandler(t *testing.T) {
 				t.Logf("Received response: '%s'", rec.Body.String())
 				t.Errorf("expected status '%d', got '%d'", tt.want.status, rec.Code)
 			}
+			assert.Equal(t, tt.want.failures, failures)
+			if rec.Code == http.StatusOK {
+				assert.Equal(t, []string{testUser.Username}, loggedIn)
+			} else {
+				assert.Empty(t, loggedIn)
+			}
 		})
 	}
 }
+
+func TestServeHTTPScrapeRestricted(t *testing.T) {
+	dir := "./test_perm_scrape"
+	ph, err := getTestPermissionsHandler(dir)
+	defer os.RemoveAll(dir)
+	assert.NoError(t, err)
+	defer ph.Close()
+	ph.AttachScrapeRestrictedHandler("/metrics", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	var scrapeWithHeaders = func(remoteAddr, token string, headers map[string]string) int {
+		var req = httptest.NewRequest("GET", "/metrics", nil)
+		req.RemoteAddr = remoteAddr
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		var rec = httptest.NewRecorder()
+		ph.ServeHTTP(rec, req)
+		return rec.Code
+	}
+	var scrape = func(remoteAddr, token string) int {
+		return scrapeWithHeaders(remoteAddr, token, nil)
+	}
+
+	// no access configured
+	assert.Equal(t, http.StatusForbidden, scrape("10.0.0.5:1234", ""))
+
+	// invalid configuration
+	assert.Error(t, ph.SetScrapeAccess("", []string{"10.0.0.0/33"}))
+	assert.Error(t, ph.SetScrapeAccess("", []string{"not-an-ip"}))
+
+	// token or allowlist
+	assert.NoError(t, ph.SetScrapeAccess("scrapetoken", []string{"10.0.0.0/24", "192.168.1.10"}))
+	assert.Equal(t, http.StatusOK, scrape("10.0.0.5:1234", ""))
+	assert.Equal(t, http.StatusOK, scrape("192.168.1.10:1234", ""))
+	assert.Equal(t, http.StatusForbidden, scrape("192.168.1.11:1234", ""))
+	assert.Equal(t, http.StatusOK, scrape("192.168.1.11:1234", "scrapetoken"))
+	assert.Equal(t, http.StatusForbidden, scrape("192.168.1.11:1234", "wrongtoken"))
+
+	// forwarding headers cannot be used to spoof an allowed address
+	assert.Equal(t, http.StatusForbidden, scrapeWithHeaders("203.0.113.9:1234", "",
+		map[string]string{"X-Forwarded-For": "10.0.0.5"}))
+	assert.Equal(t, http.StatusForbidden, scrapeWithHeaders("203.0.113.9:1234", "",
+		map[string]string{"X-Real-IP": "10.0.0.5"}))
+
+	// user sessions do not grant access
+	_, token, err := ph.sessions.BeginSession("bob", true)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, scrape("192.168.1.11:1234", token))
+}
+
+func TestPermissionsHandler_Routes(t *testing.T) {
+	dir := "./test_perm_routes"
+	ph, err := getTestPermissionsHandler(dir)
+	defer os.RemoveAll(dir)
+	assert.NoError(t, err)
+	defer ph.Close()
+
+	var noop = func(w http.ResponseWriter, r *http.Request) {}
+	ph.AttachPublicHandlerFunc("/", noop)
+	ph.AttachUserRestrictedHandlerFunc("/status", noop, http.MethodGet)
+	ph.AttachAdminRestrictedHandlerFunc("/env", noop, http.MethodGet, http.MethodPost)
+	ph.AttachScrapeRestrictedHandler("/metrics", http.HandlerFunc(noop))
+
+	var routes = ph.Routes()
+	for _, want := range []Route{
+		{"*", "/", AccessPublic},
+		{"GET", "/status", AccessUser},
+		{"GET", "/env", AccessAdmin},
+		{"POST", "/env", AccessAdmin},
+		{"GET", "/metrics", AccessScraper},
+		{"POST", "/user/login", AccessPublic},
+		{"GET", "/user/validate", AccessUser},
+		{"POST", "/user/totp/enable", AccessUser},
+		{"POST", "/user/add", AccessAdmin},
+	} {
+		assert.Contains(t, routes, want)
+	}
+}
+
+func TestServeHTTPNotFound(t *testing.T) {
+	dir := "./test_perm_notfound"
+	ph, err := getTestPermissionsHandler(dir)
+	defer os.RemoveAll(dir)
+	assert.NoError(t, err)
+	defer ph.Close()
+
+	var recorder = httptest.NewRecorder()
+	ph.ServeHTTP(recorder, httptest.NewRequest("GET", "/nope", nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	b, err := api.Unmarshal(recorder.Body)
+	assert.NoError(t, err)
+	assert.Contains(t, b.Message, "GET /nope is not supported")
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 	"sync"
 	"time"
@@ -13,9 +14,14 @@ import (
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/crypto"
 )
 
+// sessionCookie is the cookie that holds session tokens for browser clients,
+// such as the dashboard
+const sessionCookie = "inertia_session"
+
 var (
 	errSessionNotFound = errors.New("session not found")
 	errMalformedHeader = errors.New("authorization is malformed")
+	errCrossOrigin     = errors.New("session cookies are not accepted from other origins")
 )
 
 type sessionManager struct {
@@ -121,14 +127,28 @@ func (s *sessionManager) EndSession(r *http.Request) error {
 // GetSession verifies if given request is from a valid session and returns it
 func (s *sessionManager) GetSession(r *http.Request) (*crypto.TokenClaims, error) {
 	// Check token in header - if no tokens, check cookie
-	bearerString := r.Header.Get("Authorization")
-	splitToken := strings.Split(bearerString, "Bearer ")
-	if len(splitToken) != 2 {
+	var token string
+	if bearerString := r.Header.Get("Authorization"); bearerString != "" {
+		splitToken := strings.Split(bearerString, "Bearer ")
+		if len(splitToken) != 2 {
+			return nil, errMalformedHeader
+		}
+		token = splitToken[1]
+	} else if cookie, err := r.Cookie(sessionCookie); err == nil {
+		// Browsers don't send SameSite cookies with cross-site requests, but
+		// reject requests from other origins in case the browser is outdated
+		if origin := r.Header.Get("Origin"); origin != "" {
+			if u, err := url.Parse(origin); err != nil || u.Host != r.Host {
+				return nil, errCrossOrigin
+			}
+		}
+		token = cookie.Value
+	} else {
 		return nil, errMalformedHeader
 	}
 
 	// Validate token and get claims
-	claims, err := crypto.ValidateToken(splitToken[1], s.keyLookup)
+	claims, err := crypto.ValidateToken(token, s.keyLookup)
 	if err != nil {
 		return nil, err
 	}
@@ -149,6 +169,32 @@ func (s *sessionManager) GetSession(r *http.Request) (*crypto.TokenClaims, error
 	return claims, nil
 }
 
+// SetCookie stores the token of the given session in a cookie, so that
+// browser clients are authenticated without handling the token themselves
+func (s *sessionManager) SetCookie(w http.ResponseWriter, claims *crypto.TokenClaims, token string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookie,
+		Value:    token,
+		Path:     "/",
+		Expires:  claims.Expiry,
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+	})
+}
+
+// ClearCookie removes the session cookie from browser clients
+func (s *sessionManager) ClearCookie(w http.ResponseWriter) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookie,
+		Path:     "/",
+		MaxAge:   -1,
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+	})
+}
+
 // endAllUserSessions removes all active sessions with given user
 func (s *sessionManager) EndAllUserSessions(username string) {
 	for id, claim := range s.internal {
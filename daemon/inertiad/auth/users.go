@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/crypto"
 	bolt "go.etcd.io/bbolt"
@@ -414,3 +415,64 @@ func (m *userManager) RemoveBackupCode(username, backupCode string) error {
 		return errUserNotFound
 	})
 }
+
+// Export writes all users except the master user to w, so that they can be
+// loaded into another user manager using Import
+func (m *userManager) Export(w io.Writer) error {
+	var users = make(map[string]userProps)
+	if err := m.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(m.usersBucket).ForEach(func(username, v []byte) error {
+			if string(username) == masterKey {
+				return nil
+			}
+			var props userProps
+			if err := json.Unmarshal(v, &props); err != nil {
+				return fmt.Errorf("corrupt properties for user '%s': %s", string(username), err.Error())
+			}
+			props.LoginAttempts = 0
+			users[string(username)] = props
+			return nil
+		})
+	}); err != nil {
+		return err
+	}
+	return json.NewEncoder(w).Encode(users)
+}
+
+// Import loads users written by Export, overwriting existing users with the
+// same name, and returns the number of users imported. Users are validated
+// before any are stored.
+func (m *userManager) Import(r io.Reader) (int, error) {
+	users, err := decodeUsers(r)
+	if err != nil {
+		return 0, err
+	}
+	return len(users), m.db.Update(func(tx *bolt.Tx) error {
+		var bucket = tx.Bucket(m.usersBucket)
+		for username, props := range users {
+			bytes, err := json.Marshal(props)
+			if err != nil {
+				return err
+			}
+			if err := bucket.Put([]byte(username), bytes); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// decodeUsers reads and validates users written by Export
+func decodeUsers(r io.Reader) (map[string]userProps, error) {
+	var users map[string]userProps
+	if err := json.NewDecoder(r).Decode(&users); err != nil {
+		return nil, fmt.Errorf("failed to decode users: %s", err.Error())
+	}
+	delete(users, masterKey)
+	for username := range users {
+		if len(username) < 3 || len(username) >= 128 || !crypto.IsLegalString(username) {
+			return nil, fmt.Errorf("invalid username '%s'", username)
+		}
+	}
+	return users, nil
+}
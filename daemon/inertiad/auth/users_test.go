@@ -1,8 +1,10 @@
 package auth
 
 import (
+	"bytes"
 	"os"
 	"path"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -178,3 +180,42 @@ func TestRemoveBackupCode(t *testing.T) {
 	err = manager.RemoveBackupCode("bobheadxi", backupCodes[0])
 	assert.NotNil(t, err)
 }
+
+func TestExportImportUsers(t *testing.T) {
+	dir := "./test_users_export"
+	manager, err := getTestUserManager(dir)
+	defer os.RemoveAll(dir)
+	assert.NoError(t, err)
+	defer manager.Close()
+	assert.NoError(t, manager.AddUser("bobheadxi", "best_person_ever", true))
+	assert.NoError(t, manager.AddUser("whoisthat", "ummmmmmmmmm", false))
+	_, _, err = manager.EnableTotp("whoisthat")
+	assert.NoError(t, err)
+
+	var buf bytes.Buffer
+	assert.NoError(t, manager.Export(&buf))
+
+	dir2 := "./test_users_import"
+	imported, err := getTestUserManager(dir2)
+	defer os.RemoveAll(dir2)
+	assert.NoError(t, err)
+	defer imported.Close()
+	count, err := imported.Import(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	_, correct, err := imported.IsCorrectCredentials("bobheadxi", "best_person_ever")
+	assert.NoError(t, err)
+	assert.True(t, correct)
+	admin, err := imported.IsAdmin("bobheadxi")
+	assert.NoError(t, err)
+	assert.True(t, admin)
+	totp, err := imported.IsTotpEnabled("whoisthat")
+	assert.NoError(t, err)
+	assert.True(t, totp)
+	assert.Len(t, imported.UserList(), 3)
+
+	// invalid usernames are rejected
+	_, err = imported.Import(strings.NewReader(`{"a b": {}}`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,151 @@
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Archive writes the contents of the given directory to w as a gzipped tarball.
+// A missing directory is archived as an empty one.
+func Archive(dir string, w io.Writer) error {
+	var (
+		gw = gzip.NewWriter(w)
+		tw = tar.NewWriter(gw)
+	)
+	if err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if path == dir && os.IsNotExist(err) {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if path == dir {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		var link string
+		if info.Mode()&os.ModeSymlink != 0 {
+			if link, err = os.Readlink(path); err != nil {
+				return err
+			}
+		}
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(rel)
+		if info.IsDir() {
+			header.Name += "/"
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	}); err != nil {
+		return err
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+// Extract unpacks a gzipped tarball created by Archive into the given directory.
+// Entries that would be written outside of the directory are rejected.
+func Extract(r io.Reader, dir string) error {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("invalid archive: %s", err.Error())
+	}
+	defer gr.Close()
+
+	var tr = tar.NewReader(gr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("invalid archive: %s", err.Error())
+		}
+
+		target, err := extractPath(dir, header.Name)
+		if err != nil {
+			return err
+		}
+		var mode = os.FileMode(header.Mode).Perm()
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, mode|0700); err != nil {
+				return err
+			}
+
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(f, tr)
+			f.Close()
+			if err != nil {
+				return err
+			}
+
+		case tar.TypeSymlink:
+			// only allow links that stay within the directory
+			var linkTarget = header.Linkname
+			if !filepath.IsAbs(linkTarget) {
+				linkTarget = filepath.Join(filepath.Dir(target), linkTarget)
+			}
+			if !within(dir, linkTarget) {
+				return fmt.Errorf("invalid archive: link '%s' points outside of archive", header.Name)
+			}
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			if err := os.Symlink(header.Linkname, target); err != nil {
+				return err
+			}
+
+		default:
+			// other file types are not supported, and are skipped
+		}
+	}
+}
+
+// extractPath returns the path an archive entry should be extracted to
+func extractPath(dir, name string) (string, error) {
+	var target = filepath.Join(dir, filepath.FromSlash(name))
+	if filepath.IsAbs(filepath.FromSlash(name)) || !within(dir, target) {
+		return "", fmt.Errorf("invalid archive: entry '%s' is outside of archive", name)
+	}
+	return target, nil
+}
+
+// within checks if path is within dir
+func within(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
@@ -0,0 +1,79 @@
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveExtract(t *testing.T) {
+	src, err := ioutil.TempDir("", "inertia-backup-src")
+	require.NoError(t, err)
+	defer os.RemoveAll(src)
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "db", "nested"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(src, "top.txt"), []byte("top"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(src, "db", "nested", "data"), []byte("data"), 0600))
+	require.NoError(t, os.Symlink("nested/data", filepath.Join(src, "db", "link")))
+
+	var buf bytes.Buffer
+	require.NoError(t, Archive(src, &buf))
+
+	dst, err := ioutil.TempDir("", "inertia-backup-dst")
+	require.NoError(t, err)
+	defer os.RemoveAll(dst)
+	require.NoError(t, Extract(&buf, dst))
+
+	b, err := ioutil.ReadFile(filepath.Join(dst, "top.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "top", string(b))
+	info, err := os.Stat(filepath.Join(dst, "db", "nested", "data"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	b, err = ioutil.ReadFile(filepath.Join(dst, "db", "link"))
+	require.NoError(t, err)
+	assert.Equal(t, "data", string(b))
+
+	t.Run("missing directory", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, Archive(filepath.Join(src, "does-not-exist"), &buf))
+		assert.NoError(t, Extract(&buf, dst))
+	})
+}
+
+func TestExtract_unsafe(t *testing.T) {
+	var archive = func(header *tar.Header) *bytes.Buffer {
+		var buf bytes.Buffer
+		gw := gzip.NewWriter(&buf)
+		tw := tar.NewWriter(gw)
+		require.NoError(t, tw.WriteHeader(header))
+		require.NoError(t, tw.Close())
+		require.NoError(t, gw.Close())
+		return &buf
+	}
+
+	dst, err := ioutil.TempDir("", "inertia-backup-dst")
+	require.NoError(t, err)
+	defer os.RemoveAll(dst)
+
+	var tests = []struct {
+		name   string
+		header *tar.Header
+	}{
+		{"relative path", &tar.Header{Name: "../escape", Typeflag: tar.TypeReg, Mode: 0644}},
+		{"absolute path", &tar.Header{Name: "/escape", Typeflag: tar.TypeReg, Mode: 0644}},
+		{"relative link", &tar.Header{Name: "link", Linkname: "../../etc", Typeflag: tar.TypeSymlink}},
+		{"absolute link", &tar.Header{Name: "link", Linkname: "/etc", Typeflag: tar.TypeSymlink}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Error(t, Extract(archive(tt.header), dst))
+		})
+	}
+}
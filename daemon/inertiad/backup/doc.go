@@ -0,0 +1,2 @@
+// Package backup implements snapshots of project persistent data
+package backup
@@ -0,0 +1,440 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/common"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/crypto"
+)
+
+const (
+	// backupTimeFormat has millisecond precision, so that backups created in
+	// quick succession have different names
+	backupTimeFormat = "20060102T150405.000Z"
+
+	backupExtension    = ".tar.gz"
+	encryptedExtension = ".enc"
+)
+
+// backupNamePattern matches names of backups created by the Manager
+var backupNamePattern = regexp.MustCompile(`^persist-(\d{8}T\d{6}\.\d{3}Z)\.tar\.gz(\.enc)?$`)
+
+// Manager creates and restores backups of a directory
+type Manager struct {
+	dir      string
+	localDir string
+	out      io.Writer
+
+	// mux guards configuration
+	mux      sync.RWMutex
+	conf     api.BackupConfiguration
+	store    Store
+	keep     int
+	maxAge   time.Duration
+	schedule context.CancelFunc
+
+	// op serializes backup operations
+	op sync.Mutex
+	// lastCreated is when the most recent backup was created, guarded by op
+	lastCreated time.Time
+
+	now func() time.Time
+}
+
+// NewManager creates a manager for backups of dir. By default, backups are
+// unencrypted, stored in localDir, and only created on demand. Scheduled backup
+// activity is logged to out.
+func NewManager(dir, localDir string, out io.Writer) *Manager {
+	if out == nil {
+		out = ioutil.Discard
+	}
+	return &Manager{
+		dir:      dir,
+		localDir: localDir,
+		out:      out,
+		conf:     api.BackupConfiguration{Storage: api.BackupStorageLocal},
+		store:    NewLocalStore(localDir),
+		now:      time.Now,
+	}
+}
+
+// Configure validates and applies the given configuration, restarting the backup
+// schedule if there is one
+func (m *Manager) Configure(conf api.BackupConfiguration) error {
+	var (
+		store    Store
+		err      error
+		schedule time.Duration
+		maxAge   time.Duration
+	)
+	switch conf.Storage {
+	case "", api.BackupStorageLocal:
+		conf.Storage = api.BackupStorageLocal
+		store = NewLocalStore(m.localDir)
+	case api.BackupStorageS3:
+		if conf.S3 == nil {
+			return errors.New("s3 configuration is required for s3 storage")
+		}
+		if store, err = NewS3Store(*conf.S3); err != nil {
+			return fmt.Errorf("invalid s3 configuration: %s", err.Error())
+		}
+	default:
+		return fmt.Errorf("unknown storage '%s'", conf.Storage)
+	}
+	if conf.Schedule != "" {
+		if schedule, err = time.ParseDuration(conf.Schedule); err != nil || schedule < time.Minute {
+			return fmt.Errorf("invalid schedule '%s': must be a duration of at least 1m", conf.Schedule)
+		}
+	}
+	if conf.MaxAge != "" {
+		if maxAge, err = time.ParseDuration(conf.MaxAge); err != nil || maxAge <= 0 {
+			return fmt.Errorf("invalid max age '%s'", conf.MaxAge)
+		}
+	}
+	if conf.Keep < 0 {
+		return errors.New("number of backups to keep cannot be negative")
+	}
+
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	m.conf = conf
+	m.store = store
+	m.keep = conf.Keep
+	m.maxAge = maxAge
+	if m.schedule != nil {
+		m.schedule()
+		m.schedule = nil
+	}
+	if schedule > 0 {
+		ctx, cancel := context.WithCancel(context.Background())
+		m.schedule = cancel
+		go m.runSchedule(ctx, schedule)
+	}
+	return nil
+}
+
+// GetConfiguration returns the current configuration
+func (m *Manager) GetConfiguration() api.BackupConfiguration {
+	m.mux.RLock()
+	defer m.mux.RUnlock()
+	return m.conf
+}
+
+// HasData reports whether there is anything in the directory to back up
+func (m *Manager) HasData() bool {
+	entries, err := ioutil.ReadDir(m.dir)
+	return err == nil && len(entries) > 0
+}
+
+// Close stops scheduled backups
+func (m *Manager) Close() {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	if m.schedule != nil {
+		m.schedule()
+		m.schedule = nil
+	}
+}
+
+func (m *Manager) runSchedule(ctx context.Context, interval time.Duration) {
+	var ticker = time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			b, err := m.Create(ctx)
+			if err != nil {
+				fmt.Fprintf(m.out, "scheduled backup failed: %s\n", err.Error())
+			} else {
+				fmt.Fprintf(m.out, "scheduled backup '%s' created\n", b.Name)
+			}
+		}
+	}
+}
+
+// Create snapshots the directory, stores it, and then applies the retention
+// policy
+func (m *Manager) Create(ctx context.Context) (*api.Backup, error) {
+	m.op.Lock()
+	defer m.op.Unlock()
+	m.mux.RLock()
+	var (
+		store      = m.store
+		passphrase = m.conf.Passphrase
+	)
+	m.mux.RUnlock()
+
+	// stage backup in a temporary file, since stores need to know its size
+	if err := os.MkdirAll(m.localDir, 0700); err != nil {
+		return nil, err
+	}
+	tmp, err := ioutil.TempFile(m.localDir, ".staged-*")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { tmp.Close(); os.Remove(tmp.Name()) }()
+
+	// never reuse the name of the previous backup
+	var created = m.now().UTC().Truncate(time.Millisecond)
+	if !created.After(m.lastCreated) {
+		created = m.lastCreated.Add(time.Millisecond)
+	}
+	m.lastCreated = created
+	var backup = &api.Backup{
+		CreatedAt: created,
+		Encrypted: passphrase != "",
+	}
+	backup.Name = backupName(backup.CreatedAt, backup.Encrypted)
+	if backup.Encrypted {
+		var salt = crypto.GenerateSalt()
+		if _, err := tmp.Write(salt); err != nil {
+			return nil, err
+		}
+		w, err := crypto.NewEncryptWriter(crypto.DeriveKey(passphrase, salt), tmp)
+		if err != nil {
+			return nil, err
+		}
+		if err := Archive(m.dir, w); err != nil {
+			return nil, fmt.Errorf("failed to archive data: %s", err.Error())
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+	} else if err := Archive(m.dir, tmp); err != nil {
+		return nil, fmt.Errorf("failed to archive data: %s", err.Error())
+	}
+
+	if backup.Size, err = tmp.Seek(0, io.SeekCurrent); err != nil {
+		return nil, err
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	if err := store.Put(ctx, backup.Name, tmp); err != nil {
+		return nil, fmt.Errorf("failed to store backup: %s", err.Error())
+	}
+
+	if err := m.prune(ctx, store); err != nil {
+		return backup, fmt.Errorf("backup '%s' created, but failed to apply retention policy: %s",
+			backup.Name, err.Error())
+	}
+	return backup, nil
+}
+
+// List returns all stored backups, newest first
+func (m *Manager) List(ctx context.Context) ([]api.Backup, error) {
+	m.mux.RLock()
+	var store = m.store
+	m.mux.RUnlock()
+	return list(ctx, store)
+}
+
+func list(ctx context.Context, store Store) ([]api.Backup, error) {
+	objects, err := store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var backups = make([]api.Backup, 0, len(objects))
+	for _, o := range objects {
+		created, encrypted, ok := parseBackupName(o.Name)
+		if !ok {
+			continue
+		}
+		backups = append(backups, api.Backup{
+			Name:      o.Name,
+			Size:      o.Size,
+			CreatedAt: created,
+			Encrypted: encrypted,
+		})
+	}
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].CreatedAt.After(backups[j].CreatedAt)
+	})
+	return backups, nil
+}
+
+// prune removes backups according to the retention policy. The most recent
+// backup is always kept.
+func (m *Manager) prune(ctx context.Context, store Store) error {
+	m.mux.RLock()
+	var keep, maxAge = m.keep, m.maxAge
+	m.mux.RUnlock()
+	if keep == 0 && maxAge == 0 {
+		return nil
+	}
+
+	backups, err := list(ctx, store)
+	if err != nil {
+		return err
+	}
+	var now = m.now()
+	for i, b := range backups {
+		if i == 0 {
+			continue
+		}
+		if (keep > 0 && i >= keep) || (maxAge > 0 && now.Sub(b.CreatedAt) > maxAge) {
+			if err := store.Delete(ctx, b.Name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Restore replaces the contents of the directory with the contents of the named
+// backup. The backup is fully extracted before any existing data is replaced.
+func (m *Manager) Restore(ctx context.Context, name string) error {
+	staged, err := m.Stage(ctx, name)
+	if err != nil {
+		return err
+	}
+	defer staged.Discard()
+	return staged.Apply()
+}
+
+// Stage retrieves, decrypts, and fully extracts the named backup without
+// touching the directory, so that callers can check that a backup can be
+// restored before stopping anything that uses the directory. The staged backup
+// must be applied with Apply or removed with Discard.
+func (m *Manager) Stage(ctx context.Context, name string) (*Staged, error) {
+	_, encrypted, ok := parseBackupName(name)
+	if !ok {
+		return nil, fmt.Errorf("invalid backup name '%s'", name)
+	}
+
+	m.op.Lock()
+	defer m.op.Unlock()
+	m.mux.RLock()
+	var (
+		store      = m.store
+		passphrase = m.conf.Passphrase
+	)
+	m.mux.RUnlock()
+	if encrypted && passphrase == "" {
+		return nil, errors.New("backup is encrypted, but no passphrase is configured")
+	}
+
+	rc, err := store.Get(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve backup: %s", err.Error())
+	}
+	defer rc.Close()
+	var r io.Reader = rc
+	if encrypted {
+		var salt = make([]byte, crypto.KeyDerivationSaltLength)
+		if _, err := io.ReadFull(rc, salt); err != nil {
+			return nil, errors.New("backup is corrupt")
+		}
+		if r, err = crypto.NewDecryptReader(crypto.DeriveKey(passphrase, salt), rc); err != nil {
+			return nil, err
+		}
+	}
+	return m.stage(r)
+}
+
+// WriteArchive writes an unencrypted archive of the directory to w, for use
+// with StageFrom
+func (m *Manager) WriteArchive(w io.Writer) error {
+	m.op.Lock()
+	defer m.op.Unlock()
+	return Archive(m.dir, w)
+}
+
+// StageFrom fully extracts the given unencrypted archive, such as one written by
+// WriteArchive, without touching the directory. The staged archive must be
+// applied with Apply or removed with Discard.
+func (m *Manager) StageFrom(r io.Reader) (*Staged, error) {
+	m.op.Lock()
+	defer m.op.Unlock()
+	return m.stage(r)
+}
+
+// stage extracts the given archive next to the directory, so that entries can
+// later be moved into place
+func (m *Manager) stage(r io.Reader) (*Staged, error) {
+	if err := os.MkdirAll(m.dir, 0755); err != nil {
+		return nil, err
+	}
+	dir, err := ioutil.TempDir(filepath.Dir(m.dir), ".restore-")
+	if err != nil {
+		return nil, err
+	}
+	if err := Extract(r, dir); err != nil {
+		os.RemoveAll(dir)
+		return nil, fmt.Errorf("failed to extract backup: %s", err.Error())
+	}
+	return &Staged{m: m, dir: dir}, nil
+}
+
+// Staged is an extracted backup that is ready to replace the contents of the
+// directory
+type Staged struct {
+	m   *Manager
+	dir string
+}
+
+// Apply replaces the contents of the directory with the staged backup
+func (s *Staged) Apply() error {
+	s.m.op.Lock()
+	defer s.m.op.Unlock()
+
+	// the directory itself is kept, since it may be mounted into containers
+	if err := common.RemoveContents(s.m.dir); err != nil {
+		return fmt.Errorf("failed to clear existing data: %s", err.Error())
+	}
+	entries, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if err := os.Rename(filepath.Join(s.dir, e.Name()), filepath.Join(s.m.dir, e.Name())); err != nil {
+			return fmt.Errorf("failed to restore '%s': %s", e.Name(), err.Error())
+		}
+	}
+	return nil
+}
+
+// Discard removes whatever remains of the staged backup
+func (s *Staged) Discard() error {
+	return os.RemoveAll(s.dir)
+}
+
+// IsValidName reports whether the given name is that of a backup created by a
+// Manager
+func IsValidName(name string) bool {
+	_, _, ok := parseBackupName(name)
+	return ok
+}
+
+func backupName(created time.Time, encrypted bool) string {
+	var name = "persist-" + created.UTC().Format(backupTimeFormat) + backupExtension
+	if encrypted {
+		name += encryptedExtension
+	}
+	return name
+}
+
+func parseBackupName(name string) (created time.Time, encrypted bool, ok bool) {
+	var match = backupNamePattern.FindStringSubmatch(name)
+	if match == nil {
+		return time.Time{}, false, false
+	}
+	created, err := time.Parse(backupTimeFormat, match[1])
+	if err != nil {
+		return time.Time{}, false, false
+	}
+	return created, match[2] != "", true
+}
@@ -0,0 +1,199 @@
+package backup
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ubclaunchpad/inertia/api"
+)
+
+func newTestManager(t *testing.T) (*Manager, string) {
+	dir, err := ioutil.TempDir("", "inertia-backup-manager")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	var persist = filepath.Join(dir, "persist")
+	require.NoError(t, os.MkdirAll(persist, 0755))
+	var m = NewManager(persist, filepath.Join(dir, "backups"), nil)
+	var now = time.Date(2020, 10, 19, 12, 0, 0, 0, time.UTC)
+	m.now = func() time.Time {
+		now = now.Add(time.Hour)
+		return now
+	}
+	return m, persist
+}
+
+func TestManager_CreateRestore(t *testing.T) {
+	for _, passphrase := range []string{"", "hunter2"} {
+		t.Run("passphrase="+passphrase, func(t *testing.T) {
+			m, persist := newTestManager(t)
+			require.NoError(t, m.Configure(api.BackupConfiguration{Passphrase: passphrase}))
+			assert.False(t, m.HasData())
+			require.NoError(t, ioutil.WriteFile(filepath.Join(persist, "data"), []byte("v1"), 0644))
+			assert.True(t, m.HasData())
+
+			b, err := m.Create(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, passphrase != "", b.Encrypted)
+			assert.NotZero(t, b.Size)
+
+			backups, err := m.List(context.Background())
+			require.NoError(t, err)
+			require.Len(t, backups, 1)
+			assert.Equal(t, b.Name, backups[0].Name)
+			assert.Equal(t, b.Size, backups[0].Size)
+
+			// modify data, then restore
+			require.NoError(t, ioutil.WriteFile(filepath.Join(persist, "data"), []byte("v2"), 0644))
+			require.NoError(t, ioutil.WriteFile(filepath.Join(persist, "new"), []byte("new"), 0644))
+			require.NoError(t, m.Restore(context.Background(), b.Name))
+			data, err := ioutil.ReadFile(filepath.Join(persist, "data"))
+			require.NoError(t, err)
+			assert.Equal(t, "v1", string(data))
+			_, err = os.Stat(filepath.Join(persist, "new"))
+			assert.True(t, os.IsNotExist(err))
+
+			if passphrase != "" {
+				// wrong passphrase should fail, and leave data untouched
+				require.NoError(t, m.Configure(api.BackupConfiguration{Passphrase: "wrong"}))
+				assert.Error(t, m.Restore(context.Background(), b.Name))
+				data, err := ioutil.ReadFile(filepath.Join(persist, "data"))
+				require.NoError(t, err)
+				assert.Equal(t, "v1", string(data))
+			}
+		})
+	}
+
+	t.Run("invalid names", func(t *testing.T) {
+		m, _ := newTestManager(t)
+		assert.Error(t, m.Restore(context.Background(), "../../etc/passwd"))
+		assert.Error(t, m.Restore(context.Background(), "persist-20201019T120000.000Z.tar.gz"))
+	})
+}
+
+func TestManager_Stage(t *testing.T) {
+	m, persist := newTestManager(t)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(persist, "data"), []byte("v1"), 0644))
+	b, err := m.Create(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(persist, "data"), []byte("v2"), 0644))
+
+	// existing data is untouched until the staged backup is applied
+	staged, err := m.Stage(context.Background(), b.Name)
+	require.NoError(t, err)
+	data, err := ioutil.ReadFile(filepath.Join(persist, "data"))
+	require.NoError(t, err)
+	assert.Equal(t, "v2", string(data))
+
+	require.NoError(t, staged.Discard())
+	_, err = os.Stat(staged.dir)
+	assert.True(t, os.IsNotExist(err))
+	data, err = ioutil.ReadFile(filepath.Join(persist, "data"))
+	require.NoError(t, err)
+	assert.Equal(t, "v2", string(data))
+}
+
+func TestManager_Retention(t *testing.T) {
+	m, _ := newTestManager(t)
+	require.NoError(t, m.Configure(api.BackupConfiguration{Keep: 3}))
+	var created []string
+	for i := 0; i < 5; i++ {
+		b, err := m.Create(context.Background())
+		require.NoError(t, err)
+		created = append(created, b.Name)
+	}
+	backups, err := m.List(context.Background())
+	require.NoError(t, err)
+	require.Len(t, backups, 3)
+	assert.Equal(t, created[4], backups[0].Name)
+	assert.Equal(t, created[2], backups[2].Name)
+
+	// backups are created an hour apart, so only the newest should survive
+	require.NoError(t, m.Configure(api.BackupConfiguration{MaxAge: "90m"}))
+	b, err := m.Create(context.Background())
+	require.NoError(t, err)
+	backups, err = m.List(context.Background())
+	require.NoError(t, err)
+	require.Len(t, backups, 1)
+	assert.Equal(t, b.Name, backups[0].Name)
+}
+
+func TestManager_CreateSameInstant(t *testing.T) {
+	m, _ := newTestManager(t)
+	var now = time.Date(2020, 10, 19, 12, 0, 0, 0, time.UTC)
+	m.now = func() time.Time { return now }
+	first, err := m.Create(context.Background())
+	require.NoError(t, err)
+	second, err := m.Create(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "persist-20201019T120000.000Z.tar.gz", first.Name)
+	assert.Equal(t, "persist-20201019T120000.001Z.tar.gz", second.Name)
+
+	backups, err := m.List(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, backups, 2)
+}
+
+func TestParseBackupName(t *testing.T) {
+	var tests = []struct {
+		name          string
+		wantCreated   time.Time
+		wantEncrypted bool
+		wantOK        bool
+	}{
+		{"persist-20201019T120000.123Z.tar.gz",
+			time.Date(2020, 10, 19, 12, 0, 0, 123e6, time.UTC), false, true},
+		{"persist-20201019T120000.123Z.tar.gz.enc",
+			time.Date(2020, 10, 19, 12, 0, 0, 123e6, time.UTC), true, true},
+		{"persist-20201019T120000Z.tar.gz", time.Time{}, false, false},
+		{"persist-20201019T120000.1Z.tar.gz", time.Time{}, false, false},
+		{"persist-latest.tar.gz", time.Time{}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			created, encrypted, ok := parseBackupName(tt.name)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.True(t, tt.wantCreated.Equal(created), "got %v", created)
+			assert.Equal(t, tt.wantEncrypted, encrypted)
+		})
+	}
+}
+
+func TestManager_Configure(t *testing.T) {
+	m, _ := newTestManager(t)
+	defer m.Close()
+	var tests = []struct {
+		name    string
+		conf    api.BackupConfiguration
+		wantErr bool
+	}{
+		{"defaults", api.BackupConfiguration{}, false},
+		{"scheduled", api.BackupConfiguration{Schedule: "24h", Keep: 7}, false},
+		{"s3", api.BackupConfiguration{
+			Storage: api.BackupStorageS3,
+			S3:      &api.S3Configuration{Bucket: "backups"},
+		}, false},
+		{"unknown storage", api.BackupConfiguration{Storage: "floppy"}, true},
+		{"s3 without configuration", api.BackupConfiguration{Storage: api.BackupStorageS3}, true},
+		{"s3 without bucket", api.BackupConfiguration{
+			Storage: api.BackupStorageS3,
+			S3:      &api.S3Configuration{},
+		}, true},
+		{"invalid schedule", api.BackupConfiguration{Schedule: "often"}, true},
+		{"schedule too frequent", api.BackupConfiguration{Schedule: "1s"}, true},
+		{"invalid max age", api.BackupConfiguration{MaxAge: "-1h"}, true},
+		{"negative keep", api.BackupConfiguration{Keep: -1}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err = m.Configure(tt.conf)
+			assert.Equal(t, tt.wantErr, err != nil, "got %v", err)
+		})
+	}
+}
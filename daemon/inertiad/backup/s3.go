@@ -0,0 +1,111 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+
+	"github.com/ubclaunchpad/inertia/api"
+)
+
+// S3Store stores backups in an S3-compatible bucket
+type S3Store struct {
+	client *s3.S3
+	bucket string
+	prefix string
+}
+
+// NewS3Store creates a store that keeps backups in the configured bucket. If no
+// credentials are configured, credentials are read from the environment.
+func NewS3Store(conf api.S3Configuration) (*S3Store, error) {
+	if conf.Bucket == "" {
+		return nil, errors.New("bucket is required")
+	}
+	var awsConf = aws.NewConfig().
+		WithRegion(conf.Region).
+		WithS3ForcePathStyle(conf.PathStyle)
+	if conf.Region == "" {
+		awsConf = awsConf.WithRegion("us-east-1")
+	}
+	if conf.Endpoint != "" {
+		awsConf = awsConf.WithEndpoint(conf.Endpoint)
+	}
+	if conf.AccessKeyID != "" {
+		awsConf = awsConf.WithCredentials(
+			credentials.NewStaticCredentials(conf.AccessKeyID, conf.SecretAccessKey, ""))
+	}
+	sess, err := session.NewSession(awsConf)
+	if err != nil {
+		return nil, err
+	}
+
+	var prefix = strings.Trim(conf.Prefix, "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+	return &S3Store{
+		client: s3.New(sess),
+		bucket: conf.Bucket,
+		prefix: prefix,
+	}, nil
+}
+
+func (s *S3Store) key(name string) string { return s.prefix + name }
+
+// Put implements Store
+func (s *S3Store) Put(ctx context.Context, name string, r io.ReadSeeker) error {
+	_, err := s.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(name)),
+		Body:   r,
+	})
+	return err
+}
+
+// Get implements Store
+func (s *S3Store) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	out, err := s.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(name)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.Body, nil
+}
+
+// List implements Store
+func (s *S3Store) List(ctx context.Context) ([]Object, error) {
+	var objects []Object
+	err := s.client.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(s.prefix),
+	}, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		for _, o := range page.Contents {
+			var name = strings.TrimPrefix(aws.StringValue(o.Key), s.prefix)
+			// ignore objects in nested "directories"
+			if name == "" || path.Base(name) != name {
+				continue
+			}
+			objects = append(objects, Object{Name: name, Size: aws.Int64Value(o.Size)})
+		}
+		return true
+	})
+	return objects, err
+}
+
+// Delete implements Store
+func (s *S3Store) Delete(ctx context.Context, name string) error {
+	_, err := s.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(name)),
+	})
+	return err
+}
@@ -0,0 +1,146 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ubclaunchpad/inertia/api"
+)
+
+// fakeS3 is a minimal stand-in for an S3-compatible service such as MinIO,
+// supporting path-style object operations on a single bucket
+type fakeS3 struct {
+	bucket  string
+	mux     sync.Mutex
+	objects map[string][]byte
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	var parts = strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+	if parts[0] != f.bucket {
+		http.Error(w, "no such bucket", http.StatusNotFound)
+		return
+	}
+	if len(parts) == 1 || parts[1] == "" {
+		if r.Method != http.MethodGet || r.URL.Query().Get("list-type") != "2" {
+			http.Error(w, "unsupported", http.StatusNotImplemented)
+			return
+		}
+		type object struct {
+			Key  string
+			Size int
+		}
+		var result struct {
+			XMLName     xml.Name `xml:"ListBucketResult"`
+			Name        string
+			Prefix      string
+			IsTruncated bool
+			Contents    []object
+		}
+		result.Name = f.bucket
+		result.Prefix = r.URL.Query().Get("prefix")
+		for k, v := range f.objects {
+			if strings.HasPrefix(k, result.Prefix) {
+				result.Contents = append(result.Contents, object{Key: k, Size: len(v)})
+			}
+		}
+		sort.Slice(result.Contents, func(i, j int) bool { return result.Contents[i].Key < result.Contents[j].Key })
+		w.Header().Set("Content-Type", "application/xml")
+		xml.NewEncoder(w).Encode(result)
+		return
+	}
+
+	var key = parts[1]
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := ioutil.ReadAll(r.Body)
+		f.objects[key] = body
+	case http.MethodGet:
+		obj, ok := f.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(obj)
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "unsupported", http.StatusNotImplemented)
+	}
+}
+
+func TestS3Store(t *testing.T) {
+	var fake = &fakeS3{bucket: "backups", objects: map[string][]byte{
+		"other/persist-20201019T120000Z.tar.gz": []byte("not ours"),
+	}}
+	var server = httptest.NewServer(fake)
+	defer server.Close()
+
+	store, err := NewS3Store(api.S3Configuration{
+		Endpoint:        server.URL,
+		Bucket:          "backups",
+		Prefix:          "/inertia/",
+		AccessKeyID:     "minio",
+		SecretAccessKey: "minio123",
+		PathStyle:       true,
+	})
+	require.NoError(t, err)
+	var ctx = context.Background()
+
+	require.NoError(t, store.Put(ctx, "a", bytes.NewReader([]byte("hello"))))
+	require.NoError(t, store.Put(ctx, "b", bytes.NewReader([]byte("world!"))))
+	assert.Contains(t, fake.objects, "inertia/a")
+
+	objects, err := store.List(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []Object{{Name: "a", Size: 5}, {Name: "b", Size: 6}}, objects)
+
+	rc, err := store.Get(ctx, "a")
+	require.NoError(t, err)
+	b, err := ioutil.ReadAll(rc)
+	rc.Close()
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(b))
+
+	require.NoError(t, store.Delete(ctx, "a"))
+	objects, err = store.List(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []Object{{Name: "b", Size: 6}}, objects)
+
+	t.Run("with manager", func(t *testing.T) {
+		m, persist := newTestManager(t)
+		require.NoError(t, m.Configure(api.BackupConfiguration{
+			Storage: api.BackupStorageS3,
+			S3: &api.S3Configuration{
+				Endpoint:        server.URL,
+				Bucket:          "backups",
+				AccessKeyID:     "minio",
+				SecretAccessKey: "minio123",
+				PathStyle:       true,
+			},
+			Passphrase: "hunter2",
+		}))
+		require.NoError(t, ioutil.WriteFile(persist+"/data", []byte("data"), 0644))
+		backup, err := m.Create(ctx)
+		require.NoError(t, err)
+		require.NoError(t, ioutil.WriteFile(persist+"/data", []byte("changed"), 0644))
+		require.NoError(t, m.Restore(ctx, backup.Name))
+		data, err := ioutil.ReadFile(persist + "/data")
+		require.NoError(t, err)
+		assert.Equal(t, "data", string(data))
+	})
+}
@@ -0,0 +1,79 @@
+package backup
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// Store stores backups
+type Store interface {
+	Put(ctx context.Context, name string, r io.ReadSeeker) error
+	Get(ctx context.Context, name string) (io.ReadCloser, error)
+	List(ctx context.Context) ([]Object, error)
+	Delete(ctx context.Context, name string) error
+}
+
+// Object describes a stored backup
+type Object struct {
+	Name string
+	Size int64
+}
+
+// LocalStore stores backups in a directory on disk
+type LocalStore struct {
+	dir string
+}
+
+// NewLocalStore creates a store that keeps backups in the given directory
+func NewLocalStore(dir string) *LocalStore { return &LocalStore{dir} }
+
+// Put implements Store
+func (l *LocalStore) Put(ctx context.Context, name string, r io.ReadSeeker) error {
+	if err := os.MkdirAll(l.dir, 0700); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(l.dir, name+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	_, err = io.Copy(f, r)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), filepath.Join(l.dir, name))
+}
+
+// Get implements Store
+func (l *LocalStore) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	return os.Open(filepath.Join(l.dir, name))
+}
+
+// List implements Store
+func (l *LocalStore) List(ctx context.Context) ([]Object, error) {
+	files, err := ioutil.ReadDir(l.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var objects = make([]Object, 0, len(files))
+	for _, f := range files {
+		if f.Mode().IsRegular() {
+			objects = append(objects, Object{Name: f.Name(), Size: f.Size()})
+		}
+	}
+	return objects, nil
+}
+
+// Delete implements Store
+func (l *LocalStore) Delete(ctx context.Context, name string) error {
+	return os.Remove(filepath.Join(l.dir, name))
+}
@@ -38,6 +38,7 @@ type ProjectBuilder func(Config, *docker.Client, io.Writer) (func() error, error
 type Builder struct {
 	buildStageName       string
 	dockerComposeVersion string
+	secretFilesDirectory string
 	stopper              containers.ContainerStopper
 
 	builders map[string]ProjectBuilder
@@ -48,6 +49,7 @@ func NewBuilder(conf cfg.Config, stopper containers.ContainerStopper) *Builder {
 	b := &Builder{
 		buildStageName:       "build",
 		dockerComposeVersion: conf.DockerComposeVersion,
+		secretFilesDirectory: conf.SecretFilesDirectory,
 		stopper:              stopper,
 	}
 	b.builders = map[string]ProjectBuilder{
@@ -84,7 +86,14 @@ type Config struct {
 	BuildDirectory   string
 	PersistDirectory string
 
-	EnvValues []string
+	// EnvValues are applied to all containers, while ContainerEnvValues are
+	// only applied to the container or docker-compose service they are keyed by
+	EnvValues          []string
+	ContainerEnvValues map[string][]string
+
+	// Secrets are written to files keyed by name and mounted read-only into all
+	// containers at SecretFilesMountPath
+	Secrets map[string][]byte
 }
 
 // Build executes build and deploy
@@ -94,8 +103,8 @@ func (b *Builder) Build(buildType string, d Config,
 	builder, found := b.builders[strings.ToLower(buildType)]
 	if !found {
 		// @todo: attempt a guess at project type instead
-		fmt.Println(out, "Unknown project type "+buildType)
-		fmt.Println(out, "Defaulting to docker-compose build")
+		fmt.Fprintln(out, "Unknown project type "+buildType)
+		fmt.Fprintln(out, "Defaulting to docker-compose build")
 		builder = b.dockerCompose
 	}
 
@@ -134,6 +143,23 @@ func (b *Builder) dockerCompose(d Config, cli *docker.Client,
 		dockercomposeFilePath = d.BuildFilePath
 	}
 
+	// set up container-specific env values and secrets
+	mountSecrets, err := b.prepareSecretFiles(d)
+	if err != nil {
+		return nil, err
+	}
+	composeFiles := []string{"-f", dockercomposeFilePath}
+	env := d.EnvValues
+	overrideFilePath, overrideEnv, err := writeComposeOverride(
+		d.BuildDirectory, dockercomposeFilePath, d.ContainerEnvValues, mountSecrets)
+	if err != nil {
+		return nil, err
+	}
+	if overrideFilePath != "" {
+		composeFiles = append(composeFiles, "-f", overrideFilePath)
+		env = append(append([]string{}, env...), overrideEnv...)
+	}
+
 	// set up bindings
 	binds := []string{
 		getTrueDirectory(d.BuildDirectory) + ":/build",
@@ -148,12 +174,10 @@ func (b *Builder) dockerCompose(d Config, cli *docker.Client,
 		ctx, &container.Config{
 			Image:      b.dockerComposeVersion,
 			WorkingDir: "/build",
-			Cmd: []string{
+			Cmd: append(append([]string{
 				"-p", d.Name,
-				"-f", dockercomposeFilePath,
-				"build",
-			},
-			Env: d.EnvValues,
+			}, composeFiles...), "build"),
+			Env: env,
 		},
 		&container.HostConfig{
 			AutoRemove: true,
@@ -184,25 +208,29 @@ func (b *Builder) dockerCompose(d Config, cli *docker.Client,
 		)
 	)
 
+	upBinds := []string{
+		dockerComposeFilePath + ":/build/docker-compose.yml",
+		"/var/run/docker.sock:/var/run/docker.sock",
+	}
+	if overrideFilePath != "" {
+		upBinds = append(upBinds, path.Join(getTrueDirectory(d.BuildDirectory), overrideFilePath)+
+			":/build/"+overrideFilePath)
+	}
+
 	// Set up docker-compose up
 	reportProjectContainerCreateBegin(d.Name, out)
 	resp, err = cli.ContainerCreate(
 		ctx, &container.Config{
 			Image:      b.dockerComposeVersion,
 			WorkingDir: "/build",
-			Cmd: []string{
+			Cmd: append(append([]string{
 				"-p", d.Name,
-				"-f", dockercomposeFilePath,
-				"up",
-			},
-			Env: d.EnvValues,
+			}, composeFiles...), "up"),
+			Env: env,
 		},
 		&container.HostConfig{
 			AutoRemove: true,
-			Binds: []string{
-				dockerComposeFilePath + ":/build/docker-compose.yml",
-				"/var/run/docker.sock:/var/run/docker.sock",
-			},
+			Binds:      upBinds,
 		}, nil, "docker-compose",
 	)
 	if err != nil {
@@ -270,13 +298,20 @@ func (b *Builder) dockerBuild(d Config, cli *docker.Client,
 	if d.PersistDirectory != "" {
 		binds = append(binds, getTrueDirectory(d.PersistDirectory)+":/persist")
 	}
+	mountSecrets, err := b.prepareSecretFiles(d)
+	if err != nil {
+		return nil, err
+	}
+	if mountSecrets {
+		binds = append(binds, secretFilesBind)
+	}
 
 	// Create container from image
 	reportProjectContainerCreateBegin(d.Name, out)
 	containerResp, err := cli.ContainerCreate(
 		ctx, &container.Config{
 			Image: imageName,
-			Env:   d.EnvValues,
+			Env:   append(append([]string{}, d.EnvValues...), d.ContainerEnvValues[d.Name]...),
 		},
 		&container.HostConfig{
 			Binds:        binds,
@@ -297,6 +332,15 @@ func (b *Builder) dockerBuild(d Config, cli *docker.Client,
 	return func() error { return b.run(ctx, cli, d.Name, containerResp.ID, out) }, nil
 }
 
+// prepareSecretFiles writes the configured secrets to the secret files volume,
+// and returns true if the volume should be mounted into project containers
+func (b *Builder) prepareSecretFiles(d Config) (bool, error) {
+	if err := writeSecretFiles(b.secretFilesDirectory, d.Secrets); err != nil {
+		return false, err
+	}
+	return len(d.Secrets) > 0, nil
+}
+
 // run starts project and tracks all active project containers
 func (b *Builder) run(ctx context.Context, client *docker.Client, name, id string, out io.Writer) error {
 	reportProjectStartup(name, out)
@@ -0,0 +1,135 @@
+package build
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// composeOverrideFile is the name of the docker-compose override file used to
+// apply Inertia-managed configuration to services
+const composeOverrideFile = "docker-compose.inertia.yml"
+
+// composeOverride is a minimal docker-compose file that only declares
+// Inertia-managed configuration for services
+type composeOverride struct {
+	Version  string                            `json:"version,omitempty"`
+	Services map[string]composeServiceOverride `json:"services"`
+	Volumes  map[string]composeVolume          `json:"volumes,omitempty"`
+}
+
+type composeServiceOverride struct {
+	Environment map[string]string `json:"environment,omitempty"`
+	Volumes     []string          `json:"volumes,omitempty"`
+}
+
+type composeVolume struct {
+	External bool `json:"external"`
+}
+
+// composeFile declares the parts of a docker-compose file we care about
+type composeFile struct {
+	Version  string                 `yaml:"version"`
+	Services map[string]interface{} `yaml:"services"`
+}
+
+// writeComposeOverride writes a docker-compose override file into the build
+// directory that applies the given container-specific env values to their
+// respective services, and mounts secret files into all services if
+// mountSecrets is set. Env values are not written to disk - instead, the
+// override interpolates them from the returned env values, which should be
+// provided to the docker-compose runner. Returns the override file's path
+// relative to the build directory, or an empty string if there is nothing to
+// override.
+func writeComposeOverride(
+	buildDirectory, composeFilePath string,
+	containerEnv map[string][]string,
+	mountSecrets bool,
+) (string, []string, error) {
+	if len(containerEnv) == 0 && !mountSecrets {
+		return "", nil, nil
+	}
+
+	// docker-compose requires override files to use the same version as the
+	// file they are applied to
+	compose, err := readComposeFile(filepath.Join(buildDirectory, composeFilePath))
+	if err != nil {
+		return "", nil, err
+	}
+
+	var (
+		override = composeOverride{
+			Version:  compose.Version,
+			Services: map[string]composeServiceOverride{},
+		}
+		runnerEnv []string
+	)
+
+	var services = make([]string, 0, len(containerEnv))
+	for service := range containerEnv {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	for _, service := range services {
+		var env = map[string]string{}
+		for _, kv := range containerEnv[service] {
+			parts := strings.SplitN(kv, "=", 2)
+			if len(parts) != 2 {
+				continue
+			}
+			ref := fmt.Sprintf("INERTIA_SCOPED_ENV_%d", len(runnerEnv))
+			env[parts[0]] = "${" + ref + "}"
+			runnerEnv = append(runnerEnv, ref+"="+parts[1])
+		}
+		override.Services[service] = composeServiceOverride{Environment: env}
+	}
+
+	if mountSecrets {
+		if len(compose.Services) == 0 {
+			return "", nil, errors.New("secret files require a docker-compose file with a 'services' section")
+		}
+		for service := range compose.Services {
+			s := override.Services[service]
+			s.Volumes = append(s.Volumes, secretFilesBind)
+			override.Services[service] = s
+		}
+		override.Volumes = map[string]composeVolume{
+			SecretFilesVolume: {External: true},
+		}
+	}
+
+	// JSON is valid YAML, so docker-compose can read this directly
+	bytes, err := json.MarshalIndent(override, "", "  ")
+	if err != nil {
+		return "", nil, err
+	}
+	if err := ioutil.WriteFile(
+		filepath.Join(buildDirectory, composeOverrideFile), bytes, 0644,
+	); err != nil {
+		return "", nil, fmt.Errorf("failed to write docker-compose override: %s", err.Error())
+	}
+	return composeOverrideFile, runnerEnv, nil
+}
+
+// readComposeFile reads the given docker-compose file
+func readComposeFile(path string) (composeFile, error) {
+	var compose composeFile
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return compose, nil
+		}
+		return compose, fmt.Errorf("failed to read docker-compose file: %s", err.Error())
+	}
+	if err := yaml.Unmarshal(bytes, &compose); err != nil {
+		return compose, fmt.Errorf("failed to parse docker-compose file: %s", err.Error())
+	}
+	return compose, nil
+}
@@ -0,0 +1,49 @@
+package build
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_writeComposeOverride(t *testing.T) {
+	dir, err := ioutil.TempDir("", "inertia-build-compose")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// nothing to override
+	file, env, err := writeComposeOverride(dir, "docker-compose.yml", nil, false)
+	assert.NoError(t, err)
+	assert.Empty(t, file)
+	assert.Empty(t, env)
+
+	// secrets require services to mount into
+	_, _, err = writeComposeOverride(dir, "docker-compose.yml", nil, true)
+	assert.Error(t, err)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "docker-compose.yml"),
+		[]byte("version: '3'\nservices:\n  web:\n    image: nginx\n  db:\n    image: postgres\n"), 0644))
+	file, env, err = writeComposeOverride(dir, "docker-compose.yml", map[string][]string{
+		"web": {"SECRET=hunter2"},
+	}, true)
+	require.NoError(t, err)
+	assert.Equal(t, composeOverrideFile, file)
+	assert.Equal(t, []string{"INERTIA_SCOPED_ENV_0=hunter2"}, env)
+
+	bytes, err := ioutil.ReadFile(filepath.Join(dir, file))
+	require.NoError(t, err)
+	assert.NotContains(t, string(bytes), "hunter2")
+
+	var override composeOverride
+	require.NoError(t, json.Unmarshal(bytes, &override))
+	assert.Equal(t, "3", override.Version)
+	assert.Equal(t, "${INERTIA_SCOPED_ENV_0}", override.Services["web"].Environment["SECRET"])
+	assert.Equal(t, []string{secretFilesBind}, override.Services["web"].Volumes)
+	assert.Equal(t, []string{secretFilesBind}, override.Services["db"].Volumes)
+	assert.True(t, override.Volumes[SecretFilesVolume].External)
+}
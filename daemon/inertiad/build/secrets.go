@@ -0,0 +1,50 @@
+package build
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/ubclaunchpad/inertia/common"
+)
+
+const (
+	// SecretFilesVolume is the name of the tmpfs-backed Docker volume that secret
+	// files are written to - it must be created before the daemon starts
+	SecretFilesVolume = "inertia-secrets"
+
+	// SecretFilesMountPath is where secret files are mounted in project containers
+	SecretFilesMountPath = "/run/secrets/inertia"
+)
+
+// secretFilesBind mounts the secret files volume read-only
+const secretFilesBind = SecretFilesVolume + ":" + SecretFilesMountPath + ":ro"
+
+// writeSecretFiles replaces the contents of dir with the given secrets, one
+// read-only file per secret. If there are no secrets, a missing directory is
+// not an error.
+func writeSecretFiles(dir string, secrets map[string][]byte) error {
+	if dir == "" {
+		if len(secrets) == 0 {
+			return nil
+		}
+		return errors.New("no secret files directory configured")
+	}
+	if _, err := os.Stat(dir); err != nil {
+		if len(secrets) == 0 && os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("secret files directory unavailable - try reinstalling the daemon: %s", err.Error())
+	}
+	if err := common.RemoveContents(dir); err != nil {
+		return fmt.Errorf("failed to clear secret files: %s", err.Error())
+	}
+	for name, value := range secrets {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), value, 0444); err != nil {
+			return fmt.Errorf("failed to write secret '%s': %s", name, err.Error())
+		}
+	}
+	return nil
+}
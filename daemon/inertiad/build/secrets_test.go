@@ -0,0 +1,34 @@
+package build
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_writeSecretFiles(t *testing.T) {
+	// no secrets and no directory is fine
+	assert.NoError(t, writeSecretFiles("", nil))
+	assert.NoError(t, writeSecretFiles("./does-not-exist", nil))
+	assert.Error(t, writeSecretFiles("./does-not-exist", map[string][]byte{"a": []byte("b")}))
+
+	dir, err := ioutil.TempDir("", "inertia-build-secrets")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "stale"), []byte("old"), 0444))
+	require.NoError(t, writeSecretFiles(dir, map[string][]byte{"tls.key": []byte("sekret")}))
+
+	_, err = os.Stat(filepath.Join(dir, "stale"))
+	assert.True(t, os.IsNotExist(err))
+	info, err := os.Stat(filepath.Join(dir, "tls.key"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0444), info.Mode().Perm())
+	contents, err := ioutil.ReadFile(filepath.Join(dir, "tls.key"))
+	require.NoError(t, err)
+	assert.Equal(t, "sekret", string(contents))
+}
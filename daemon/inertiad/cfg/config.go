@@ -16,10 +16,22 @@ type Config struct {
 	DataDirectory    string // "/app/host/inertia/data/"
 	SecretsDirectory string // "/app/host/.inertia/"
 
+	// SecretFilesDirectory is a tmpfs mount where project secrets are written,
+	// backed by a volume that is shared with project containers
+	SecretFilesDirectory string // "/app/secrets/"
+
+	// DockerRootDirectory is a read-only mount of Docker's data root, used to
+	// monitor the disk space available to builds
+	DockerRootDirectory string // "/app/docker/"
+
 	// Build tools
 	DockerComposeVersion string // "docker/compose:${version}"
 
 	WebhookSecret string
+
+	// Logging
+	LogLevel  string // "info"
+	LogFormat string // "text"
 }
 
 // New creates a new daemon configuration from environment values
@@ -38,5 +50,9 @@ func New() *Config {
 		DockerComposeVersion: fmt.Sprintf("docker/compose:%s", dcVersionString),
 		ProjectDirectory:     os.Getenv("INERTIA_PROJECT_DIR"),
 		PersistDirectory:     os.Getenv("INERTIA_PERSIST_DIR"),
+		SecretFilesDirectory: os.Getenv("INERTIA_SECRET_FILES_DIR"),
+		DockerRootDirectory:  os.Getenv("INERTIA_DOCKER_ROOT_DIR"),
+		LogLevel:             os.Getenv("INERTIA_LOG_LEVEL"),
+		LogFormat:            os.Getenv("INERTIA_LOG_FORMAT"),
 	}
 }
@@ -1,24 +1,33 @@
 package containers
 
 import (
+	"bufio"
 	"context"
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/filters"
 	docker "github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/log"
 )
 
 var (
 	// ErrNoContainers is the response to indicate that no containers are active
 	ErrNoContainers = errors.New("There are currently no active containers")
+
+	// ErrContainerUnreachable indicates that a container has no address that
+	// connections can be made to
+	ErrContainerUnreachable = errors.New("container is unreachable")
 )
 
 // LogOptions is used to configure retrieved container logs
@@ -60,6 +69,103 @@ func StreamContainerLogs(client *docker.Client, id string, out io.Writer,
 	return nil
 }
 
+// LogLine is a line of output from a container
+type LogLine struct {
+	Time    time.Time
+	Stderr  bool
+	Message string
+}
+
+// FollowLogs calls fn with each line logged by the given container after the
+// given time, until the container stops or ctx is cancelled
+func FollowLogs(ctx context.Context, cli *docker.Client, id string, since time.Time,
+	fn func(LogLine)) error {
+	return readContainerLogs(ctx, cli, id, types.ContainerLogsOptions{
+		Follow: true,
+		Since:  dockerTime(since),
+	}, fn)
+}
+
+// readContainerLogs calls fn with each line of the given container's logs
+// selected by opts, with timestamps
+func readContainerLogs(ctx context.Context, cli *docker.Client, id string,
+	opts types.ContainerLogsOptions, fn func(LogLine)) error {
+	info, err := cli.ContainerInspect(ctx, id)
+	if err != nil {
+		return err
+	}
+	opts.ShowStdout = true
+	opts.ShowStderr = true
+	opts.Timestamps = true
+	reader, err := cli.ContainerLogs(ctx, id, opts)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	return ReadLogs(reader, info.Config != nil && info.Config.Tty, fn)
+}
+
+// dockerTime formats t as a Docker API timestamp, or returns an empty string
+// if t is zero
+func dockerTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%d.%09d", t.Unix(), t.Nanosecond())
+}
+
+// ReadLogs parses container logs retrieved with timestamps, calling fn with
+// each line. Unless the container has a TTY, Docker multiplexes stdout and
+// stderr into one stream.
+func ReadLogs(r io.Reader, tty bool, fn func(LogLine)) error {
+	if tty {
+		return scanLogs(r, false, fn)
+	}
+
+	var (
+		stdout, stdoutW = io.Pipe()
+		stderr, stderrW = io.Pipe()
+		mux             sync.Mutex
+		wait            sync.WaitGroup
+	)
+	var read = func(r io.Reader, isStderr bool) {
+		defer wait.Done()
+		scanLogs(r, isStderr, func(l LogLine) {
+			mux.Lock()
+			fn(l)
+			mux.Unlock()
+		})
+		// keep draining, so that demultiplexing is not blocked
+		io.Copy(ioutil.Discard, r)
+	}
+	wait.Add(2)
+	go read(stdout, false)
+	go read(stderr, true)
+	_, err := stdcopy.StdCopy(stdoutW, stderrW, r)
+	stdoutW.Close()
+	stderrW.Close()
+	wait.Wait()
+	return err
+}
+
+// scanLogs calls fn with each line in r, parsing the timestamp Docker prefixes
+// each line with
+func scanLogs(r io.Reader, stderr bool, fn func(LogLine)) error {
+	var scanner = bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var line = LogLine{Stderr: stderr, Message: scanner.Text()}
+		if i := strings.IndexByte(line.Message, ' '); i > 0 {
+			if t, err := time.Parse(time.RFC3339Nano, line.Message[:i]); err == nil {
+				line.Time = t
+				line.Message = line.Message[i+1:]
+			}
+		}
+		fn(line)
+	}
+	return scanner.Err()
+}
+
 // GetActiveContainers returns all active containers and returns and error
 // if the Daemon is the only active container
 func GetActiveContainers(docker *docker.Client) ([]types.Container, error) {
@@ -159,6 +265,68 @@ func PruneAll(docker *docker.Client, exceptions ...string) error {
 	return nil
 }
 
+// PruneDangling removes dangling images and unused build cache, returning the
+// amount of space reclaimed. Unlike Prune, it leaves containers and volumes
+// alone, so it is safe to run at any time.
+func PruneDangling(ctx context.Context, docker *docker.Client) (uint64, error) {
+	images, err := docker.ImagesPrune(ctx, filters.NewArgs(filters.Arg("dangling", "true")))
+	if err != nil {
+		return 0, err
+	}
+	var reclaimed = images.SpaceReclaimed
+
+	// build cache pruning is not supported by older Docker versions
+	if cache, err := docker.BuildCachePrune(ctx); err == nil {
+		reclaimed += cache.SpaceReclaimed
+	}
+	return reclaimed, nil
+}
+
+// PruneUnusedImages removes images that are not used by any container, except
+// those whose tags contain one of the given exceptions, returning the amount
+// of space reclaimed. Unlike PruneAll, images of stopped containers - such as
+// archived containers from previous deployments - are kept, so that those
+// deployments can still be rolled back to.
+func PruneUnusedImages(ctx context.Context, docker *docker.Client, exceptions ...string) (uint64, error) {
+	containers, err := docker.ContainerList(ctx, types.ContainerListOptions{All: true})
+	if err != nil {
+		return 0, err
+	}
+	var used = make(map[string]bool, len(containers))
+	for _, c := range containers {
+		used[c.ImageID] = true
+	}
+
+	images, err := docker.ImageList(ctx, types.ImageListOptions{})
+	if err != nil {
+		return 0, err
+	}
+	var reclaimed uint64
+	for _, i := range images {
+		if used[i.ID] || matchesAny(i.RepoTags, exceptions) {
+			continue
+		}
+		if _, err := docker.ImageRemove(ctx, i.ID, types.ImageRemoveOptions{
+			PruneChildren: true,
+		}); err == nil && i.Size > 0 {
+			reclaimed += uint64(i.Size)
+		}
+	}
+	return reclaimed, nil
+}
+
+// matchesAny reports whether any of the given tags contains one of patterns
+func matchesAny(tags, patterns []string) bool {
+	for _, t := range tags {
+		for _, p := range patterns {
+			if strings.Contains(t, p) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // Wait blocks until given container ID stops
 func Wait(cli *docker.Client, id string, stop chan struct{}) (int64, error) {
 	var status container.ContainerWaitOKBody
@@ -194,3 +362,40 @@ func StartAndWait(cli *docker.Client, containerID string, out io.Writer) error {
 
 	return nil
 }
+
+// ContainerAddress returns the IP address of the given running container, as
+// seen from the host
+func ContainerAddress(cli *docker.Client, name string) (string, error) {
+	c, err := cli.ContainerInspect(context.Background(), name)
+	if err != nil {
+		return "", err
+	}
+	return containerIP(c)
+}
+
+// containerIP picks an address from the container's network settings,
+// preferring the default bridge network
+func containerIP(c types.ContainerJSON) (string, error) {
+	if c.ContainerJSONBase == nil || c.State == nil || !c.State.Running {
+		return "", fmt.Errorf("%w: %s is not running", ErrContainerUnreachable, strings.TrimPrefix(c.Name, "/"))
+	}
+	if c.NetworkSettings == nil {
+		return "", fmt.Errorf("%w: %s has no network settings", ErrContainerUnreachable, strings.TrimPrefix(c.Name, "/"))
+	}
+	if ip := c.NetworkSettings.IPAddress; ip != "" {
+		return ip, nil
+	}
+
+	// fall back to the first named network with an address, in a stable order
+	var names = make([]string, 0, len(c.NetworkSettings.Networks))
+	for n := range c.NetworkSettings.Networks {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		if e := c.NetworkSettings.Networks[n]; e != nil && e.IPAddress != "" {
+			return e.IPAddress, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %s has no network address", ErrContainerUnreachable, strings.TrimPrefix(c.Name, "/"))
+}
@@ -1,13 +1,17 @@
 package containers
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"os"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/pkg/stdcopy"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -64,6 +68,30 @@ func TestStreamContainerLogs(t *testing.T) {
 	close(stop)
 }
 
+func TestReadLogs(t *testing.T) {
+	var ts = "2020-01-02T03:04:05.123456789Z"
+	var want = []LogLine{
+		{Time: time.Date(2020, 1, 2, 3, 4, 5, 123456789, time.UTC), Message: "hello world"},
+		{Time: time.Date(2020, 1, 2, 3, 4, 5, 123456789, time.UTC), Stderr: true, Message: "oh no"},
+	}
+
+	t.Run("multiplexed", func(t *testing.T) {
+		var buf bytes.Buffer
+		stdcopy.NewStdWriter(&buf, stdcopy.Stdout).Write([]byte(ts + " hello world\n"))
+		stdcopy.NewStdWriter(&buf, stdcopy.Stderr).Write([]byte(ts + " oh no\n"))
+		var lines []LogLine
+		assert.NoError(t, ReadLogs(&buf, false, func(l LogLine) { lines = append(lines, l) }))
+		assert.ElementsMatch(t, want, lines)
+	})
+
+	t.Run("tty", func(t *testing.T) {
+		var lines []LogLine
+		assert.NoError(t, ReadLogs(strings.NewReader(ts+" hello world\nno timestamp\n"), true,
+			func(l LogLine) { lines = append(lines, l) }))
+		assert.Equal(t, []LogLine{want[0], {Message: "no timestamp"}}, lines)
+	})
+}
+
 func TestGetActiveContainers(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
@@ -107,3 +135,78 @@ func TestPruneAll(t *testing.T) {
 	}
 	assert.True(t, found)
 }
+
+func TestPruneUnusedImages(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	cli, err := NewDockerClient()
+	assert.NoError(t, err)
+	defer cli.Close()
+
+	_, err = PruneDangling(context.Background(), cli)
+	assert.NoError(t, err)
+	_, err = PruneUnusedImages(context.Background(), cli, "docker/compose")
+	assert.NoError(t, err)
+
+	// Exceptions should still be present
+	list, err := cli.ImageList(context.Background(), types.ImageListOptions{})
+	assert.NoError(t, err)
+	var found bool
+	for _, i := range list {
+		found = found || matchesAny(i.RepoTags, []string{"docker/compose"})
+	}
+	assert.True(t, found)
+}
+
+func Test_matchesAny(t *testing.T) {
+	assert.True(t, matchesAny([]string{"docker/compose:1.25.0"}, []string{"docker/compose"}))
+	assert.True(t, matchesAny([]string{"web:latest", "docker/compose:1.25.0"}, []string{"other", "compose"}))
+	assert.False(t, matchesAny([]string{"web:latest"}, []string{"docker/compose"}))
+	assert.False(t, matchesAny(nil, []string{"docker/compose"}))
+}
+
+func Test_containerIP(t *testing.T) {
+	var running = func(settings *types.NetworkSettings) types.ContainerJSON {
+		return types.ContainerJSON{
+			ContainerJSONBase: &types.ContainerJSONBase{
+				Name:  "/db",
+				State: &types.ContainerState{Running: true},
+			},
+			NetworkSettings: settings,
+		}
+	}
+	tests := []struct {
+		name    string
+		c       types.ContainerJSON
+		want    string
+		wantErr bool
+	}{
+		{"not running", types.ContainerJSON{
+			ContainerJSONBase: &types.ContainerJSONBase{Name: "/db", State: &types.ContainerState{}},
+		}, "", true},
+		{"no network settings", running(nil), "", true},
+		{"default bridge", running(&types.NetworkSettings{
+			DefaultNetworkSettings: types.DefaultNetworkSettings{IPAddress: "172.17.0.2"},
+		}), "172.17.0.2", false},
+		{"named network", running(&types.NetworkSettings{
+			Networks: map[string]*network.EndpointSettings{
+				"project_default": {IPAddress: "172.18.0.3"},
+				"other":           {},
+			},
+		}), "172.18.0.3", false},
+		{"no address", running(&types.NetworkSettings{}), "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := containerIP(tt.c)
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, ErrContainerUnreachable))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
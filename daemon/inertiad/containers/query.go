@@ -0,0 +1,179 @@
+package containers
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	timetypes "github.com/docker/docker/api/types/time"
+	docker "github.com/docker/docker/client"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/log"
+)
+
+// LogQuery selects lines from the logs of one or more containers
+type LogQuery struct {
+	Containers []string
+
+	// Since and Until restrict lines to those logged within a time range -
+	// either may be left zero
+	Since time.Time
+	Until time.Time
+
+	// Grep, if set, selects only lines that match it
+	Grep *regexp.Regexp
+
+	// Level, if set, selects only lines at least as severe as it, as detected
+	// by log.DetectLevel. Lines without a recognizable level are treated as
+	// errors if they were written to stderr, and info otherwise.
+	Level *logrus.Level
+
+	// Entries, if set, limits results to the most recent matching lines
+	Entries int
+}
+
+// ContainerLogLine is a line of output from a named container
+type ContainerLogLine struct {
+	Container string
+	LogLine
+}
+
+// Match reports whether the given line passes the query's filters
+func (q *LogQuery) Match(l LogLine) bool {
+	if !q.Since.IsZero() && l.Time.Before(q.Since) {
+		return false
+	}
+	if !q.Until.IsZero() && l.Time.After(q.Until) {
+		return false
+	}
+	if q.Grep != nil && !q.Grep.MatchString(l.Message) {
+		return false
+	}
+	if q.Level != nil {
+		level, found := log.DetectLevel(l.Message)
+		if !found && l.Stderr {
+			level = logrus.ErrorLevel
+		}
+		// more severe logrus levels are lower
+		if level > *q.Level {
+			return false
+		}
+	}
+	return true
+}
+
+// filtered reports whether lines are filtered by their contents, in which case
+// Docker can't be relied on to limit the number of lines retrieved
+func (q *LogQuery) filtered() bool {
+	return q.Grep != nil || q.Level != nil
+}
+
+// QueryLogs retrieves lines matching q from each of its containers, merged in
+// timestamp order
+func QueryLogs(ctx context.Context, cli *docker.Client, q LogQuery) ([]ContainerLogLine, error) {
+	var opts = types.ContainerLogsOptions{
+		Since: dockerTime(q.Since),
+		Until: dockerTime(q.Until),
+		Tail:  "all",
+	}
+	if q.Entries > 0 && !q.filtered() {
+		opts.Tail = strconv.Itoa(q.Entries)
+	}
+
+	var lines []ContainerLogLine
+	for _, c := range q.Containers {
+		var (
+			name    = strings.TrimPrefix(c, "/")
+			matched []ContainerLogLine
+		)
+		if err := readContainerLogs(ctx, cli, c, opts, func(l LogLine) {
+			if !q.Match(l) {
+				return
+			}
+			matched = append(matched, ContainerLogLine{Container: name, LogLine: l})
+			// only the most recent entries are kept, so don't hold on to
+			// more than necessary
+			if q.Entries > 0 && len(matched) >= 2*q.Entries {
+				matched = append(matched[:0], matched[len(matched)-q.Entries:]...)
+			}
+		}); err != nil {
+			return nil, err
+		}
+		lines = append(lines, matched...)
+	}
+	return MergeLogs(lines, q.Entries), nil
+}
+
+// FollowQuery calls fn with each line matching q logged by its containers
+// after the given time, until ctx is cancelled or all the containers stop
+func FollowQuery(ctx context.Context, cli *docker.Client, q LogQuery, since time.Time,
+	fn func(ContainerLogLine)) error {
+	var opts = types.ContainerLogsOptions{
+		Follow: true,
+		Since:  dockerTime(since),
+		Until:  dockerTime(q.Until),
+	}
+
+	var (
+		mux      sync.Mutex
+		wait     sync.WaitGroup
+		firstErr error
+	)
+	for _, c := range q.Containers {
+		wait.Add(1)
+		go func(c string) {
+			defer wait.Done()
+			var name = strings.TrimPrefix(c, "/")
+			err := readContainerLogs(ctx, cli, c, opts, func(l LogLine) {
+				if !q.Match(l) {
+					return
+				}
+				mux.Lock()
+				fn(ContainerLogLine{Container: name, LogLine: l})
+				mux.Unlock()
+			})
+			if err != nil && ctx.Err() == nil {
+				mux.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mux.Unlock()
+			}
+		}(c)
+	}
+	wait.Wait()
+	return firstErr
+}
+
+// MergeLogs sorts lines from several containers in timestamp order, keeping
+// the order of lines logged at the same time, and returns the most recent
+// limit lines, or all of them if limit is not positive
+func MergeLogs(lines []ContainerLogLine, limit int) []ContainerLogLine {
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i].Time.Before(lines[j].Time)
+	})
+	if limit > 0 && len(lines) > limit {
+		lines = lines[len(lines)-limit:]
+	}
+	return lines
+}
+
+// ParseLogTime parses a time given as an RFC3339 timestamp, a Unix timestamp,
+// or a duration before now such as "10m", as accepted by 'docker logs'
+func ParseLogTime(value string, now time.Time) (time.Time, error) {
+	ts, err := timetypes.GetTimestamp(value, now)
+	if err != nil {
+		return time.Time{}, err
+	}
+	sec, nsec, err := timetypes.ParseTimestamps(ts, 0)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(sec, nsec), nil
+}
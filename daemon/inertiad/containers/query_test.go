@@ -0,0 +1,87 @@
+package containers
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogQuery_Match(t *testing.T) {
+	var base = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	var warn = logrus.WarnLevel
+	tests := []struct {
+		name  string
+		query LogQuery
+		line  LogLine
+		want  bool
+	}{
+		{"no filters", LogQuery{}, LogLine{Message: "hello"}, true},
+		{"before since", LogQuery{Since: base},
+			LogLine{Time: base.Add(-time.Second), Message: "hello"}, false},
+		{"after until", LogQuery{Until: base},
+			LogLine{Time: base.Add(time.Second), Message: "hello"}, false},
+		{"within range", LogQuery{Since: base, Until: base.Add(time.Minute)},
+			LogLine{Time: base.Add(time.Second), Message: "hello"}, true},
+		{"grep matches", LogQuery{Grep: regexp.MustCompile(`GET /api/\w+`)},
+			LogLine{Message: "GET /api/users 200"}, true},
+		{"grep does not match", LogQuery{Grep: regexp.MustCompile(`POST`)},
+			LogLine{Message: "GET /api/users 200"}, false},
+		{"level too low", LogQuery{Level: &warn},
+			LogLine{Message: "level=info msg=hello"}, false},
+		{"level high enough", LogQuery{Level: &warn},
+			LogLine{Message: "level=error msg=hello"}, true},
+		{"unknown level on stdout", LogQuery{Level: &warn},
+			LogLine{Message: "hello"}, false},
+		{"unknown level on stderr", LogQuery{Level: &warn},
+			LogLine{Stderr: true, Message: "panic: oh no"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.query.Match(tt.line))
+		})
+	}
+}
+
+func TestMergeLogs(t *testing.T) {
+	var base = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	var lines = []ContainerLogLine{
+		{Container: "web", LogLine: LogLine{Time: base.Add(2 * time.Second), Message: "c"}},
+		{Container: "web", LogLine: LogLine{Time: base.Add(3 * time.Second), Message: "d"}},
+		{Container: "db", LogLine: LogLine{Time: base, Message: "a"}},
+		{Container: "db", LogLine: LogLine{Time: base.Add(2 * time.Second), Message: "c2"}},
+		{Container: "db", LogLine: LogLine{Time: base.Add(time.Second), Message: "b"}},
+	}
+
+	var messages = func(lines []ContainerLogLine) []string {
+		var m []string
+		for _, l := range lines {
+			m = append(m, l.Message)
+		}
+		return m
+	}
+	assert.Equal(t, []string{"c2", "d"}, messages(MergeLogs(append([]ContainerLogLine(nil), lines...), 2)))
+	assert.Equal(t, []string{"a", "b", "c", "c2", "d"}, messages(MergeLogs(lines, 0)))
+}
+
+func TestParseLogTime(t *testing.T) {
+	var now = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	parsed, err := ParseLogTime("10m", now)
+	require.NoError(t, err)
+	assert.True(t, now.Add(-10*time.Minute).Equal(parsed))
+
+	parsed, err = ParseLogTime("2020-01-01T00:00:00Z", now)
+	require.NoError(t, err)
+	assert.True(t, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).Equal(parsed))
+
+	parsed, err = ParseLogTime("1577934245", now)
+	require.NoError(t, err)
+	assert.True(t, now.Equal(parsed))
+
+	_, err = ParseLogTime("yesterday", now)
+	assert.Error(t, err)
+}
@@ -0,0 +1,134 @@
+package containers
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	docker "github.com/docker/docker/client"
+)
+
+// Stats is a snapshot of a container's resource usage
+type Stats struct {
+	Name string
+
+	// CPUPercent is the container's CPU usage since the previous sample, where
+	// 100% is one fully used CPU
+	CPUPercent float64
+	// CPUTotal is the container's cumulative CPU time
+	CPUTotal time.Duration
+
+	// MemoryUsage excludes the page cache, matching 'docker stats'
+	MemoryUsage uint64
+	MemoryLimit uint64
+
+	NetworkRx uint64
+	NetworkTx uint64
+
+	BlockRead  uint64
+	BlockWrite uint64
+
+	PIDs uint64
+}
+
+// GetStats retrieves a snapshot of the given container's resource usage
+func GetStats(ctx context.Context, cli *docker.Client, id string) (Stats, error) {
+	resp, err := cli.ContainerStats(ctx, id, false)
+	if err != nil {
+		return Stats{}, err
+	}
+	defer resp.Body.Close()
+	var s types.StatsJSON
+	if err := json.NewDecoder(resp.Body).Decode(&s); err != nil {
+		return Stats{}, err
+	}
+	return statsFromJSON(s), nil
+}
+
+// statsFromJSON summarizes stats reported by the Docker API
+func statsFromJSON(s types.StatsJSON) Stats {
+	var stats = Stats{
+		Name:        s.Name,
+		CPUTotal:    time.Duration(s.CPUStats.CPUUsage.TotalUsage),
+		MemoryUsage: s.MemoryStats.Usage,
+		MemoryLimit: s.MemoryStats.Limit,
+		PIDs:        s.PidsStats.Current,
+	}
+
+	var (
+		cpuDelta    = float64(s.CPUStats.CPUUsage.TotalUsage) - float64(s.PreCPUStats.CPUUsage.TotalUsage)
+		systemDelta = float64(s.CPUStats.SystemUsage) - float64(s.PreCPUStats.SystemUsage)
+		cpus        = float64(s.CPUStats.OnlineCPUs)
+	)
+	if cpus == 0 {
+		cpus = float64(len(s.CPUStats.CPUUsage.PercpuUsage))
+	}
+	if cpuDelta > 0 && systemDelta > 0 {
+		stats.CPUPercent = cpuDelta / systemDelta * cpus * 100
+	}
+
+	if cache, ok := s.MemoryStats.Stats["cache"]; ok && cache < stats.MemoryUsage {
+		stats.MemoryUsage -= cache
+	}
+
+	for _, n := range s.Networks {
+		stats.NetworkRx += n.RxBytes
+		stats.NetworkTx += n.TxBytes
+	}
+
+	for _, e := range s.BlkioStats.IoServiceBytesRecursive {
+		switch strings.ToLower(e.Op) {
+		case "read":
+			stats.BlockRead += e.Value
+		case "write":
+			stats.BlockWrite += e.Value
+		}
+	}
+	return stats
+}
+
+// ListStats retrieves a snapshot of the resource usage of all running project
+// containers, sampled concurrently since each sample takes about a second. If
+// names are given, only containers with those names are sampled.
+func ListStats(ctx context.Context, cli *docker.Client, names ...string) ([]Stats, error) {
+	list, err := cli.ContainerList(ctx, types.ContainerListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var include = make(map[string]bool, len(names))
+	for _, n := range names {
+		include[n] = true
+	}
+
+	var (
+		stats = make([]Stats, 0, len(list))
+		mux   sync.Mutex
+		wg    sync.WaitGroup
+	)
+	for _, c := range list {
+		var name = c.Names[0]
+		if name == "/inertia-daemon" || (len(include) > 0 && !include[name]) {
+			continue
+		}
+		wg.Add(1)
+		go func(id, name string) {
+			defer wg.Done()
+			s, err := GetStats(ctx, cli, id)
+			if err != nil {
+				return
+			}
+			s.Name = name
+			mux.Lock()
+			stats = append(stats, s)
+			mux.Unlock()
+		}(c.ID, name)
+	}
+	wg.Wait()
+
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Name < stats[j].Name })
+	return stats, nil
+}
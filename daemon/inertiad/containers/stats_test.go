@@ -0,0 +1,58 @@
+package containers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_statsFromJSON(t *testing.T) {
+	var s types.StatsJSON
+	s.Name = "/web"
+	s.CPUStats = types.CPUStats{
+		CPUUsage:    types.CPUUsage{TotalUsage: 3000000000},
+		SystemUsage: 20000000000,
+		OnlineCPUs:  2,
+	}
+	s.PreCPUStats = types.CPUStats{
+		CPUUsage:    types.CPUUsage{TotalUsage: 2000000000},
+		SystemUsage: 10000000000,
+	}
+	s.MemoryStats = types.MemoryStats{
+		Usage: 300,
+		Limit: 1000,
+		Stats: map[string]uint64{"cache": 100},
+	}
+	s.Networks = map[string]types.NetworkStats{
+		"eth0": {RxBytes: 10, TxBytes: 20},
+		"eth1": {RxBytes: 1, TxBytes: 2},
+	}
+	s.BlkioStats = types.BlkioStats{
+		IoServiceBytesRecursive: []types.BlkioStatEntry{
+			{Major: 8, Op: "Read", Value: 100},
+			{Major: 8, Op: "Write", Value: 50},
+			{Major: 9, Op: "Read", Value: 5},
+			{Major: 8, Op: "Total", Value: 150},
+		},
+	}
+	s.PidsStats = types.PidsStats{Current: 7}
+
+	var stats = statsFromJSON(s)
+	assert.Equal(t, "/web", stats.Name)
+	assert.InDelta(t, 20.0, stats.CPUPercent, 0.001)
+	assert.Equal(t, 3*time.Second, stats.CPUTotal)
+	assert.Equal(t, uint64(200), stats.MemoryUsage)
+	assert.Equal(t, uint64(1000), stats.MemoryLimit)
+	assert.Equal(t, uint64(11), stats.NetworkRx)
+	assert.Equal(t, uint64(22), stats.NetworkTx)
+	assert.Equal(t, uint64(105), stats.BlockRead)
+	assert.Equal(t, uint64(50), stats.BlockWrite)
+	assert.Equal(t, uint64(7), stats.PIDs)
+
+	// no previous sample
+	s.PreCPUStats = types.CPUStats{}
+	s.CPUStats.SystemUsage = 0
+	assert.Zero(t, statsFromJSON(s).CPUPercent)
+}
@@ -0,0 +1,39 @@
+package crypto
+
+import (
+	"io"
+
+	"github.com/minio/sio"
+)
+
+// streamConfig configures stream encryption to use only version 2.0 of the DARE
+// format (https://github.com/minio/sio/blob/master/DARE.md). DARE 2.0 seals a
+// stream in packages of up to 64KB with a random nonce per stream, a sequence
+// number per package, and a flag marking the final package, so that reordered,
+// truncated, or extended streams are rejected.
+func streamConfig(key []byte) sio.Config {
+	return sio.Config{
+		MinVersion: sio.Version20,
+		MaxVersion: sio.Version20,
+		Key:        key,
+	}
+}
+
+// NewEncryptWriter returns a writer that encrypts data written to it using the
+// given key, and writes the result to w. Close must be called to seal the final
+// package - it does not close w. At least one byte must be written, since an
+// empty stream cannot be told apart from a truncated one.
+func NewEncryptWriter(key []byte, w io.Writer) (io.WriteCloser, error) {
+	return sio.EncryptWriter(writerOnly{w}, streamConfig(key))
+}
+
+// NewDecryptReader returns a reader that decrypts data read from r, which must
+// have been encrypted by NewEncryptWriter using the given key. Reads fail if the
+// data has been tampered with or truncated.
+func NewDecryptReader(key []byte, r io.Reader) (io.Reader, error) {
+	return sio.DecryptReader(r, streamConfig(key))
+}
+
+// writerOnly hides any Close method of the underlying writer, which the DARE
+// writer would otherwise call when the stream is sealed
+type writerOnly struct{ io.Writer }
@@ -0,0 +1,96 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// streamPackageSize is the size of a full DARE 2.0 package - a 16 byte header,
+// 64KB of payload, and a 16 byte tag
+const streamPackageSize = 16 + 64*1024 + 16
+
+func encryptStream(t *testing.T, key, plaintext []byte) []byte {
+	var ciphertext bytes.Buffer
+	w, err := NewEncryptWriter(key, &ciphertext)
+	require.NoError(t, err)
+	// write in uneven pieces
+	for p := plaintext; len(p) > 0; {
+		n := 1000
+		if n > len(p) {
+			n = len(p)
+		}
+		_, err := w.Write(p[:n])
+		require.NoError(t, err)
+		p = p[n:]
+	}
+	require.NoError(t, w.Close())
+	return ciphertext.Bytes()
+}
+
+func decryptStream(key, ciphertext []byte) ([]byte, error) {
+	r, err := NewDecryptReader(key, bytes.NewReader(ciphertext))
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadAll(r)
+}
+
+func TestEncryptDecryptStream(t *testing.T) {
+	key := make([]byte, SymmetricKeyLength)
+	rand.Read(key)
+
+	for _, size := range []int{1, 10, 64 * 1024, 64*1024*2 + 17} {
+		plaintext := make([]byte, size)
+		rand.Read(plaintext)
+		ciphertext := encryptStream(t, key, plaintext)
+
+		decrypted, err := decryptStream(key, ciphertext)
+		require.NoError(t, err, "size %d", size)
+		assert.Equal(t, plaintext, decrypted, "size %d", size)
+
+		// bad key
+		badKey := make([]byte, SymmetricKeyLength)
+		rand.Read(badKey)
+		_, err = decryptStream(badKey, ciphertext)
+		assert.Error(t, err, "size %d", size)
+
+		// truncated within the final package
+		_, err = decryptStream(key, ciphertext[:len(ciphertext)-1])
+		assert.Error(t, err, "size %d", size)
+	}
+}
+
+func TestDecryptStream_Tampered(t *testing.T) {
+	key := make([]byte, SymmetricKeyLength)
+	rand.Read(key)
+	plaintext := make([]byte, 64*1024*2+17)
+	rand.Read(plaintext)
+	ciphertext := encryptStream(t, key, plaintext)
+	require.Len(t, ciphertext, 2*streamPackageSize+16+17+16)
+	var (
+		first  = ciphertext[:streamPackageSize]
+		second = ciphertext[streamPackageSize : 2*streamPackageSize]
+		final  = ciphertext[2*streamPackageSize:]
+	)
+	var join = func(packages ...[]byte) []byte { return bytes.Join(packages, nil) }
+
+	for name, tampered := range map[string][]byte{
+		"empty":                 {},
+		"final package dropped": join(first, second),
+		"packages reordered":    join(second, first, final),
+		"package repeated":      join(first, first, second, final),
+		"data after final":      join(first, second, final, final),
+		"spliced from other stream": join(first,
+			encryptStream(t, key, plaintext)[streamPackageSize:2*streamPackageSize], final),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := decryptStream(key, tampered)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
+	"errors"
 )
 
 const (
@@ -53,9 +54,36 @@ func Decrypt(key, ciphertext []byte) ([]byte, error) {
 	}
 
 	nonceSize := aesgcm.NonceSize()
+	if len(ciphertext) < nonceSize {
+		return nil, errors.New("ciphertext is too short")
+	}
 
 	// nonce is stored at beginning of ciphertext
 	nonce := ciphertext[:nonceSize]
 
 	return aesgcm.Open(nil, nonce, ciphertext[nonceSize:], nil)
 }
+
+// EncryptWithPassphrase encrypts plaintext using a key derived from the given
+// passphrase. The salt used to derive the key is stored at the beginning of the
+// returned ciphertext.
+func EncryptWithPassphrase(passphrase string, plaintext []byte) ([]byte, error) {
+	if passphrase == "" {
+		return nil, errors.New("passphrase is required")
+	}
+	salt := GenerateSalt()
+	ciphertext, err := Encrypt(DeriveKey(passphrase, salt), plaintext)
+	if err != nil {
+		return nil, err
+	}
+	return append(salt, ciphertext...), nil
+}
+
+// DecryptWithPassphrase decrypts ciphertext created by EncryptWithPassphrase
+func DecryptWithPassphrase(passphrase string, ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) < KeyDerivationSaltLength {
+		return nil, errors.New("ciphertext is too short")
+	}
+	salt := ciphertext[:KeyDerivationSaltLength]
+	return Decrypt(DeriveKey(passphrase, salt), ciphertext[KeyDerivationSaltLength:])
+}
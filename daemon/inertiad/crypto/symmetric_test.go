@@ -30,3 +30,25 @@ func TestEncryptDecrypt(t *testing.T) {
 	_, err = Decrypt(keyBad, ciphertext)
 	assert.NotNil(t, err)
 }
+
+func TestEncryptDecryptWithPassphrase(t *testing.T) {
+	plaintext := []byte("I'm a little teapot, short and STDOUT")
+
+	_, err := EncryptWithPassphrase("", plaintext)
+	assert.Error(t, err)
+
+	ciphertext, err := EncryptWithPassphrase("hunter7", plaintext)
+	assert.NoError(t, err)
+
+	decrypted, err := DecryptWithPassphrase("hunter7", ciphertext)
+	assert.NoError(t, err)
+	assert.Equal(t, plaintext, decrypted)
+
+	// Wrong passphrase
+	_, err = DecryptWithPassphrase("hunter8", ciphertext)
+	assert.Error(t, err)
+
+	// Garbage input
+	_, err = DecryptWithPassphrase("hunter7", []byte("abc"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,57 @@
+package daemon
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/go-chi/render"
+
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/api/openapi"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/res"
+)
+
+// versionPrefix matches the API version prefix of a path
+var versionPrefix = regexp.MustCompile(`^/v[0-9]+(/|$)`)
+
+// versioned serves the daemon API under api.PathPrefix, and reports the
+// daemon and API versions in every response. Requests for other API versions
+// are rejected, while requests without a version prefix, made by clients that
+// predate versioning, are served as the current version.
+func (s *Server) versioned(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(api.DaemonVersionHeader, s.version)
+		w.Header().Set(api.APIVersionHeader, api.Version)
+
+		var prefix = strings.TrimSuffix(versionPrefix.FindString(r.URL.Path), "/")
+		if prefix == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if prefix != api.PathPrefix {
+			render.Render(w, r, res.ErrNotFound(fmt.Sprintf(
+				"API %s is not supported by this daemon (version %s), which serves API %s",
+				strings.TrimPrefix(prefix, "/"), s.version, api.Version)))
+			return
+		}
+
+		// serve the request as if it was made without the version prefix
+		var r2 = new(http.Request)
+		*r2 = *r
+		r2.URL = new(url.URL)
+		*r2.URL = *r.URL
+		r2.URL.Path = "/" + strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, prefix), "/")
+		r2.URL.RawPath = ""
+		next.ServeHTTP(w, r2)
+	})
+}
+
+// specHandler serves the OpenAPI document describing the daemon API
+func specHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/yaml")
+	w.WriteHeader(http.StatusOK)
+	w.Write(openapi.Raw())
+}
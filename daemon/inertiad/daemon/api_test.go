@@ -0,0 +1,132 @@
+package daemon
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/api/openapi"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/auth"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/crypto"
+)
+
+// newTestLogger returns a logger that discards everything
+func newTestLogger() *logrus.Entry {
+	logger, _ := test.NewNullLogger()
+	return logrus.NewEntry(logger)
+}
+
+func newTestRoutes(t *testing.T) (*Server, *auth.PermissionsHandler, func()) {
+	dir, err := ioutil.TempDir("", "inertia-api")
+	require.NoError(t, err)
+	permissions, err := auth.NewPermissionsHandler(filepath.Join(dir, "users.db"),
+		"127.0.0.1", 3000, newTestLogger(), crypto.GetFakeAPIKey)
+	require.NoError(t, err)
+	var s = &Server{
+		version:     "test",
+		permissions: permissions,
+		metrics:     newServerMetrics(nil, nil, newTestLogger()),
+	}
+	s.attachRoutes(permissions)
+	return s, permissions, func() {
+		permissions.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestServer_routesMatchSpec(t *testing.T) {
+	_, permissions, cleanup := newTestRoutes(t)
+	defer cleanup()
+	doc, err := openapi.Load()
+	require.NoError(t, err)
+
+	// every route served must be documented, with the access it requires
+	var served = map[string]bool{}
+	for _, route := range permissions.Routes() {
+		var method = route.Method
+		if method == "*" {
+			// routes served for any method are documented as GET
+			method = http.MethodGet
+		}
+		served[method+" "+route.Path] = true
+
+		var item, found = doc.Paths[route.Path]
+		if !assert.True(t, found, "%s %s is not documented", method, route.Path) {
+			continue
+		}
+		var endpoint openapi.Endpoint
+		for _, e := range doc.Endpoints() {
+			if e.Method == method && e.Path == route.Path {
+				endpoint = e
+			}
+		}
+		if !assert.NotNil(t, endpoint.Operation, "%s %s is not documented", method, route.Path) {
+			continue
+		}
+		assert.Equal(t, route.Access, endpoint.Access,
+			"%s %s is documented with the wrong access", method, route.Path)
+
+		// paths outside the API are served from the root
+		var outside = route.Path == "/" || route.Path == "/webhook" || route.Path == "/metrics" ||
+			route.Path == "/dashboard"
+		assert.Equal(t, outside, len(item.Servers) > 0,
+			"%s %s is documented under the wrong server", method, route.Path)
+	}
+
+	// every documented endpoint must be served
+	for _, e := range doc.Endpoints() {
+		assert.True(t, served[e.Method+" "+e.Path], "%s %s is documented but not served",
+			e.Method, e.Path)
+	}
+}
+
+func TestServer_versioned(t *testing.T) {
+	s, permissions, cleanup := newTestRoutes(t)
+	defer cleanup()
+	var handler = s.versioned(permissions)
+
+	for _, tt := range []struct {
+		name     string
+		method   string
+		path     string
+		wantCode int
+	}{
+		{"versioned", "GET", "/v1/openapi.yaml", http.StatusOK},
+		{"versioned root", "GET", "/v1", http.StatusOK},
+		{"unversioned", "GET", "/openapi.yaml", http.StatusOK},
+		{"unversioned restricted", "GET", "/status", http.StatusUnauthorized},
+		{"versioned restricted", "GET", "/v1/status", http.StatusUnauthorized},
+		{"unsupported version", "GET", "/v2/status", http.StatusNotFound},
+		{"unknown endpoint", "GET", "/v1/nope", http.StatusNotFound},
+		{"wrong method", "POST", "/v1/openapi.yaml", http.StatusMethodNotAllowed},
+		{"dashboard", "GET", "/dashboard/", http.StatusOK},
+		{"dashboard redirect", "GET", "/dashboard", http.StatusMovedPermanently},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var recorder = httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(tt.method, tt.path, nil))
+			assert.Equal(t, tt.wantCode, recorder.Code)
+			assert.Equal(t, "test", recorder.Header().Get(api.DaemonVersionHeader))
+			assert.Equal(t, api.Version, recorder.Header().Get(api.APIVersionHeader))
+		})
+	}
+
+	var recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/v2/status", nil))
+	b, err := api.Unmarshal(recorder.Body)
+	require.NoError(t, err)
+	assert.Contains(t, b.Message, "API v2 is not supported")
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/v1/openapi.yaml", nil))
+	assert.Equal(t, openapi.Raw(), recorder.Body.Bytes())
+}
@@ -0,0 +1,160 @@
+package daemon
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/backup"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/containers"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/log"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/res"
+)
+
+// redacted replaces secret values in responses
+const redacted = "<redacted>"
+
+// backupsHandler lists or creates backups of persistent data
+func (s *Server) backupsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == "POST" {
+		backupsPostHandler(s, w, r)
+	} else if r.Method == "GET" {
+		backupsGetHandler(s, w, r)
+	}
+}
+
+func backupsPostHandler(s *Server, w http.ResponseWriter, r *http.Request) {
+	created, err := s.backups.Create(r.Context())
+	if err != nil {
+		render.Render(w, r, res.ErrInternalServer("failed to create backup", err))
+		return
+	}
+
+	render.Render(w, r, res.Msg("backup created", http.StatusCreated,
+		"backup", created))
+}
+
+func backupsGetHandler(s *Server, w http.ResponseWriter, r *http.Request) {
+	backups, err := s.backups.List(r.Context())
+	if err != nil {
+		render.Render(w, r, res.ErrInternalServer("failed to list backups", err))
+		return
+	}
+
+	render.Render(w, r, res.MsgOK("backups retrieved",
+		"backups", backups))
+}
+
+// backupsRestoreHandler shuts down the project and replaces its persistent
+// data with the contents of a backup
+func (s *Server) backupsRestoreHandler(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		render.Render(w, r, res.ErrBadRequest(err.Error()))
+		return
+	}
+	defer r.Body.Close()
+	var backupReq api.BackupRequest
+	if err = json.Unmarshal(body, &backupReq); err != nil {
+		render.Render(w, r, res.ErrBadRequest(err.Error()))
+		return
+	}
+	if backupReq.Name == "" {
+		render.Render(w, r, res.ErrBadRequest("no backup name provided"))
+		return
+	}
+	if !backup.IsValidName(backupReq.Name) {
+		render.Render(w, r, res.ErrBadRequest("invalid backup name", "name", backupReq.Name))
+		return
+	}
+
+	// extract the backup first, so that the project stays online if the backup
+	// can't be restored
+	staged, err := s.backups.Stage(r.Context(), backupReq.Name)
+	if err != nil {
+		render.Render(w, r, res.ErrInternalServer("failed to restore backup", err))
+		return
+	}
+	defer staged.Discard()
+
+	// containers must not write to the data while it is replaced
+	if s.deployment != nil {
+		if err := s.deployment.Down(s.docker, log.Writer(s.requestLogger(r), logrus.InfoLevel)); err != nil && err != containers.ErrNoContainers {
+			render.Render(w, r, res.ErrInternalServer("failed to shut down project", err))
+			return
+		}
+	}
+
+	if err := staged.Apply(); err != nil {
+		render.Render(w, r, res.ErrInternalServer("failed to restore backup", err))
+		return
+	}
+
+	render.Render(w, r, res.MsgOK(
+		"backup restored - run 'inertia [remote] up' to bring your project back online",
+		"backup", backupReq.Name))
+}
+
+// backupsConfigHandler manages the backup configuration
+func (s *Server) backupsConfigHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == "POST" {
+		backupsConfigPostHandler(s, w, r)
+	} else if r.Method == "GET" {
+		backupsConfigGetHandler(s, w, r)
+	}
+}
+
+func backupsConfigPostHandler(s *Server, w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		render.Render(w, r, res.ErrBadRequest(err.Error()))
+		return
+	}
+	defer r.Body.Close()
+	var conf api.BackupConfiguration
+	if err = json.Unmarshal(body, &conf); err != nil {
+		render.Render(w, r, res.ErrBadRequest(err.Error()))
+		return
+	}
+
+	manager, found := s.deployment.GetDataManager()
+	if !found {
+		render.Render(w, r, res.Err("no data manager found", http.StatusPreconditionFailed))
+		return
+	}
+
+	if err := s.backups.Configure(conf); err != nil {
+		render.Render(w, r, res.ErrBadRequest(err.Error()))
+		return
+	}
+	if err := manager.SetBackupConfiguration(s.backups.GetConfiguration()); err != nil {
+		render.Render(w, r, res.ErrInternalServer("failed to save backup configuration", err))
+		return
+	}
+
+	render.Render(w, r, res.MsgOK("backup configuration updated"))
+}
+
+func backupsConfigGetHandler(s *Server, w http.ResponseWriter, r *http.Request) {
+	render.Render(w, r, res.MsgOK("backup configuration retrieved",
+		"config", redactBackupConfiguration(s.backups.GetConfiguration())))
+}
+
+// redactBackupConfiguration hides credentials in the given configuration
+func redactBackupConfiguration(conf api.BackupConfiguration) api.BackupConfiguration {
+	if conf.Passphrase != "" {
+		conf.Passphrase = redacted
+	}
+	if conf.S3 != nil {
+		var s3 = *conf.S3
+		if s3.SecretAccessKey != "" {
+			s3.SecretAccessKey = redacted
+		}
+		conf.S3 = &s3
+	}
+	return conf
+}
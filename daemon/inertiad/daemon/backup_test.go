@@ -0,0 +1,126 @@
+package daemon
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/backup"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/project/mocks"
+)
+
+func newBackupTestServer(t *testing.T) (*Server, *mocks.FakeDeployer, string) {
+	dir, err := ioutil.TempDir("", "inertia-backup-handler")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	var persist = filepath.Join(dir, "persist")
+	require.NoError(t, os.MkdirAll(persist, 0755))
+	var fake = &mocks.FakeDeployer{}
+	return &Server{
+		logger:     newTestLogger(),
+		deployment: fake,
+		backups:    backup.NewManager(persist, filepath.Join(dir, "backups"), nil),
+	}, fake, persist
+}
+
+func TestBackupsHandler(t *testing.T) {
+	s, fake, persist := newBackupTestServer(t)
+	var handler = http.HandlerFunc(s.backupsHandler)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(persist, "data"), []byte("hello"), 0644))
+
+	// create a backup
+	req, err := http.NewRequest("POST", "/backups", nil)
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+
+	// list backups
+	req, err = http.NewRequest("GET", "/backups", nil)
+	require.NoError(t, err)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var backups []api.Backup
+	_, err = api.Unmarshal(recorder.Body, api.KV{Key: "backups", Value: &backups})
+	require.NoError(t, err)
+	require.Len(t, backups, 1)
+
+	// restore it
+	require.NoError(t, ioutil.WriteFile(filepath.Join(persist, "data"), []byte("oops"), 0644))
+	var restore = http.HandlerFunc(s.backupsRestoreHandler)
+	for _, tt := range []struct {
+		name     string
+		req      api.BackupRequest
+		wantCode int
+	}{
+		{"no name", api.BackupRequest{}, http.StatusBadRequest},
+		{"invalid name", api.BackupRequest{Name: "../data"}, http.StatusBadRequest},
+		{"missing backup", api.BackupRequest{Name: "persist-20201019T120000.000Z.tar.gz"},
+			http.StatusInternalServerError},
+		{"ok", api.BackupRequest{Name: backups[0].Name}, http.StatusOK},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(tt.req)
+			req, err := http.NewRequest("POST", "/backups/restore", bytes.NewReader(body))
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			restore.ServeHTTP(recorder, req)
+			assert.Equal(t, tt.wantCode, recorder.Code)
+		})
+	}
+	data, err := ioutil.ReadFile(filepath.Join(persist, "data"))
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(data))
+	// the project is only shut down once the backup is ready to be restored
+	assert.Equal(t, 1, fake.DownCallCount())
+}
+
+func TestBackupsConfigHandler(t *testing.T) {
+	s, _, _ := newBackupTestServer(t)
+	var handler = http.HandlerFunc(s.backupsConfigHandler)
+
+	t.Run("no data manager", func(t *testing.T) {
+		body, _ := json.Marshal(api.BackupConfiguration{Keep: 3})
+		req, err := http.NewRequest("POST", "/backups/config", bytes.NewReader(body))
+		require.NoError(t, err)
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, req)
+		assert.Equal(t, http.StatusPreconditionFailed, recorder.Code)
+	})
+
+	t.Run("secrets are redacted", func(t *testing.T) {
+		require.NoError(t, s.backups.Configure(api.BackupConfiguration{
+			Storage:    api.BackupStorageS3,
+			Passphrase: "hunter2",
+			S3: &api.S3Configuration{
+				Bucket:          "backups",
+				AccessKeyID:     "robert",
+				SecretAccessKey: "tables",
+			},
+		}))
+		req, err := http.NewRequest("GET", "/backups/config", nil)
+		require.NoError(t, err)
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, req)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+
+		var conf api.BackupConfiguration
+		_, err = api.Unmarshal(recorder.Body, api.KV{Key: "config", Value: &conf})
+		require.NoError(t, err)
+		assert.Equal(t, redacted, conf.Passphrase)
+		assert.Equal(t, redacted, conf.S3.SecretAccessKey)
+		assert.Equal(t, "robert", conf.S3.AccessKeyID)
+
+		// the active configuration is untouched
+		assert.Equal(t, "hunter2", s.backups.GetConfiguration().Passphrase)
+	})
+}
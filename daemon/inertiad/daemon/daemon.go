@@ -1,19 +1,29 @@
 package daemon
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"os"
 	"path"
+	"sync"
 	"time"
 
 	docker "github.com/docker/docker/client"
 	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/writer"
+	"github.com/ubclaunchpad/inertia/api"
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/auth"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/backup"
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/cfg"
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/containers"
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/crypto"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/disk"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/log"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/logsink"
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/project"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/web"
 )
 
 // Server is the core component of Inertiad, and hosts its API and deployment manager
@@ -22,13 +32,27 @@ type Server struct {
 
 	deployment project.Deployer
 	state      cfg.Config
+	backups    *backup.Manager
+	metrics    *serverMetrics
+	disk       *disk.Watcher
+	logger     *logrus.Entry
+	logSinks   *logsink.Forwarder
+	logs       *logFollower
+	events     *eventBroker
 
-	docker    *docker.Client
-	websocket *websocket.Upgrader
+	docker      *docker.Client
+	websocket   *websocket.Upgrader
+	permissions *auth.PermissionsHandler
+
+	// serving guards http and shutdown, which records that a shutdown was
+	// requested before the daemon started serving
+	serving  sync.Mutex
+	http     *http.Server
+	shutdown bool
 }
 
-// New instantiates a new Inertiad server
-func New(version string, state cfg.Config, deployment project.Deployer) (*Server, error) {
+// New instantiates a new Inertiad server, which writes its logs to logger
+func New(version string, state cfg.Config, deployment project.Deployer, logger *logrus.Entry) (*Server, error) {
 	// Establish connection with dockerd
 	cli, err := containers.NewDockerClient()
 	if err != nil {
@@ -36,13 +60,33 @@ func New(version string, state cfg.Config, deployment project.Deployer) (*Server
 	}
 
 	// Download build tools
-	go downloadDeps(cli, state.DockerComposeVersion)
+	go downloadDeps(cli, logger, state.DockerComposeVersion)
+
+	// Forward the daemon's own logs to log sinks, once they are configured
+	var sinks = logsink.NewForwarder(logsink.Options{
+		OnError: func(sink string, err error) {
+			logger.WithError(err).WithField("sink", sink).Warn("failed to forward logs")
+		},
+	})
+	logger.Logger.AddHook(&writer.Hook{
+		Writer:    sinks.Writer(logsink.SourceDaemon),
+		LogLevels: logrus.AllLevels,
+	})
 
 	return &Server{
 		version: version,
 
 		deployment: deployment,
 		state:      state,
+		backups: backup.NewManager(state.PersistDirectory,
+			path.Join(state.DataDirectory, "backups"),
+			log.Writer(logger.WithField("component", "backup"), logrus.InfoLevel)),
+		metrics:  newServerMetrics(cli, deployment, logger),
+		disk:     newDiskWatcher(cli, state, deployment, logger),
+		logger:   logger,
+		logSinks: sinks,
+		logs:     newLogFollower(cli, sinks, state.DockerComposeVersion, logger),
+		events:   newEventBroker(logger.WithField("component", "events")),
 
 		docker: cli,
 		websocket: &websocket.Upgrader{
@@ -68,56 +112,196 @@ func (s *Server) Run(host, port string) error {
 
 	// If they are not available, generate new ones.
 	if keyNotPresent && certNotPresent {
-		fmt.Printf("No certificates found in %s - generating new ones...", sslDir)
+		s.logger.WithField("directory", sslDir).Info("no certificates found - generating new ones")
 		if err = crypto.GenerateCertificate(cert, key, host+":"+port, "RSA"); err != nil {
 			return err
 		}
 	} else {
-		fmt.Printf("Found certificates in %s (%s, %s)",
-			sslDir, cert, key)
+		s.logger.WithFields(logrus.Fields{"cert": cert, "key": key}).Info("found certificates")
 	}
 
+	// Restore the previous deployment, if there is one
+	if _, err := s.deployment.Restore(s.docker, log.Writer(s.logger, logrus.InfoLevel)); err != nil {
+		s.logger.WithError(err).Error("failed to restore deployment")
+	}
+
+	// Restore the webhook secret, which may have been changed since the daemon
+	// was started
+	if manager, found := s.deployment.GetDataManager(); found {
+		if secret, found, err := manager.GetWebhookSecret(); err != nil {
+			s.logger.WithError(err).Error("failed to load webhook secret")
+		} else if found {
+			s.state.WebhookSecret = secret
+		}
+	}
+
+	// Restore backup configuration
+	if manager, found := s.deployment.GetDataManager(); found {
+		if conf, found, err := manager.GetBackupConfiguration(); err != nil {
+			s.logger.WithError(err).Error("failed to load backup configuration")
+		} else if found {
+			if err := s.backups.Configure(*conf); err != nil {
+				s.logger.WithError(err).Error("failed to apply backup configuration")
+			}
+		}
+	}
+
+	// Restore disk configuration, and start watching for low disk space
+	if manager, found := s.deployment.GetDataManager(); found {
+		if conf, found, err := manager.GetDiskConfiguration(); err != nil {
+			s.logger.WithError(err).Error("failed to load disk configuration")
+		} else if found {
+			if err := s.disk.Configure(*conf); err != nil {
+				s.logger.WithError(err).Error("failed to apply disk configuration")
+			}
+		}
+	}
+	s.disk.Start(diskCheckInterval)
+
+	// Restore log sink configuration, and start forwarding container logs
+	if manager, found := s.deployment.GetDataManager(); found {
+		if conf, found, err := manager.GetLogSinkConfiguration(); err != nil {
+			s.logger.WithError(err).Error("failed to load log sink configuration")
+		} else if found {
+			if err := s.logSinks.Configure(*conf); err != nil {
+				s.logger.WithError(err).Error("failed to apply log sink configuration")
+			}
+		}
+	}
+	s.logs.Start(logFollowInterval)
+
 	// Watch container events
+	s.events.WatchContainers(s.docker)
 	go func() {
 		logsCh, errCh := s.deployment.Watch(s.docker)
 		for {
 			select {
 			case err := <-errCh:
 				if err != nil {
-					println(err.Error())
+					s.logger.WithError(err).Error("stopped watching containers")
 					return
 				}
 			case event := <-logsCh:
-				println(event)
+				s.logger.Info(event)
 			}
 		}
 	}()
 
 	// Set up endpoints
-	handler, err := auth.NewPermissionsHandler(path.Join(s.state.DataDirectory, "users.db"), host, 120)
+	handler, err := auth.NewPermissionsHandler(path.Join(s.state.DataDirectory, "users.db"), host, 120,
+		s.logger)
 	if err != nil {
 		return err
 	}
 	defer handler.Close()
-	println("Permissions manager successfully created")
+	s.permissions = handler
+	handler.OnLoginFailure(s.metrics.loginFailed)
+	handler.OnLogin(func(user string) {
+		s.events.Publish(api.EventUserLoggedIn, fmt.Sprintf("user %s logged in", user),
+			"user", user)
+	})
+	s.logger.Debug("permissions manager successfully created")
+
+	// Restore metrics configuration
+	if manager, found := s.deployment.GetDataManager(); found {
+		if conf, found, err := manager.GetMetricsConfiguration(); err != nil {
+			s.logger.WithError(err).Error("failed to load metrics configuration")
+		} else if found {
+			if err := handler.SetScrapeAccess(conf.ScrapeToken, conf.AllowedNetworks); err != nil {
+				s.logger.WithError(err).Error("failed to apply metrics configuration")
+			}
+		}
+	}
+
+	s.attachRoutes(handler)
+
+	// Serve daemon on port
+	s.serving.Lock()
+	if s.shutdown {
+		s.serving.Unlock()
+		s.logger.Info("shutdown requested during startup, not serving daemon")
+		return nil
+	}
+	var server = &http.Server{
+		Addr:    ":" + port,
+		Handler: s.versioned(handler),
+	}
+	s.http = server
+	s.serving.Unlock()
+	s.logger.WithField("port", port).Info("serving daemon")
+	if err := server.ListenAndServeTLS(cert, key); err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+// attachRoutes registers the daemon's endpoints with the handler. API
+// endpoints must also be described in the API's OpenAPI document - see
+// package api/openapi.
+func (s *Server) attachRoutes(handler *auth.PermissionsHandler) {
+	// Metrics endpoint, restricted to scrapers
+	handler.AttachScrapeRestrictedHandler("/metrics", s.metrics.handler())
 
 	// GitHub webhook endpoint
 	handler.AttachPublicHandlerFunc("/webhook",
 		s.webhookHandler, http.MethodPost)
 
+	// Dashboard, which uses the API with a session cookie
+	handler.AttachPublicHandlerFunc("/dashboard", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/dashboard/", http.StatusMovedPermanently)
+	}, http.MethodGet)
+	handler.AttachPublicHandlerFunc("/dashboard/*",
+		web.Handler("/dashboard").ServeHTTP, http.MethodGet)
+
 	// API endpoints
+	handler.AttachPublicHandlerFunc("/openapi.yaml",
+		specHandler, http.MethodGet)
 	handler.AttachUserRestrictedHandlerFunc("/status",
 		s.statusHandler, http.MethodGet)
 	handler.AttachUserRestrictedHandlerFunc("/logs",
 		s.logHandler, http.MethodGet)
+	handler.AttachUserRestrictedHandlerFunc("/stats",
+		s.statsHandler, http.MethodGet)
+	handler.AttachUserRestrictedHandlerFunc("/events",
+		s.eventsHandler, http.MethodGet)
+	handler.AttachUserRestrictedHandlerFunc("/history",
+		s.historyHandler, http.MethodGet)
+	handler.AttachAdminRestrictedHandlerFunc("/containers/address",
+		s.containerAddressHandler, http.MethodGet)
+	handler.AttachAdminRestrictedHandlerFunc("/plan",
+		s.planHandler, http.MethodPost)
 	handler.AttachAdminRestrictedHandlerFunc("/up",
 		s.upHandler, http.MethodPost)
+	handler.AttachAdminRestrictedHandlerFunc("/redeploy",
+		s.redeployHandler, http.MethodPost)
 	handler.AttachAdminRestrictedHandlerFunc("/down",
 		s.downHandler, http.MethodPost)
 	handler.AttachAdminRestrictedHandlerFunc("/reset",
 		s.resetHandler, http.MethodPost)
 	handler.AttachAdminRestrictedHandlerFunc("/env",
 		s.envHandler, http.MethodGet, http.MethodPost)
+	handler.AttachAdminRestrictedHandlerFunc("/env/export",
+		s.envExportHandler, http.MethodPost)
+	handler.AttachAdminRestrictedHandlerFunc("/env/import",
+		s.envImportHandler, http.MethodPost)
+	handler.AttachAdminRestrictedHandlerFunc("/env/rotate-key",
+		s.envRotateKeyHandler, http.MethodPost)
+	handler.AttachAdminRestrictedHandlerFunc("/secrets",
+		s.secretsHandler, http.MethodGet, http.MethodPost)
+	handler.AttachAdminRestrictedHandlerFunc("/backups",
+		s.backupsHandler, http.MethodGet, http.MethodPost)
+	handler.AttachAdminRestrictedHandlerFunc("/backups/restore",
+		s.backupsRestoreHandler, http.MethodPost)
+	handler.AttachAdminRestrictedHandlerFunc("/backups/config",
+		s.backupsConfigHandler, http.MethodGet, http.MethodPost)
+	handler.AttachAdminRestrictedHandlerFunc("/migrate/export",
+		s.migrateExportHandler, http.MethodPost)
+	handler.AttachAdminRestrictedHandlerFunc("/migrate/import",
+		s.migrateImportHandler, http.MethodPost)
+	handler.AttachAdminRestrictedHandlerFunc("/metrics/config",
+		s.metricsConfigHandler, http.MethodGet, http.MethodPost)
+	handler.AttachAdminRestrictedHandlerFunc("/disk/config",
+		s.diskConfigHandler, http.MethodGet, http.MethodPost)
 	handler.AttachAdminRestrictedHandlerFunc("/prune",
 		s.pruneHandler, http.MethodPost)
 	handler.AttachAdminRestrictedHandlerFunc("/token",
@@ -127,18 +311,32 @@ func (s *Server) Run(host, port string) error {
 	handler.AttachPublicHandlerFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 	})
+}
 
-	// Serve daemon on port
-	println("Serving daemon on port " + port)
-	return http.ListenAndServeTLS(
-		":"+port,
-		cert,
-		key,
-		handler)
+// Shutdown gracefully stops serving requests, causing Run to return. If the
+// daemon is still starting up, Run returns once startup completes instead of
+// serving. Project containers are left running, so that a restarted daemon can
+// reattach to them.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.serving.Lock()
+	s.shutdown = true
+	var server = s.http
+	s.serving.Unlock()
+	if server == nil {
+		return nil
+	}
+	return server.Shutdown(ctx)
 }
 
-// Close releases server assets
+// Close releases server assets. Project containers are left running - use
+// the deployment's Down to stop them.
 func (s *Server) Close() {
-	s.deployment.Down(s.docker, os.Stdout)
+	if s.backups != nil {
+		s.backups.Close()
+	}
+	s.disk.Close()
+	s.logs.Close()
+	s.events.Close()
+	s.logSinks.Close()
 	s.docker.Close()
 }
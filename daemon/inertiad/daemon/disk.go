@@ -0,0 +1,96 @@
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	docker "github.com/docker/docker/client"
+	"github.com/go-chi/render"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/cfg"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/containers"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/disk"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/log"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/project"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/res"
+)
+
+// diskCheckInterval is how often free disk space is checked in the background
+const diskCheckInterval = 5 * time.Minute
+
+// newDiskWatcher creates a watcher over the filesystems holding Docker's data
+// root and the daemon's host directories, which prunes unused Docker assets -
+// keeping images of existing containers, so that deployments can be rolled
+// back - and warns through the deployment's notifiers when space runs low
+func newDiskWatcher(cli *docker.Client, state cfg.Config, deployment project.Deployer,
+	logger *logrus.Entry) *disk.Watcher {
+	var paths []string
+	for _, p := range []string{state.DockerRootDirectory, state.DataDirectory} {
+		if p != "" {
+			paths = append(paths, p)
+		}
+	}
+	return disk.NewWatcher(disk.Options{
+		Paths: paths,
+		Prune: []disk.PruneFunc{
+			func(ctx context.Context) (uint64, error) {
+				return containers.PruneDangling(ctx, cli)
+			},
+			func(ctx context.Context) (uint64, error) {
+				return containers.PruneUnusedImages(ctx, cli, state.DockerComposeVersion)
+			},
+		},
+		Notify: deployment.Notify,
+		Out:    log.Writer(logger.WithField("component", "disk"), logrus.WarnLevel),
+	})
+}
+
+// diskConfigHandler configures (POST) or retrieves (GET) disk monitoring
+func (s *Server) diskConfigHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == "POST" {
+		diskConfigPostHandler(s, w, r)
+	} else if r.Method == "GET" {
+		diskConfigGetHandler(s, w, r)
+	}
+}
+
+func diskConfigPostHandler(s *Server, w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		render.Render(w, r, res.ErrBadRequest(err.Error()))
+		return
+	}
+	defer r.Body.Close()
+	var conf api.DiskConfiguration
+	if err = json.Unmarshal(body, &conf); err != nil {
+		render.Render(w, r, res.ErrBadRequest(err.Error()))
+		return
+	}
+
+	manager, found := s.deployment.GetDataManager()
+	if !found {
+		render.Render(w, r, res.Err("no data manager found", http.StatusPreconditionFailed))
+		return
+	}
+
+	if err := s.disk.Configure(conf); err != nil {
+		render.Render(w, r, res.ErrBadRequest(err.Error()))
+		return
+	}
+	if err := manager.SetDiskConfiguration(s.disk.GetConfiguration()); err != nil {
+		render.Render(w, r, res.ErrInternalServer("failed to save disk configuration", err))
+		return
+	}
+
+	render.Render(w, r, res.MsgOK("disk configuration updated"))
+}
+
+func diskConfigGetHandler(s *Server, w http.ResponseWriter, r *http.Request) {
+	render.Render(w, r, res.MsgOK("disk configuration retrieved",
+		"config", s.disk.GetConfiguration()))
+}
@@ -0,0 +1,75 @@
+package daemon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/disk"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/project"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/project/mocks"
+)
+
+func TestDiskConfigHandler(t *testing.T) {
+	dir, err := ioutil.TempDir("", "inertia-disk-handler")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	manager, err := project.NewDataManager(filepath.Join(dir, "project.db"), filepath.Join(dir, "key"))
+	require.NoError(t, err)
+	var fake = &mocks.FakeDeployer{}
+	fake.GetDataManagerReturns(manager, true)
+	var s = &Server{
+		logger:     newTestLogger(),
+		deployment: fake,
+		disk:       disk.NewWatcher(disk.Options{Paths: []string{dir}, Notify: fake.Notify}),
+	}
+	var handler = http.HandlerFunc(s.diskConfigHandler)
+
+	for _, tt := range []struct {
+		name     string
+		conf     api.DiskConfiguration
+		wantCode int
+	}{
+		{"invalid threshold", api.DiskConfiguration{MinimumFree: "lots"}, http.StatusBadRequest},
+		{"ok", api.DiskConfiguration{MinimumFree: "99.99%"}, http.StatusOK},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(tt.conf)
+			req, err := http.NewRequest("POST", "/disk/config", bytes.NewReader(body))
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			assert.Equal(t, tt.wantCode, recorder.Code)
+		})
+	}
+
+	// configuration should be saved and applied
+	req, err := http.NewRequest("GET", "/disk/config", nil)
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var conf api.DiskConfiguration
+	_, err = api.Unmarshal(recorder.Body, api.KV{Key: "config", Value: &conf})
+	require.NoError(t, err)
+	assert.Equal(t, "99.99%", conf.MinimumFree)
+	stored, found, err := manager.GetDiskConfiguration()
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, conf, *stored)
+
+	// no disk is that empty, so builds should be refused, and notifiers warned
+	err = s.disk.Check(context.Background())
+	assert.True(t, errors.Is(err, disk.ErrInsufficientSpace))
+	assert.Equal(t, 1, fake.NotifyCallCount())
+}
@@ -2,9 +2,9 @@ package daemon
 
 import (
 	"net/http"
-	"os"
 
 	"github.com/go-chi/render"
+	"github.com/sirupsen/logrus"
 
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/containers"
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/log"
@@ -24,10 +24,10 @@ func (s *Server) downHandler(w http.ResponseWriter, r *http.Request) {
 
 	var stream = log.NewStreamer(log.StreamerOptions{
 		Request:    r,
-		Stdout:     os.Stdout,
+		Stdout:     log.Writer(s.requestLogger(r), logrus.InfoLevel),
 		HTTPWriter: w,
 	})
-	defer s.Close()
+	defer stream.Close()
 
 	if err := s.deployment.Down(s.docker, stream); err == containers.ErrNoContainers {
 		stream.Error(res.Err(err.Error(), http.StatusPreconditionFailed))
@@ -13,6 +13,7 @@ import (
 
 func TestDownHandlerNoDeployment(t *testing.T) {
 	var s = &Server{
+		logger: newTestLogger(),
 		deployment: &mocks.FakeDeployer{
 			GetStatusStub: func(*docker.Client) (api.DeploymentStatus, error) {
 				return api.DeploymentStatus{
@@ -34,3 +35,19 @@ func TestDownHandlerNoDeployment(t *testing.T) {
 	assert.Equal(t, recorder.Code, http.StatusPreconditionFailed)
 	assert.Contains(t, recorder.Body.String(), msgNoDeployment)
 }
+
+func TestRedeployHandlerNoDeployment(t *testing.T) {
+	var s = &Server{
+		logger: newTestLogger(),
+		deployment: &mocks.FakeDeployer{
+			GetStatusStub: func(*docker.Client) (api.DeploymentStatus, error) {
+				return api.DeploymentStatus{}, nil
+			},
+		},
+	}
+
+	recorder := httptest.NewRecorder()
+	s.redeployHandler(recorder, httptest.NewRequest("POST", "/redeploy", nil))
+	assert.Equal(t, http.StatusPreconditionFailed, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), msgNoDeployment)
+}
@@ -2,12 +2,15 @@ package daemon
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 
 	"github.com/go-chi/render"
 
 	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/project"
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/res"
 )
 
@@ -46,10 +49,11 @@ func envPostHandler(s *Server, w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Add, update, or remove values from storage
+	var scope = project.EnvScope{Profile: envReq.Profile, Container: envReq.Container}
 	if envReq.Remove {
-		err = manager.RemoveEnvVariables(envReq.Name)
+		err = manager.RemoveScopedEnvVariables(scope, envReq.Name)
 	} else {
-		err = manager.AddEnvVariable(
+		err = manager.AddScopedEnvVariable(scope,
 			envReq.Name, envReq.Value, envReq.Encrypt,
 		)
 	}
@@ -57,6 +61,14 @@ func envPostHandler(s *Server, w http.ResponseWriter, r *http.Request) {
 		render.Render(w, r, res.ErrInternalServer("failed to update variable", err))
 		return
 	}
+	var action = "updated"
+	if envReq.Remove {
+		action = "removed"
+	}
+	s.events.Publish(api.EventEnvChanged,
+		fmt.Sprintf("environment variable %s %s", envReq.Name, action),
+		"variable", envReq.Name, "action", action,
+		"profile", envReq.Profile, "container", envReq.Container)
 
 	render.Render(w, r, res.Msg(
 		"environment variable updated - this will be applied the next time your container is started",
@@ -71,7 +83,7 @@ func envGetHandler(s *Server, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	values, err := manager.GetEnvVariables(false)
+	values, err := manager.ListEnvVariables()
 	if err != nil {
 		render.Render(w, r, res.ErrInternalServer("failed to retrieve environment variables", err))
 		return
@@ -80,3 +92,93 @@ func envGetHandler(s *Server, w http.ResponseWriter, r *http.Request) {
 	render.Render(w, r, res.Msg("configured environment variables retrieved", http.StatusOK,
 		"variables", values))
 }
+
+// envExportHandler exports all environment variables, encrypted with the
+// requested passphrase
+func (s *Server) envExportHandler(w http.ResponseWriter, r *http.Request) {
+	var req api.EnvTransferRequest
+	if err := readEnvTransferRequest(r, &req); err != nil {
+		render.Render(w, r, res.ErrBadRequest(err.Error()))
+		return
+	}
+
+	manager, found := s.deployment.GetDataManager()
+	if !found {
+		render.Render(w, r, res.Err("no environment manager found", http.StatusPreconditionFailed))
+		return
+	}
+
+	data, err := manager.ExportEnvVariables(req.Passphrase)
+	if err != nil {
+		render.Render(w, r, res.ErrInternalServer("failed to export environment variables", err))
+		return
+	}
+
+	render.Render(w, r, res.MsgOK("environment variables exported",
+		"data", data))
+}
+
+// envImportHandler imports environment variables exported by envExportHandler
+func (s *Server) envImportHandler(w http.ResponseWriter, r *http.Request) {
+	var req api.EnvTransferRequest
+	if err := readEnvTransferRequest(r, &req); err != nil {
+		render.Render(w, r, res.ErrBadRequest(err.Error()))
+		return
+	}
+	if len(req.Data) == 0 {
+		render.Render(w, r, res.ErrBadRequest("no variables provided"))
+		return
+	}
+
+	manager, found := s.deployment.GetDataManager()
+	if !found {
+		render.Render(w, r, res.Err("no environment manager found", http.StatusPreconditionFailed))
+		return
+	}
+
+	count, err := manager.ImportEnvVariables(req.Passphrase, req.Data)
+	if err != nil {
+		render.Render(w, r, res.ErrBadRequest("failed to import environment variables",
+			"error", err))
+		return
+	}
+	s.events.Publish(api.EventEnvChanged,
+		fmt.Sprintf("%d environment variables imported", count),
+		"action", "imported", "count", count)
+
+	render.Render(w, r, res.Msg(
+		"environment variables imported - these will be applied the next time your container is started",
+		http.StatusAccepted,
+		"count", count))
+}
+
+// envRotateKeyHandler re-encrypts all environment variables with a new key
+func (s *Server) envRotateKeyHandler(w http.ResponseWriter, r *http.Request) {
+	manager, found := s.deployment.GetDataManager()
+	if !found {
+		render.Render(w, r, res.Err("no environment manager found", http.StatusPreconditionFailed))
+		return
+	}
+
+	if err := manager.RotateKey(); err != nil {
+		render.Render(w, r, res.ErrInternalServer("failed to rotate key", err))
+		return
+	}
+
+	render.Render(w, r, res.MsgOK("environment variables re-encrypted with new key"))
+}
+
+func readEnvTransferRequest(r *http.Request, req *api.EnvTransferRequest) error {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	defer r.Body.Close()
+	if err := json.Unmarshal(body, req); err != nil {
+		return err
+	}
+	if req.Passphrase == "" {
+		return errors.New("no passphrase provided")
+	}
+	return nil
+}
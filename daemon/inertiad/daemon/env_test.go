@@ -0,0 +1,45 @@
+package daemon
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/project/mocks"
+)
+
+func TestEnvImportHandler(t *testing.T) {
+	var s = &Server{deployment: &mocks.FakeDeployer{}}
+	var handler = http.HandlerFunc(s.envImportHandler)
+
+	t.Run("no passphrase", func(t *testing.T) {
+		body, _ := json.Marshal(api.EnvTransferRequest{Data: []byte("wow")})
+		req, err := http.NewRequest("POST", "/env/import", bytes.NewReader(body))
+		assert.NoError(t, err)
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, req)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("no data", func(t *testing.T) {
+		body, _ := json.Marshal(api.EnvTransferRequest{Passphrase: "hunter7"})
+		req, err := http.NewRequest("POST", "/env/import", bytes.NewReader(body))
+		assert.NoError(t, err)
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, req)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("no data manager", func(t *testing.T) {
+		body, _ := json.Marshal(api.EnvTransferRequest{Passphrase: "hunter7", Data: []byte("wow")})
+		req, err := http.NewRequest("POST", "/env/import", bytes.NewReader(body))
+		assert.NoError(t, err)
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, req)
+		assert.Equal(t, http.StatusPreconditionFailed, recorder.Code)
+	})
+}
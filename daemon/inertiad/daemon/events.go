@@ -0,0 +1,294 @@
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/go-chi/render"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/res"
+)
+
+const (
+	// eventHistory is the number of recent events kept, so that clients that
+	// reconnect can catch up on events they missed
+	eventHistory = 100
+
+	// eventBuffer is the number of events queued for each subscriber - events
+	// are dropped for subscribers that fall further behind
+	eventBuffer = 50
+
+	// eventKeepAlive is how often an idle event stream is written to, so that
+	// connections are not closed by proxies
+	eventKeepAlive = 15 * time.Second
+)
+
+// containerEventSource provides Docker events, and is implemented by the
+// Docker client
+type containerEventSource interface {
+	Events(ctx context.Context, options types.EventsOptions) (<-chan events.Message, <-chan error)
+}
+
+// eventBroker publishes events to subscribers. A nil eventBroker discards
+// all events.
+type eventBroker struct {
+	logger *logrus.Entry
+
+	mux         sync.Mutex
+	last        int64
+	recent      []api.Event
+	subscribers map[chan api.Event]struct{}
+	stop        context.CancelFunc
+}
+
+func newEventBroker(logger *logrus.Entry) *eventBroker {
+	return &eventBroker{
+		logger:      logger,
+		subscribers: make(map[chan api.Event]struct{}),
+	}
+}
+
+// Publish emits an event of the given type. Details are provided as
+// alternating keys and values.
+func (b *eventBroker) Publish(eventType, message string, details ...interface{}) {
+	if b == nil {
+		return
+	}
+	var data map[string]string
+	if len(details) > 0 {
+		data = make(map[string]string, len(details)/2)
+		for i := 0; i+1 < len(details); i += 2 {
+			data[fmt.Sprint(details[i])] = fmt.Sprint(details[i+1])
+		}
+	}
+
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	b.last++
+	var event = api.Event{
+		ID:      b.last,
+		Type:    eventType,
+		Time:    time.Now(),
+		Message: message,
+		Data:    data,
+	}
+	b.recent = append(b.recent, event)
+	if len(b.recent) > eventHistory {
+		b.recent = b.recent[len(b.recent)-eventHistory:]
+	}
+	for sub := range b.subscribers {
+		select {
+		case sub <- event:
+		default:
+			// subscriber is too far behind
+		}
+	}
+}
+
+// Subscribe returns a channel that receives published events, starting with
+// recent events after the given ID, if there are any. The returned function
+// must be called to stop receiving events.
+func (b *eventBroker) Subscribe(after int64) (<-chan api.Event, func()) {
+	if b == nil {
+		return nil, func() {}
+	}
+	var sub = make(chan api.Event, eventBuffer+eventHistory)
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	if after > 0 {
+		for _, e := range b.recent {
+			if e.ID > after {
+				sub <- e
+			}
+		}
+	}
+	b.subscribers[sub] = struct{}{}
+	return sub, func() {
+		b.mux.Lock()
+		defer b.mux.Unlock()
+		if _, ok := b.subscribers[sub]; ok {
+			delete(b.subscribers, sub)
+			close(sub)
+		}
+	}
+}
+
+// WatchContainers publishes events when containers start or die, until Close
+// is called
+func (b *eventBroker) WatchContainers(source containerEventSource) {
+	b.mux.Lock()
+	if b.stop != nil {
+		b.stop()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	b.stop = cancel
+	b.mux.Unlock()
+
+	var messages, errs = source.Events(ctx, types.EventsOptions{
+		Filters: filters.NewArgs(
+			filters.Arg("type", events.ContainerEventType),
+			filters.Arg("event", "start"),
+			filters.Arg("event", "die")),
+	})
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case err := <-errs:
+				if err != nil && ctx.Err() == nil {
+					b.logger.WithError(err).Error("stopped watching container events")
+				}
+				return
+			case msg := <-messages:
+				b.publishContainerEvent(msg)
+			}
+		}
+	}()
+}
+
+// publishContainerEvent publishes a Docker container event
+func (b *eventBroker) publishContainerEvent(msg events.Message) {
+	var name = msg.Actor.Attributes["name"]
+	if name == "inertia-daemon" {
+		return
+	}
+	if name == "" && len(msg.Actor.ID) >= 12 {
+		name = msg.Actor.ID[:12]
+	}
+	switch msg.Action {
+	case "start":
+		b.Publish(api.EventContainerStarted, fmt.Sprintf("container %s started", name),
+			"container", name)
+	case "die":
+		var code = msg.Actor.Attributes["exitCode"]
+		b.Publish(api.EventContainerDied,
+			fmt.Sprintf("container %s died with exit code %s", name, code),
+			"container", name, "exit_code", code)
+	}
+}
+
+// Close stops watching containers and ends all subscriptions
+func (b *eventBroker) Close() {
+	if b == nil {
+		return
+	}
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	if b.stop != nil {
+		b.stop()
+		b.stop = nil
+	}
+	for sub := range b.subscribers {
+		delete(b.subscribers, sub)
+		close(sub)
+	}
+}
+
+// buildStarted publishes an event for a build triggered by the given source,
+// and returns a function that publishes an event once the build and
+// deployment are finished
+func (s *Server) buildStarted(trigger string) func(err error) {
+	var (
+		start  = time.Now()
+		branch = s.deployment.GetBranch()
+	)
+	s.events.Publish(api.EventBuildStarted, "build started",
+		"trigger", trigger, "branch", branch)
+	return func(err error) {
+		var duration = time.Since(start).Round(time.Millisecond)
+		if err != nil {
+			s.events.Publish(api.EventBuildFinished, "build failed",
+				"trigger", trigger, "branch", branch, "status", "failed",
+				"duration", duration, "error", err)
+			return
+		}
+		s.events.Publish(api.EventBuildFinished, "build succeeded",
+			"trigger", trigger, "branch", branch, "status", "succeeded",
+			"duration", duration)
+	}
+}
+
+// eventsHandler streams events as server-sent events. Clients may restrict the
+// types of events received, and resume a stream by providing the ID of the last
+// event they received in the Last-Event-ID header.
+func (s *Server) eventsHandler(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		render.Render(w, r, res.ErrInternalServer("failed to stream events",
+			fmt.Errorf("streaming is not supported")))
+		return
+	}
+
+	var after int64
+	if id := r.Header.Get("Last-Event-ID"); id != "" {
+		var err error
+		if after, err = strconv.ParseInt(id, 10, 64); err != nil {
+			render.Render(w, r, res.ErrBadRequest("invalid last event ID",
+				"error", err))
+			return
+		}
+	}
+	var eventTypes []string
+	for _, t := range strings.Split(r.URL.Query().Get(api.Type), ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			eventTypes = append(eventTypes, t)
+		}
+	}
+
+	sub, unsubscribe := s.events.Subscribe(after)
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	var keepAlive = time.NewTicker(eventKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-keepAlive.C:
+			fmt.Fprint(w, ": keep-alive\n\n")
+			flusher.Flush()
+		case event, ok := <-sub:
+			if !ok {
+				return
+			}
+			if !matchesEventType(event.Type, eventTypes) {
+				continue
+			}
+			data, _ := json.Marshal(event)
+			fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+			flusher.Flush()
+		}
+	}
+}
+
+// matchesEventType reports whether the event type is one of the given types,
+// or is prefixed by one of them, such as "build" for "build.started". All
+// types match if none are given.
+func matchesEventType(eventType string, types []string) bool {
+	if len(types) == 0 {
+		return true
+	}
+	for _, t := range types {
+		if eventType == t || strings.HasPrefix(eventType, t+".") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,147 @@
+package daemon
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ubclaunchpad/inertia/api"
+)
+
+type fakeEventSource struct {
+	messages chan events.Message
+	errs     chan error
+}
+
+func (f *fakeEventSource) Events(ctx context.Context, options types.EventsOptions) (<-chan events.Message, <-chan error) {
+	return f.messages, f.errs
+}
+
+func receiveEvent(t *testing.T, sub <-chan api.Event) api.Event {
+	select {
+	case e := <-sub:
+		return e
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event")
+		return api.Event{}
+	}
+}
+
+func TestEventBroker(t *testing.T) {
+	var b = newEventBroker(newTestLogger())
+	defer b.Close()
+
+	b.Publish(api.EventBuildStarted, "build started", "branch", "master")
+	sub, unsubscribe := b.Subscribe(0)
+	b.Publish(api.EventBuildFinished, "build succeeded", "status", "succeeded")
+
+	// only events published after subscribing are received
+	var e = receiveEvent(t, sub)
+	assert.Equal(t, int64(2), e.ID)
+	assert.Equal(t, api.EventBuildFinished, e.Type)
+	assert.Equal(t, map[string]string{"status": "succeeded"}, e.Data)
+	unsubscribe()
+	_, open := <-sub
+	assert.False(t, open)
+
+	// resuming replays events after the given ID
+	sub, unsubscribe = b.Subscribe(1)
+	defer unsubscribe()
+	assert.Equal(t, int64(2), receiveEvent(t, sub).ID)
+
+	// a nil broker discards events
+	var nilBroker *eventBroker
+	nilBroker.Publish(api.EventBuildStarted, "build started")
+	nilBroker.Close()
+}
+
+func TestEventBroker_WatchContainers(t *testing.T) {
+	var (
+		b      = newEventBroker(newTestLogger())
+		source = &fakeEventSource{
+			messages: make(chan events.Message),
+			errs:     make(chan error),
+		}
+	)
+	defer b.Close()
+	sub, unsubscribe := b.Subscribe(0)
+	defer unsubscribe()
+	b.WatchContainers(source)
+
+	source.messages <- events.Message{Action: "start", Actor: events.Actor{
+		ID: "0123456789abcdef", Attributes: map[string]string{"name": "inertia-daemon"}}}
+	source.messages <- events.Message{Action: "start", Actor: events.Actor{
+		ID: "0123456789abcdef", Attributes: map[string]string{"name": "web"}}}
+	source.messages <- events.Message{Action: "die", Actor: events.Actor{
+		ID: "0123456789abcdef", Attributes: map[string]string{"exitCode": "137"}}}
+
+	var e = receiveEvent(t, sub)
+	assert.Equal(t, api.EventContainerStarted, e.Type)
+	assert.Equal(t, "web", e.Data["container"])
+	e = receiveEvent(t, sub)
+	assert.Equal(t, api.EventContainerDied, e.Type)
+	assert.Equal(t, "0123456789ab", e.Data["container"])
+	assert.Equal(t, "137", e.Data["exit_code"])
+}
+
+func TestEventsHandler(t *testing.T) {
+	var s = &Server{events: newEventBroker(newTestLogger())}
+	defer s.events.Close()
+	ts := httptest.NewServer(http.HandlerFunc(s.eventsHandler))
+	defer ts.Close()
+
+	t.Run("invalid last event ID", func(t *testing.T) {
+		req, err := http.NewRequest("GET", ts.URL, nil)
+		require.NoError(t, err)
+		req.Header.Set("Last-Event-ID", "wow")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		resp, err := http.Get(ts.URL + "?type=build,container.died")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+		s.events.Publish(api.EventUserLoggedIn, "user bob logged in")
+		s.events.Publish(api.EventContainerStarted, "container web started")
+		s.events.Publish(api.EventBuildStarted, "build started", "branch", "master")
+
+		var (
+			scan   = bufio.NewScanner(resp.Body)
+			fields []string
+		)
+		for scan.Scan() && scan.Text() != "" {
+			fields = append(fields, scan.Text())
+		}
+		require.Len(t, fields, 3)
+		assert.Equal(t, "event: build.started", fields[1])
+		var e api.Event
+		require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(fields[2], "data: ")), &e))
+		assert.Equal(t, "id: "+strconv.FormatInt(e.ID, 10), fields[0])
+		assert.Equal(t, "master", e.Data["branch"])
+	})
+}
+
+func TestMatchesEventType(t *testing.T) {
+	assert.True(t, matchesEventType(api.EventBuildStarted, nil))
+	assert.True(t, matchesEventType(api.EventBuildStarted, []string{"build"}))
+	assert.True(t, matchesEventType(api.EventBuildStarted, []string{"env", "build.started"}))
+	assert.False(t, matchesEventType(api.EventBuildStarted, []string{"build.finished"}))
+	assert.False(t, matchesEventType(api.EventBuildStarted, []string{"buil"}))
+}
@@ -0,0 +1,38 @@
+package daemon
+
+import (
+	"errors"
+	"net/http"
+
+	docker "github.com/docker/docker/client"
+	"github.com/go-chi/render"
+
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/containers"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/res"
+)
+
+// containerAddressHandler resolves the address of a container, for use in
+// forwarding connections to it from the host
+func (s *Server) containerAddressHandler(w http.ResponseWriter, r *http.Request) {
+	var container = r.URL.Query().Get(api.Container)
+	if container == "" {
+		render.Render(w, r, res.ErrBadRequest("no container provided"))
+		return
+	}
+
+	addr, err := containers.ContainerAddress(s.docker, container)
+	if err != nil {
+		if docker.IsErrNotFound(err) {
+			render.Render(w, r, res.ErrNotFound(err.Error()))
+		} else if errors.Is(err, containers.ErrContainerUnreachable) {
+			render.Render(w, r, res.Err(err.Error(), http.StatusPreconditionFailed))
+		} else {
+			render.Render(w, r, res.ErrInternalServer("failed to inspect container", err))
+		}
+		return
+	}
+
+	render.Render(w, r, res.MsgOK("container address resolved",
+		"address", addr))
+}
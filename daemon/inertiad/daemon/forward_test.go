@@ -0,0 +1,20 @@
+package daemon
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainerAddressHandler(t *testing.T) {
+	var s = &Server{}
+	var handler = http.HandlerFunc(s.containerAddressHandler)
+
+	req, err := http.NewRequest("GET", "/containers/address", nil)
+	assert.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
@@ -0,0 +1,40 @@
+package daemon
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/res"
+)
+
+// historyHandler lists previous deployments of the project, most recent first
+func (s *Server) historyHandler(w http.ResponseWriter, r *http.Request) {
+	manager, found := s.deployment.GetDataManager()
+	if !found {
+		render.Render(w, r, res.Err("no deployment history found", http.StatusPreconditionFailed))
+		return
+	}
+
+	builds, err := manager.GetProjectBuildData(s.deployment.GetProject())
+	if err != nil {
+		render.Render(w, r, res.ErrInternalServer("failed to retrieve deployment history", err))
+		return
+	}
+	var history = make([]api.Deployment, 0, len(builds))
+	for _, b := range builds {
+		history = append(history, api.Deployment{
+			Branch:          b.Branch,
+			CommitHash:      b.Hash,
+			DeployedAt:      b.DeployedAt,
+			ContainerStatus: b.ContainerStatus,
+
+			Changes:          b.Changes,
+			ChangesTruncated: b.ChangesTruncated,
+		})
+	}
+
+	render.Render(w, r, res.MsgOK("deployment history retrieved",
+		"history", history))
+}
@@ -0,0 +1,56 @@
+package daemon
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/project"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/project/mocks"
+)
+
+func TestHistoryHandler(t *testing.T) {
+	dir, err := ioutil.TempDir("", "inertia-history-handler")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	manager, err := project.NewDataManager(filepath.Join(dir, "project.db"), filepath.Join(dir, "key"))
+	require.NoError(t, err)
+	var deployedAt = time.Now().Add(-time.Hour).Round(time.Second)
+	require.NoError(t, manager.AddProjectBuildData("wow", project.DeploymentMetadata{
+		Hash: "1234", Branch: "master", ContainerStatus: "running", DeployedAt: deployedAt}))
+	require.NoError(t, manager.AddProjectBuildData("wow", project.DeploymentMetadata{
+		Hash: "5678", Branch: "master",
+		Changes: []api.Commit{{Hash: "5678", Author: "bob", Message: "fix things"}}}))
+
+	var fake = &mocks.FakeDeployer{}
+	fake.GetProjectReturns("wow")
+	fake.GetDataManagerReturns(manager, true)
+	var s = &Server{deployment: fake}
+
+	var recorder = httptest.NewRecorder()
+	s.historyHandler(recorder, httptest.NewRequest("GET", "/history", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var history []api.Deployment
+	_, err = api.Unmarshal(recorder.Body, api.KV{Key: "history", Value: &history})
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, "5678", history[0].CommitHash)
+	assert.Equal(t, []api.Commit{{Hash: "5678", Author: "bob", Message: "fix things"}}, history[0].Changes)
+	assert.Equal(t, "1234", history[1].CommitHash)
+	assert.Equal(t, "master", history[1].Branch)
+	assert.Equal(t, "running", history[1].ContainerStatus)
+	assert.True(t, deployedAt.Equal(history[1].DeployedAt))
+
+	// no history without a data manager
+	fake.GetDataManagerReturns(nil, false)
+	recorder = httptest.NewRecorder()
+	s.historyHandler(recorder, httptest.NewRequest("GET", "/history", nil))
+	assert.Equal(t, http.StatusPreconditionFailed, recorder.Code)
+}
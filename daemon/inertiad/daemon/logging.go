@@ -0,0 +1,32 @@
+package daemon
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/go-chi/chi/middleware"
+	"github.com/sirupsen/logrus"
+)
+
+// requestLogger returns a logger for activity caused by the given request,
+// tagged with its request ID
+func (s *Server) requestLogger(r *http.Request) *logrus.Entry {
+	return s.logger.WithField("request", middleware.GetReqID(r.Context()))
+}
+
+// deployLogger returns a logger for a new build and deployment of the current
+// project, tagged with the project name and a randomly generated deploy ID. If
+// the deployment was requested through the API, the request is provided.
+func (s *Server) deployLogger(r *http.Request) *logrus.Entry {
+	var logger = s.logger
+	if r != nil {
+		logger = s.requestLogger(r)
+	}
+	var id = make([]byte, 4)
+	rand.Read(id)
+	return logger.WithFields(logrus.Fields{
+		"project": s.deployment.GetProject(),
+		"deploy":  hex.EncodeToString(id),
+	})
+}
@@ -1,14 +1,21 @@
 package daemon
 
 import (
-	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
-	"os"
+	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	docker "github.com/docker/docker/client"
 	"github.com/go-chi/render"
+	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
 
 	"github.com/ubclaunchpad/inertia/api"
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/containers"
@@ -16,21 +23,22 @@ import (
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/res"
 )
 
-// logHandler handles requests for container logs
+// logHandler handles requests for container logs. Several containers can be
+// requested at once, separated by commas, and their logs are merged in
+// timestamp order. Logs are filtered here rather than by clients, so that only
+// the lines requested are sent.
 func (s *Server) logHandler(w http.ResponseWriter, r *http.Request) {
 	var (
 		shouldStream bool
 		err          error
 	)
 
-	// Get container name and stream from request query params
+	// Get stream from request query params
 	params := r.URL.Query()
-	container := params.Get(api.Container)
 	streamParam := params.Get(api.Stream)
 	if streamParam != "" {
 		s, err := strconv.ParseBool(streamParam)
 		if err != nil {
-			println(err.Error())
 			render.Render(w, r, res.ErrBadRequest(err.Error()))
 			return
 		}
@@ -53,59 +61,164 @@ func (s *Server) logHandler(w http.ResponseWriter, r *http.Request) {
 		entries = 500
 	}
 
-	// Upgrade to websocket connection if required, otherwise just set up a
-	// standard streamer
-	var stream *log.Streamer
-	if shouldStream {
-		socket, err := s.websocket.Upgrade(w, r, nil)
-		if err != nil {
-			render.Render(w, r,
-				res.ErrInternalServer("failed to esablish websocket connection", err))
-			return
+	// Set up filters
+	var now = time.Now()
+	query, err := parseLogQuery(params, now)
+	if err != nil {
+		render.Render(w, r, res.ErrBadRequest(err.Error()))
+		return
+	}
+	query.Entries = entries
+	if query.Containers, err = s.logContainers(params); err != nil {
+		if errors.Is(err, containers.ErrNoContainers) {
+			render.Render(w, r, res.ErrNotFound(err.Error()))
+		} else {
+			render.Render(w, r, res.ErrBadRequest(err.Error()))
 		}
-		stream = log.NewStreamer(log.StreamerOptions{
-			Request:    r,
-			Stdout:     os.Stdout,
-			Socket:     socket,
-			HTTPWriter: w,
-		})
-	} else {
-		stream = log.NewStreamer(log.StreamerOptions{
-			Request:    r,
-			Stdout:     os.Stdout,
-			HTTPWriter: w,
-		})
+		return
 	}
 
-	logs, err := containers.ContainerLogs(s.docker, containers.LogOptions{
-		Container: container,
-		Stream:    shouldStream,
-		Entries:   entries,
-	})
+	// Retrieve logs up to now - if streaming, logs after now are followed
+	var history = query
+	if history.Until.IsZero() || history.Until.After(now) {
+		history.Until = now
+	}
+	lines, err := containers.QueryLogs(r.Context(), s.docker, history)
 	if err != nil {
 		if docker.IsErrNotFound(err) {
-			stream.Error(res.ErrNotFound(err.Error()))
+			render.Render(w, r, res.ErrNotFound(err.Error()))
 		} else {
-			stream.Error(res.ErrInternalServer("failed to find logs for container", err))
+			render.Render(w, r, res.ErrInternalServer("failed to find logs for container", err))
 		}
 		return
 	}
-	defer logs.Close()
 
-	if shouldStream {
-		var stop = make(chan struct{})
-		socket, err := stream.GetSocketWriter()
+	if !shouldStream {
+		var logs = make([]api.LogEntry, len(lines))
+		for i, l := range lines {
+			logs[i] = logEntry(l)
+		}
+		render.Render(w, r, res.MsgOK("logs retrieved", "logs", logs))
+		return
+	}
+
+	// Upgrade to websocket connection, and send each line as a message
+	socket, err := s.websocket.Upgrade(w, r, nil)
+	if err != nil {
+		render.Render(w, r,
+			res.ErrInternalServer("failed to esablish websocket connection", err))
+		return
+	}
+	var stream = log.NewStreamer(log.StreamerOptions{
+		Request:    r,
+		Stdout:     log.Writer(s.requestLogger(r), logrus.InfoLevel),
+		Socket:     socket,
+		HTTPWriter: w,
+	})
+	defer stream.Close()
+
+	// stop following logs once the client goes away
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		for {
+			if _, _, err := socket.ReadMessage(); err != nil {
+				cancel()
+				return
+			}
+		}
+	}()
+
+	var send = func(l containers.ContainerLogLine) {
+		msg, _ := json.Marshal(logEntry(l))
+		if err := socket.WriteMessage(websocket.TextMessage, msg); err != nil {
+			cancel()
+		}
+	}
+	for _, l := range lines {
+		send(l)
+	}
+	if !query.Until.IsZero() && !query.Until.After(now) {
+		return
+	}
+	if err := containers.FollowQuery(ctx, s.docker, query, now, send); err != nil {
+		stream.Error(res.ErrInternalServer("failed to follow logs", err))
+	}
+}
+
+// parseLogQuery reads log filters from query params
+func parseLogQuery(params url.Values, now time.Time) (containers.LogQuery, error) {
+	var (
+		query containers.LogQuery
+		err   error
+	)
+	if since := params.Get(api.Since); since != "" {
+		if query.Since, err = containers.ParseLogTime(since, now); err != nil {
+			return query, fmt.Errorf("invalid start time: %w", err)
+		}
+	}
+	if until := params.Get(api.Until); until != "" {
+		if query.Until, err = containers.ParseLogTime(until, now); err != nil {
+			return query, fmt.Errorf("invalid end time: %w", err)
+		}
+	}
+	if !query.Since.IsZero() && !query.Until.IsZero() && query.Until.Before(query.Since) {
+		return query, errors.New("end time is before start time")
+	}
+	if grep := params.Get(api.Grep); grep != "" {
+		if query.Grep, err = regexp.Compile(grep); err != nil {
+			return query, fmt.Errorf("invalid pattern: %w", err)
+		}
+	}
+	if level := params.Get(api.Level); level != "" {
+		parsed, err := logrus.ParseLevel(level)
 		if err != nil {
-			stream.Error(res.ErrInternalServer("failed to write to socket", err))
-			return
+			return query, err
 		}
-		log.FlushRoutine(socket, logs, stop)
-		defer stream.Close()
-		defer close(stop)
-	} else {
-		buf := new(bytes.Buffer)
-		buf.ReadFrom(logs)
-		render.Render(w, r, res.MsgOK("logs retrieved",
-			"logs", strings.Split(buf.String(), "\n")))
+		query.Level = &parsed
+	}
+	return query, nil
+}
+
+// logContainers returns the containers whose logs were requested - either the
+// given comma-separated list, or every project container
+func (s *Server) logContainers(params url.Values) ([]string, error) {
+	if all, _ := strconv.ParseBool(params.Get(api.All)); all {
+		status, _ := s.deployment.GetStatus(s.docker)
+		var names []string
+		for _, c := range status.Containers {
+			// the output of docker-compose repeats that of project containers
+			if c != "/docker-compose" {
+				names = append(names, c)
+			}
+		}
+		if len(names) == 0 {
+			return nil, containers.ErrNoContainers
+		}
+		return names, nil
+	}
+
+	var names []string
+	for _, c := range strings.Split(params.Get(api.Container), ",") {
+		if c = strings.TrimSpace(c); c != "" {
+			names = append(names, c)
+		}
+	}
+	if len(names) == 0 {
+		return nil, errors.New("no container provided")
+	}
+	return names, nil
+}
+
+func logEntry(l containers.ContainerLogLine) api.LogEntry {
+	var entry = api.LogEntry{
+		Time:      l.Time,
+		Container: l.Container,
+		Stream:    "stdout",
+		Message:   l.Message,
+	}
+	if l.Stderr {
+		entry.Stream = "stderr"
 	}
+	return entry
 }
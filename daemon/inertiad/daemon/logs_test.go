@@ -0,0 +1,71 @@
+package daemon
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/project/mocks"
+)
+
+func Test_parseLogQuery(t *testing.T) {
+	var now = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	query, err := parseLogQuery(url.Values{
+		api.Since: {"1h"},
+		api.Until: {"2020-01-02T03:00:00Z"},
+		api.Grep:  {"GET /api/.*"},
+		api.Level: {"warn"},
+	}, now)
+	require.NoError(t, err)
+	assert.True(t, now.Add(-time.Hour).Equal(query.Since))
+	assert.True(t, time.Date(2020, 1, 2, 3, 0, 0, 0, time.UTC).Equal(query.Until))
+	assert.True(t, query.Grep.MatchString("GET /api/users"))
+	require.NotNil(t, query.Level)
+	assert.Equal(t, logrus.WarnLevel, *query.Level)
+
+	for _, params := range []url.Values{
+		{api.Since: {"last tuesday"}},
+		{api.Until: {"soon"}},
+		{api.Since: {"10m"}, api.Until: {"1h"}},
+		{api.Grep: {"(unclosed"}},
+		{api.Level: {"loud"}},
+	} {
+		_, err := parseLogQuery(params, now)
+		assert.Error(t, err, params.Encode())
+	}
+}
+
+func TestServer_logContainers(t *testing.T) {
+	var fake = &mocks.FakeDeployer{}
+	fake.GetStatusReturns(api.DeploymentStatus{
+		Containers: []string{"/docker-compose", "/web", "/db"},
+	}, nil)
+	var s = &Server{deployment: fake}
+
+	names, err := s.logContainers(url.Values{api.All: {"true"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/web", "/db"}, names)
+
+	names, err = s.logContainers(url.Values{api.Container: {"web, db"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"web", "db"}, names)
+
+	_, err = s.logContainers(url.Values{})
+	assert.Error(t, err)
+
+	// no project containers
+	fake.GetStatusReturns(api.DeploymentStatus{}, nil)
+	var recorder = httptest.NewRecorder()
+	req, err := http.NewRequest("GET", "/logs?all=true", nil)
+	require.NoError(t, err)
+	s.logHandler(recorder, req)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
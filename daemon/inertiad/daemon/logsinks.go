@@ -0,0 +1,205 @@
+package daemon
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	docker "github.com/docker/docker/client"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/containers"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/logsink"
+)
+
+// logFollowInterval is how often running containers are checked for, so that
+// their output can be forwarded to log sinks
+const logFollowInterval = 5 * time.Second
+
+// logFollower forwards the output of running project containers to log sinks
+type logFollower struct {
+	sinks  *logsink.Forwarder
+	logger *logrus.Entry
+
+	// list returns all containers, and follow calls fn with each line
+	// logged by a container after the given time until it stops
+	list   func(ctx context.Context) ([]types.Container, error)
+	follow func(ctx context.Context, id string, since time.Time, fn func(containers.LogLine)) error
+	// ignore reports whether a container's output should not be forwarded
+	ignore func(c types.Container) bool
+
+	mux       sync.Mutex
+	enabledAt time.Time
+	following map[string]context.CancelFunc
+	// last is the time of the last line forwarded from each container, so
+	// that restarted containers continue where they left off
+	last     map[string]time.Time
+	schedule context.CancelFunc
+}
+
+// newLogFollower creates a follower over the containers of cli, ignoring the
+// daemon and the build tools it runs, since their output is already part of
+// the daemon's logs
+func newLogFollower(cli *docker.Client, sinks *logsink.Forwarder, dockerCompose string,
+	logger *logrus.Entry) *logFollower {
+	return &logFollower{
+		sinks:  sinks,
+		logger: logger,
+		list: func(ctx context.Context) ([]types.Container, error) {
+			return cli.ContainerList(ctx, types.ContainerListOptions{All: true})
+		},
+		follow: func(ctx context.Context, id string, since time.Time, fn func(containers.LogLine)) error {
+			return containers.FollowLogs(ctx, cli, id, since, fn)
+		},
+		ignore: func(c types.Container) bool {
+			return containerName(c) == "inertia-daemon" || c.Image == dockerCompose
+		},
+	}
+}
+
+// Start checks for containers to follow on the given interval, until Close is
+// called
+func (f *logFollower) Start(interval time.Duration) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	if f.schedule != nil {
+		f.schedule()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	f.schedule = cancel
+	go func() {
+		var ticker = time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			f.Check(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Close stops following containers
+func (f *logFollower) Close() {
+	if f == nil {
+		return
+	}
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	if f.schedule != nil {
+		f.schedule()
+		f.schedule = nil
+	}
+	f.stopAll()
+}
+
+// Check starts following running containers that are not already followed. If
+// no sinks are configured, containers are no longer followed.
+func (f *logFollower) Check(ctx context.Context) {
+	if !f.sinks.Enabled() {
+		f.mux.Lock()
+		f.stopAll()
+		// output logged while forwarding is disabled should not be forwarded
+		// once it is enabled again
+		f.enabledAt = time.Time{}
+		for id := range f.last {
+			delete(f.last, id)
+		}
+		f.mux.Unlock()
+		return
+	}
+
+	list, err := f.list(ctx)
+	if err != nil {
+		f.logger.WithError(err).Warn("failed to list containers to forward logs from")
+		return
+	}
+
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	if f.following == nil {
+		f.following = make(map[string]context.CancelFunc)
+		f.last = make(map[string]time.Time)
+	}
+	if f.enabledAt.IsZero() {
+		f.enabledAt = time.Now()
+	}
+	var exists = make(map[string]bool, len(list))
+	for _, c := range list {
+		exists[c.ID] = true
+		if _, found := f.following[c.ID]; found || c.State != "running" || f.ignore(c) {
+			continue
+		}
+
+		// only forward output logged since forwarding was enabled
+		var since = f.enabledAt
+		if created := time.Unix(c.Created, 0); created.After(since) {
+			since = created
+		}
+		if last, found := f.last[c.ID]; found {
+			since = last.Add(time.Nanosecond)
+		}
+
+		followCtx, cancel := context.WithCancel(ctx)
+		f.following[c.ID] = cancel
+		go f.run(followCtx, c.ID, containerName(c), since)
+	}
+
+	// forget containers that have been removed
+	for id := range f.last {
+		if !exists[id] {
+			delete(f.last, id)
+		}
+	}
+}
+
+// run forwards the output of a container until it stops or ctx is cancelled
+func (f *logFollower) run(ctx context.Context, id, name string, since time.Time) {
+	var last time.Time
+	err := f.follow(ctx, id, since, func(l containers.LogLine) {
+		last = l.Time
+		f.sinks.Write(logsink.Entry{
+			Time:    l.Time,
+			Source:  name,
+			Stderr:  l.Stderr,
+			Message: l.Message,
+		})
+	})
+	if err != nil && ctx.Err() == nil {
+		f.logger.WithError(err).WithField("container", name).Warn("stopped forwarding container logs")
+	}
+
+	// if the container stopped by itself, pick up where this left off when it
+	// is restarted - otherwise, this follower has already been stopped
+	f.mux.Lock()
+	if ctx.Err() == nil {
+		if !last.IsZero() {
+			f.last[id] = last
+		}
+		if cancel, found := f.following[id]; found {
+			cancel()
+			delete(f.following, id)
+		}
+	}
+	f.mux.Unlock()
+}
+
+// stopAll stops following all containers - the caller must hold the lock
+func (f *logFollower) stopAll() {
+	for id, cancel := range f.following {
+		cancel()
+		delete(f.following, id)
+	}
+}
+
+// containerName returns the name of c without Docker's leading slash
+func containerName(c types.Container) string {
+	if len(c.Names) == 0 {
+		return c.ID
+	}
+	return strings.TrimPrefix(c.Names[0], "/")
+}
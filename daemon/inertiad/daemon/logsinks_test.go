@@ -0,0 +1,166 @@
+package daemon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/containers"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/disk"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/logsink"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/project"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/project/mocks"
+)
+
+func TestLogFollower(t *testing.T) {
+	var received = make(chan []map[string]interface{}, 10)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var batch []map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&batch))
+		received <- batch
+	}))
+	defer ts.Close()
+
+	var sinks = logsink.NewForwarder(logsink.Options{FlushInterval: 10 * time.Millisecond})
+	defer sinks.Close()
+
+	var (
+		mux      sync.Mutex
+		followed = map[string]time.Time{}
+		start    = time.Now()
+	)
+	var f = &logFollower{
+		sinks: sinks,
+		list: func(ctx context.Context) ([]types.Container, error) {
+			return []types.Container{
+				{ID: "1", Names: []string{"/web"}, State: "running", Created: start.Add(time.Hour).Unix()},
+				{ID: "2", Names: []string{"/inertia-daemon"}, State: "running"},
+				{ID: "3", Names: []string{"/db"}, State: "exited"},
+			}, nil
+		},
+		follow: func(ctx context.Context, id string, since time.Time, fn func(containers.LogLine)) error {
+			mux.Lock()
+			followed[id] = since
+			mux.Unlock()
+			fn(containers.LogLine{Time: since, Stderr: true, Message: "hello"})
+			<-ctx.Done()
+			return ctx.Err()
+		},
+		ignore: func(c types.Container) bool { return containerName(c) == "inertia-daemon" },
+	}
+	defer f.Close()
+
+	// nothing should be followed without sinks
+	f.Check(context.Background())
+	mux.Lock()
+	assert.Len(t, followed, 0)
+	mux.Unlock()
+
+	require.NoError(t, sinks.Configure(api.LogSinkConfiguration{
+		Sinks: []api.LogSink{{Type: api.LogSinkHTTP, URL: ts.URL}},
+	}))
+	f.Check(context.Background())
+	f.Check(context.Background())
+
+	select {
+	case batch := <-received:
+		require.Len(t, batch, 1)
+		assert.Equal(t, "web", batch[0]["source"])
+		assert.Equal(t, "stderr", batch[0]["stream"])
+		assert.Equal(t, "hello", batch[0]["message"])
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for entries")
+	}
+
+	// only the running project container should be followed, from when it
+	// was created
+	mux.Lock()
+	assert.Equal(t, map[string]time.Time{"1": time.Unix(start.Add(time.Hour).Unix(), 0)}, followed)
+	mux.Unlock()
+
+	// containers should no longer be followed once sinks are removed
+	require.NoError(t, sinks.Configure(api.LogSinkConfiguration{}))
+	f.Check(context.Background())
+	f.mux.Lock()
+	assert.Len(t, f.following, 0)
+	f.mux.Unlock()
+}
+
+func TestUpHandlerInvalidLogSinks(t *testing.T) {
+	var fake = &mocks.FakeDeployer{}
+	var s = &Server{
+		logger:     newTestLogger(),
+		deployment: fake,
+		logSinks:   logsink.NewForwarder(logsink.Options{}),
+	}
+	defer s.logSinks.Close()
+
+	body, _ := json.Marshal(api.UpRequest{
+		Project:  "myproject",
+		LogSinks: []api.LogSink{{Type: "carrier-pigeon", URL: "http://127.0.0.1"}},
+	})
+	req, err := http.NewRequest("POST", "/up", bytes.NewReader(body))
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	http.HandlerFunc(s.upHandler).ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "carrier-pigeon")
+	// nothing should have been deployed
+	assert.Equal(t, 0, fake.SetConfigCallCount())
+	assert.False(t, s.logSinks.Enabled())
+}
+
+func TestUpHandlerRefusedKeepsSettings(t *testing.T) {
+	dir, err := ioutil.TempDir("", "inertia-up-refused")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	manager, err := project.NewDataManager(filepath.Join(dir, "project.db"), filepath.Join(dir, "key"))
+	require.NoError(t, err)
+	var fake = &mocks.FakeDeployer{}
+	fake.GetDataManagerReturns(manager, true)
+	// no disk is that empty, so the deploy is refused
+	var watcher = disk.NewWatcher(disk.Options{Paths: []string{dir}, Notify: fake.Notify})
+	require.NoError(t, watcher.Configure(api.DiskConfiguration{MinimumFree: "99.99%"}))
+	var s = &Server{
+		deployment: fake,
+		logger:     newTestLogger(),
+		logSinks:   logsink.NewForwarder(logsink.Options{}),
+		disk:       watcher,
+	}
+	defer s.logSinks.Close()
+
+	body, _ := json.Marshal(api.UpRequest{
+		Project:       "myproject",
+		WebHookSecret: "sekret",
+		LogSinks:      []api.LogSink{{Type: api.LogSinkHTTP, URL: "http://127.0.0.1"}},
+	})
+	req, err := http.NewRequest("POST", "/up", bytes.NewReader(body))
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	http.HandlerFunc(s.upHandler).ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusInsufficientStorage, recorder.Code)
+
+	// settings for the refused deploy should not have been applied or saved
+	assert.False(t, s.logSinks.Enabled())
+	_, found, err := manager.GetLogSinkConfiguration()
+	assert.NoError(t, err)
+	assert.False(t, found)
+	assert.Empty(t, s.state.WebhookSecret)
+	_, found, err = manager.GetWebhookSecret()
+	assert.NoError(t, err)
+	assert.False(t, found)
+}
@@ -0,0 +1,273 @@
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	docker "github.com/docker/docker/client"
+	"github.com/go-chi/render"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/containers"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/project"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/res"
+)
+
+const (
+	outcomeSuccess = "success"
+	outcomeFailure = "failure"
+
+	// containerStatsTimeout bounds how long sampling container usage may take
+	// when metrics are scraped
+	containerStatsTimeout = 10 * time.Second
+)
+
+// durationBuckets are histogram buckets suited to durations, in seconds, of
+// builds and deployments
+var durationBuckets = []float64{1, 5, 10, 30, 60, 120, 300, 600, 1200}
+
+// serverMetrics are the metrics exposed by the daemon at /metrics
+type serverMetrics struct {
+	registry *prometheus.Registry
+
+	deployments        *prometheus.CounterVec
+	deploymentDuration *prometheus.HistogramVec
+	buildDuration      *prometheus.HistogramVec
+	webhookDeliveries  *prometheus.CounterVec
+	loginFailures      prometheus.Counter
+}
+
+func newServerMetrics(cli *docker.Client, deployment project.Deployer, logger *logrus.Entry) *serverMetrics {
+	var m = &serverMetrics{
+		registry: prometheus.NewRegistry(),
+
+		deployments: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "inertia_deployments_total",
+			Help: "Number of deployments, by outcome.",
+		}, []string{"outcome"}),
+		deploymentDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "inertia_deployment_duration_seconds",
+			Help:    "Time taken to update, build, and start the project, by outcome.",
+			Buckets: durationBuckets,
+		}, []string{"outcome"}),
+		buildDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "inertia_build_duration_seconds",
+			Help:    "Time taken to update and build the project, by outcome.",
+			Buckets: durationBuckets,
+		}, []string{"outcome"}),
+		webhookDeliveries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "inertia_webhook_deliveries_total",
+			Help: "Number of webhook deliveries received, by source and result.",
+		}, []string{"source", "result"}),
+		loginFailures: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "inertia_login_failures_total",
+			Help: "Number of failed login attempts.",
+		}),
+	}
+	m.registry.MustRegister(m.deployments, m.deploymentDuration, m.buildDuration,
+		m.webhookDeliveries, m.loginFailures)
+	if cli != nil {
+		m.registry.MustRegister(newContainerCollector(cli, deployment, logger))
+	}
+	return m
+}
+
+// handler serves all metrics in the Prometheus text format
+func (m *serverMetrics) handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// containerCollector samples the resource usage of running project containers
+// each time metrics are scraped. Samples are not retained between scrapes, so
+// containers that have stopped are not reported.
+type containerCollector struct {
+	cli        *docker.Client
+	deployment project.Deployer
+	logger     *logrus.Entry
+
+	restarts    *prometheus.Desc
+	cpu         *prometheus.Desc
+	memory      *prometheus.Desc
+	memoryLimit *prometheus.Desc
+	networkRx   *prometheus.Desc
+	networkTx   *prometheus.Desc
+}
+
+func newContainerCollector(cli *docker.Client, deployment project.Deployer, logger *logrus.Entry) *containerCollector {
+	var desc = func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(name, help, []string{"container"}, nil)
+	}
+	return &containerCollector{
+		cli:        cli,
+		deployment: deployment,
+		logger:     logger,
+
+		restarts: desc("inertia_container_restarts",
+			"Number of times a project container has been restarted."),
+		cpu: desc("inertia_container_cpu_percent",
+			"CPU usage of a project container, where 100 is one fully used CPU."),
+		memory: desc("inertia_container_memory_usage_bytes",
+			"Memory usage of a project container, excluding the page cache."),
+		memoryLimit: desc("inertia_container_memory_limit_bytes",
+			"Memory limit of a project container."),
+		networkRx: desc("inertia_container_network_receive_bytes",
+			"Bytes received by a project container."),
+		networkTx: desc("inertia_container_network_transmit_bytes",
+			"Bytes transmitted by a project container."),
+	}
+}
+
+// Describe implements prometheus.Collector
+func (c *containerCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, d := range []*prometheus.Desc{
+		c.restarts, c.cpu, c.memory, c.memoryLimit, c.networkRx, c.networkTx,
+	} {
+		ch <- d
+	}
+}
+
+// Collect implements prometheus.Collector
+func (c *containerCollector) Collect(ch chan<- prometheus.Metric) {
+	status, err := c.deployment.GetStatus(c.cli)
+	if err != nil {
+		c.logger.WithError(err).Error("failed to list project containers for metrics")
+		return
+	}
+	if len(status.Containers) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), containerStatsTimeout)
+	defer cancel()
+	list, err := containers.ListStats(ctx, c.cli, status.Containers...)
+	if err != nil {
+		c.logger.WithError(err).Error("failed to collect container stats for metrics")
+		return
+	}
+	var gauge = func(desc *prometheus.Desc, v float64, name string) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, v, name)
+	}
+	for _, stats := range list {
+		var name = stats.Name
+		if info, err := c.cli.ContainerInspect(ctx, name); err == nil {
+			gauge(c.restarts, float64(info.RestartCount), name)
+		}
+		gauge(c.cpu, stats.CPUPercent, name)
+		gauge(c.memory, float64(stats.MemoryUsage), name)
+		gauge(c.memoryLimit, float64(stats.MemoryLimit), name)
+		gauge(c.networkRx, float64(stats.NetworkRx), name)
+		gauge(c.networkTx, float64(stats.NetworkTx), name)
+	}
+}
+
+// buildCompleted records a build that started at the given time
+func (m *serverMetrics) buildCompleted(start time.Time, err error) {
+	if m == nil {
+		return
+	}
+	m.buildDuration.WithLabelValues(outcome(err)).Observe(time.Since(start).Seconds())
+}
+
+// deploymentCompleted records a deployment that started at the given time
+func (m *serverMetrics) deploymentCompleted(start time.Time, err error) {
+	if m == nil {
+		return
+	}
+	m.deployments.WithLabelValues(outcome(err)).Inc()
+	m.deploymentDuration.WithLabelValues(outcome(err)).Observe(time.Since(start).Seconds())
+}
+
+// webhookReceived records a webhook delivery from the given source
+func (m *serverMetrics) webhookReceived(source, result string) {
+	if m == nil {
+		return
+	}
+	if source == "" {
+		source = "unknown"
+	}
+	m.webhookDeliveries.WithLabelValues(source, result).Inc()
+}
+
+// loginFailed records a failed login attempt
+func (m *serverMetrics) loginFailed() {
+	if m == nil {
+		return
+	}
+	m.loginFailures.Inc()
+}
+
+func outcome(err error) string {
+	if err != nil {
+		return outcomeFailure
+	}
+	return outcomeSuccess
+}
+
+// metricsConfigHandler configures (POST) or retrieves (GET) access to the
+// metrics endpoint
+func (s *Server) metricsConfigHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == "POST" {
+		metricsConfigPostHandler(s, w, r)
+	} else if r.Method == "GET" {
+		metricsConfigGetHandler(s, w, r)
+	}
+}
+
+func metricsConfigPostHandler(s *Server, w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		render.Render(w, r, res.ErrBadRequest(err.Error()))
+		return
+	}
+	defer r.Body.Close()
+	var conf api.MetricsConfiguration
+	if err = json.Unmarshal(body, &conf); err != nil {
+		render.Render(w, r, res.ErrBadRequest(err.Error()))
+		return
+	}
+
+	manager, found := s.deployment.GetDataManager()
+	if !found {
+		render.Render(w, r, res.Err("no data manager found", http.StatusPreconditionFailed))
+		return
+	}
+
+	if s.permissions != nil {
+		if err := s.permissions.SetScrapeAccess(conf.ScrapeToken, conf.AllowedNetworks); err != nil {
+			render.Render(w, r, res.ErrBadRequest(err.Error()))
+			return
+		}
+	}
+	if err := manager.SetMetricsConfiguration(conf); err != nil {
+		render.Render(w, r, res.ErrInternalServer("failed to save metrics configuration", err))
+		return
+	}
+
+	render.Render(w, r, res.MsgOK("metrics configuration updated"))
+}
+
+func metricsConfigGetHandler(s *Server, w http.ResponseWriter, r *http.Request) {
+	manager, found := s.deployment.GetDataManager()
+	if !found {
+		render.Render(w, r, res.Err("no data manager found", http.StatusPreconditionFailed))
+		return
+	}
+	conf, found, err := manager.GetMetricsConfiguration()
+	if err != nil {
+		render.Render(w, r, res.ErrInternalServer("failed to retrieve metrics configuration", err))
+		return
+	} else if !found {
+		conf = &api.MetricsConfiguration{}
+	}
+	if conf.ScrapeToken != "" {
+		conf.ScrapeToken = redacted
+	}
+	render.Render(w, r, res.MsgOK("metrics configuration retrieved",
+		"config", conf))
+}
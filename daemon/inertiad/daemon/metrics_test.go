@@ -0,0 +1,114 @@
+package daemon
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/auth"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/crypto"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/project"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/project/mocks"
+)
+
+func TestServerMetrics(t *testing.T) {
+	var m = newServerMetrics(nil, nil, newTestLogger())
+	var start = time.Now().Add(-2 * time.Second)
+	m.buildCompleted(start, nil)
+	m.deploymentCompleted(start, nil)
+	m.deploymentCompleted(start, errors.New("oh no"))
+	m.webhookReceived("github", "accepted")
+	m.webhookReceived("", "unverified")
+	m.loginFailed()
+
+	var recorder = httptest.NewRecorder()
+	m.handler().ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var out = recorder.Body.String()
+	assert.Contains(t, out, `inertia_deployments_total{outcome="failure"} 1`)
+	assert.Contains(t, out, `inertia_deployments_total{outcome="success"} 1`)
+	assert.Contains(t, out, `inertia_build_duration_seconds_count{outcome="success"} 1`)
+	assert.Contains(t, out, `inertia_deployment_duration_seconds_bucket{outcome="success",le="1"} 0`)
+	assert.Contains(t, out, `inertia_webhook_deliveries_total{result="accepted",source="github"} 1`)
+	assert.Contains(t, out, `inertia_webhook_deliveries_total{result="unverified",source="unknown"} 1`)
+	assert.Contains(t, out, "inertia_login_failures_total 1")
+
+	// metrics are optional
+	var none *serverMetrics
+	assert.NotPanics(t, func() {
+		none.buildCompleted(start, nil)
+		none.deploymentCompleted(start, nil)
+		none.webhookReceived("github", "accepted")
+		none.loginFailed()
+	})
+}
+
+func TestMetricsConfigHandler(t *testing.T) {
+	dir, err := ioutil.TempDir("", "inertia-metrics-handler")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	manager, err := project.NewDataManager(filepath.Join(dir, "project.db"), filepath.Join(dir, "key"))
+	require.NoError(t, err)
+	permissions, err := auth.NewPermissionsHandler(filepath.Join(dir, "users.db"),
+		"127.0.0.1", 3000, newTestLogger(), crypto.GetFakeAPIKey)
+	require.NoError(t, err)
+	defer permissions.Close()
+	var fake = &mocks.FakeDeployer{}
+	fake.GetDataManagerReturns(manager, true)
+	var s = &Server{
+		logger:      newTestLogger(),
+		deployment:  fake,
+		permissions: permissions,
+		metrics:     newServerMetrics(nil, nil, newTestLogger()),
+	}
+	var handler = http.HandlerFunc(s.metricsConfigHandler)
+	permissions.AttachScrapeRestrictedHandler("/metrics", s.metrics.handler())
+
+	for _, tt := range []struct {
+		name     string
+		conf     api.MetricsConfiguration
+		wantCode int
+	}{
+		{"invalid network", api.MetricsConfiguration{AllowedNetworks: []string{"nope"}}, http.StatusBadRequest},
+		{"ok", api.MetricsConfiguration{ScrapeToken: "scrapetoken", AllowedNetworks: []string{"10.0.0.0/8"}}, http.StatusOK},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(tt.conf)
+			req, err := http.NewRequest("POST", "/metrics/config", bytes.NewReader(body))
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			assert.Equal(t, tt.wantCode, recorder.Code)
+		})
+	}
+
+	// configuration should be saved, and token redacted
+	req, err := http.NewRequest("GET", "/metrics/config", nil)
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	var conf api.MetricsConfiguration
+	_, err = api.Unmarshal(recorder.Body, api.KV{Key: "config", Value: &conf})
+	require.NoError(t, err)
+	assert.Equal(t, redacted, conf.ScrapeToken)
+	assert.Equal(t, []string{"10.0.0.0/8"}, conf.AllowedNetworks)
+
+	// scrape access should be applied
+	req = httptest.NewRequest("GET", "/metrics", nil)
+	req.Header.Set("Authorization", "Bearer scrapetoken")
+	recorder = httptest.NewRecorder()
+	permissions.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "# TYPE inertia_login_failures_total counter")
+}
@@ -0,0 +1,373 @@
+package daemon
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-chi/render"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/auth"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/backup"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/crypto"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/migration"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/res"
+)
+
+// migrationVersion is the version of the migration bundle format
+const migrationVersion = 1
+
+// migrateExportHandler streams a bundle of daemon state, encrypted with the
+// requested passphrase, for import on another remote
+func (s *Server) migrateExportHandler(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		render.Render(w, r, res.ErrBadRequest(err.Error()))
+		return
+	}
+	defer r.Body.Close()
+	var migrateReq api.MigrationRequest
+	if err = json.Unmarshal(body, &migrateReq); err != nil {
+		render.Render(w, r, res.ErrBadRequest(err.Error()))
+		return
+	}
+	if migrateReq.Passphrase == "" {
+		render.Render(w, r, res.ErrBadRequest("no passphrase provided"))
+		return
+	}
+
+	manager, found := s.deployment.GetDataManager()
+	if !found {
+		render.Render(w, r, res.Err("no data manager found", http.StatusPreconditionFailed))
+		return
+	}
+
+	// gather everything before streaming, so that errors can still be reported
+	status, _ := s.deployment.GetStatus(s.docker)
+	manifest, err := json.Marshal(api.MigrationManifest{
+		Version:       migrationVersion,
+		DaemonVersion: s.version,
+		CreatedAt:     time.Now().UTC(),
+		Deployment:    status,
+	})
+	if err != nil {
+		render.Render(w, r, res.ErrInternalServer("failed to encode manifest", err))
+		return
+	}
+	var users bytes.Buffer
+	if s.permissions != nil {
+		if err := s.permissions.ExportUsers(&users); err != nil {
+			render.Render(w, r, res.ErrInternalServer("failed to export users", err))
+			return
+		}
+	}
+	var data bytes.Buffer
+	if err := manager.ExportData(&data); err != nil {
+		render.Render(w, r, res.ErrInternalServer("failed to export project data", err))
+		return
+	}
+	var history bytes.Buffer
+	if err := manager.ExportHistory(&history); err != nil {
+		render.Render(w, r, res.ErrInternalServer("failed to export deployment history", err))
+		return
+	}
+	deployKey, err := ioutil.ReadFile(crypto.DaemonInertiaKeyLocation)
+	if err != nil && !os.IsNotExist(err) {
+		render.Render(w, r, res.ErrInternalServer("failed to read deploy key", err))
+		return
+	}
+	persist, err := ioutil.TempFile(s.state.DataDirectory, ".migrate-*")
+	if err != nil {
+		render.Render(w, r, res.ErrInternalServer("failed to stage persistent data", err))
+		return
+	}
+	defer func() { persist.Close(); os.Remove(persist.Name()) }()
+	if err := s.backups.WriteArchive(persist); err != nil {
+		render.Render(w, r, res.ErrInternalServer("failed to archive persistent data", err))
+		return
+	}
+	persistSize, err := persist.Seek(0, io.SeekCurrent)
+	if err == nil {
+		_, err = persist.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		render.Render(w, r, res.ErrInternalServer("failed to stage persistent data", err))
+		return
+	}
+
+	// errors past this point can only be logged - the importing daemon detects
+	// incomplete bundles
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.WriteHeader(http.StatusOK)
+	bundle, err := migration.NewWriter(w, migrateReq.Passphrase)
+	if err != nil {
+		s.requestLogger(r).Error("failed to export state", "error", err)
+		return
+	}
+	var entries = []struct {
+		name string
+		data []byte
+	}{
+		{migration.EntryManifest, manifest},
+		{migration.EntryUsers, users.Bytes()},
+		{migration.EntryData, data.Bytes()},
+		{migration.EntryHistory, history.Bytes()},
+		{migration.EntryDeployKey, deployKey},
+		{migration.EntryWebhookSecret, []byte(s.state.WebhookSecret)},
+	}
+	for _, e := range entries {
+		if len(e.data) == 0 {
+			continue
+		}
+		if err := bundle.WriteFile(e.name, e.data); err != nil {
+			s.requestLogger(r).Error("failed to export state", "error", err)
+			return
+		}
+	}
+	if err := bundle.WriteFrom(migration.EntryPersist, persistSize, persist); err != nil {
+		s.requestLogger(r).Error("failed to export state", "error", err)
+		return
+	}
+	if err := bundle.Close(); err != nil {
+		s.requestLogger(r).Error("failed to export state", "error", err)
+	}
+}
+
+// migrateImportHandler loads a bundle of daemon state exported by
+// migrateExportHandler. Remotes with an active deployment are refused. The
+// bundle is staged and checked in full before any state is changed, so that
+// incomplete or corrupt uploads are rejected without a partial import.
+func (s *Server) migrateImportHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	var passphrase = r.Header.Get(api.MigrationPassphraseHeader)
+	if passphrase == "" {
+		render.Render(w, r, res.ErrBadRequest("no passphrase provided"))
+		return
+	}
+
+	manager, found := s.deployment.GetDataManager()
+	if !found {
+		render.Render(w, r, res.Err("no data manager found", http.StatusPreconditionFailed))
+		return
+	}
+	if status, _ := s.deployment.GetStatus(s.docker); status.CommitHash != "" {
+		render.Render(w, r, res.Err(
+			"a project is already deployed on this remote - run 'inertia [remote] reset' first",
+			http.StatusPreconditionFailed))
+		return
+	}
+
+	// stage the bundle - the integrity of the bundle is only verified once all
+	// of it has been read
+	bundle, err := migration.NewReader(r.Body, passphrase)
+	if err != nil {
+		render.Render(w, r, res.ErrBadRequest(err.Error()))
+		return
+	}
+	persist, err := ioutil.TempFile(s.state.DataDirectory, ".import-*")
+	if err != nil {
+		render.Render(w, r, res.ErrInternalServer("failed to stage persistent data", err))
+		return
+	}
+	defer func() { persist.Close(); os.Remove(persist.Name()) }()
+	var (
+		entries    = map[string][]byte{}
+		hasPersist bool
+	)
+	for {
+		name, entry, err := bundle.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			render.Render(w, r, res.ErrBadRequest(err.Error()))
+			return
+		}
+		if name == migration.EntryPersist {
+			hasPersist = true
+			_, err = io.Copy(persist, entry)
+		} else {
+			entries[name], err = ioutil.ReadAll(entry)
+		}
+		if err != nil {
+			render.Render(w, r, res.ErrBadRequest("failed to read "+name+": "+err.Error()))
+			return
+		}
+	}
+
+	// check staged entries
+	var manifest api.MigrationManifest
+	if err := json.Unmarshal(entries[migration.EntryManifest], &manifest); err != nil {
+		render.Render(w, r, res.ErrBadRequest("invalid bundle manifest: "+err.Error()))
+		return
+	} else if manifest.Version > migrationVersion {
+		render.Render(w, r, res.ErrBadRequest(fmt.Sprintf(
+			"bundle version %d is not supported by this daemon - try upgrading it", manifest.Version)))
+		return
+	}
+	for _, name := range []string{migration.EntryData, migration.EntryHistory} {
+		if data, ok := entries[name]; ok && !json.Valid(data) {
+			render.Render(w, r, res.ErrBadRequest("invalid bundle entry "+name))
+			return
+		}
+	}
+	if data, ok := entries[migration.EntryUsers]; ok {
+		if err := auth.CheckUsers(bytes.NewReader(data)); err != nil {
+			render.Render(w, r, res.ErrBadRequest("invalid bundle entry "+migration.EntryUsers+": "+err.Error()))
+			return
+		}
+	}
+
+	// stage files next to where they will be moved, so that failures are caught
+	// before any state is changed
+	var staged *backup.Staged
+	if hasPersist {
+		_, err := persist.Seek(0, io.SeekStart)
+		if err == nil {
+			staged, err = s.backups.StageFrom(persist)
+		}
+		if err != nil {
+			render.Render(w, r, res.ErrInternalServer("failed to import persistent data", err))
+			return
+		}
+		defer staged.Discard()
+	}
+	var deployKey *stagedDeployKey
+	if key, ok := entries[migration.EntryDeployKey]; ok {
+		if deployKey, err = stageDeployKey(key); err != nil {
+			render.Render(w, r, res.ErrInternalServer("failed to import deploy key", err))
+			return
+		}
+		defer deployKey.discard()
+	}
+
+	// apply staged entries - project data, history, and the webhook secret are
+	// written in one transaction and users in another, then staged files are
+	// moved into place. Everything has been checked, so only storage errors can
+	// interrupt the import from here.
+	if err := manager.Import(
+		entryReader(entries, migration.EntryData),
+		entryReader(entries, migration.EntryHistory),
+		string(entries[migration.EntryWebhookSecret]),
+	); err != nil {
+		render.Render(w, r, res.ErrInternalServer("failed to import project data", err))
+		return
+	}
+	if secret, ok := entries[migration.EntryWebhookSecret]; ok {
+		s.state.WebhookSecret = string(secret)
+	}
+	var users int
+	if data, ok := entries[migration.EntryUsers]; ok && s.permissions != nil {
+		if users, err = s.permissions.ImportUsers(bytes.NewReader(data)); err != nil {
+			render.Render(w, r, res.ErrInternalServer("failed to import users", err))
+			return
+		}
+	}
+	if staged != nil {
+		if err := staged.Apply(); err != nil {
+			render.Render(w, r, res.ErrInternalServer("failed to import persistent data", err))
+			return
+		}
+	}
+	if deployKey != nil {
+		if err := deployKey.apply(); err != nil {
+			render.Render(w, r, res.ErrInternalServer("failed to import deploy key", err))
+			return
+		}
+	}
+
+	// apply imported settings
+	if conf, found, err := manager.GetBackupConfiguration(); err != nil {
+		render.Render(w, r, res.ErrInternalServer("failed to load imported backup configuration", err))
+		return
+	} else if found {
+		if err := s.backups.Configure(*conf); err != nil {
+			render.Render(w, r, res.ErrInternalServer("failed to apply imported backup configuration", err))
+			return
+		}
+	}
+
+	render.Render(w, r, res.MsgOK("state imported",
+		"manifest", manifest,
+		"users", users))
+}
+
+// entryReader returns a reader for the named bundle entry, or nil if the bundle
+// does not contain it
+func entryReader(entries map[string][]byte, name string) io.Reader {
+	if data, ok := entries[name]; ok {
+		return bytes.NewReader(data)
+	}
+	return nil
+}
+
+// stagedDeployKey is a deploy key written next to the daemon's deploy key,
+// ready to replace it
+type stagedDeployKey struct {
+	key string
+	pub string
+}
+
+// stageDeployKey writes the given private key and its public key to temporary
+// files next to the daemon's deploy key
+func stageDeployKey(key []byte) (*stagedDeployKey, error) {
+	signer, err := ssh.ParsePrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid deploy key: %s", err.Error())
+	}
+	var (
+		staged = &stagedDeployKey{}
+		dir    = filepath.Dir(crypto.DaemonInertiaKeyLocation)
+	)
+	for _, f := range []struct {
+		path *string
+		data []byte
+		mode os.FileMode
+	}{
+		{&staged.key, key, 0600},
+		{&staged.pub, ssh.MarshalAuthorizedKey(signer.PublicKey()), 0644},
+	} {
+		tmp, err := ioutil.TempFile(dir, ".deploy-key-*")
+		if err != nil {
+			staged.discard()
+			return nil, err
+		}
+		*f.path = tmp.Name()
+		_, err = tmp.Write(f.data)
+		if closeErr := tmp.Close(); err == nil {
+			err = closeErr
+		}
+		if err == nil {
+			err = os.Chmod(tmp.Name(), f.mode)
+		}
+		if err != nil {
+			staged.discard()
+			return nil, err
+		}
+	}
+	return staged, nil
+}
+
+// apply replaces the daemon's deploy key with the staged key
+func (k *stagedDeployKey) apply() error {
+	if err := os.Rename(k.pub, crypto.DaemonInertiaKeyLocation+".pub"); err != nil {
+		return err
+	}
+	return os.Rename(k.key, crypto.DaemonInertiaKeyLocation)
+}
+
+// discard removes whatever remains of the staged key
+func (k *stagedDeployKey) discard() {
+	for _, path := range []string{k.key, k.pub} {
+		if path != "" {
+			os.Remove(path)
+		}
+	}
+}
@@ -0,0 +1,224 @@
+package daemon
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/backup"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/cfg"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/crypto"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/migration"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/project"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/project/mocks"
+)
+
+// newMigrateTestServer sets up a server with real data and backup managers
+func newMigrateTestServer(t *testing.T, dir string, status api.DeploymentStatus) (*Server, *project.DeploymentDataManager) {
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "persist"), 0755))
+	manager, err := project.NewDataManager(filepath.Join(dir, "project.db"), filepath.Join(dir, "key"))
+	require.NoError(t, err)
+	var fake = &mocks.FakeDeployer{}
+	fake.GetDataManagerReturns(manager, true)
+	fake.GetStatusReturns(status, nil)
+	return &Server{
+		logger:     newTestLogger(),
+		version:    "test",
+		deployment: fake,
+		state:      cfg.Config{DataDirectory: dir},
+		backups: backup.NewManager(filepath.Join(dir, "persist"),
+			filepath.Join(dir, "backups"), nil),
+	}, manager
+}
+
+func TestMigrateHandlers(t *testing.T) {
+	dir, err := ioutil.TempDir("", "inertia-migrate")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// deploy key location is shared by both servers in this test
+	var prevKeyLocation = crypto.DaemonInertiaKeyLocation
+	crypto.DaemonInertiaKeyLocation = filepath.Join(dir, "id_rsa_inertia_deploy")
+	defer func() { crypto.DaemonInertiaKeyLocation = prevKeyLocation }()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	var deployKey = pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(rsaKey),
+	})
+	require.NoError(t, ioutil.WriteFile(crypto.DaemonInertiaKeyLocation, deployKey, 0600))
+
+	// set up source
+	src, srcData := newMigrateTestServer(t, filepath.Join(dir, "src"), api.DeploymentStatus{
+		Branch:     "master",
+		CommitHash: "abcde",
+	})
+	src.state.WebhookSecret = "webhooksekret"
+	require.NoError(t, srcData.AddEnvVariable("SECRET", "sekret", true))
+	require.NoError(t, srcData.AddProjectBuildData("wow", project.DeploymentMetadata{
+		Hash: "abcde", Branch: "master"}))
+	// persisted data spans several encrypted chunks, so that a truncated bundle
+	// is only detected after earlier entries have been read
+	var persisted = make([]byte, 256*1024)
+	_, err = rand.Read(persisted)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "src", "persist", "data"), persisted, 0644))
+
+	// export
+	body, _ := json.Marshal(api.MigrationRequest{Passphrase: "hunter2"})
+	req, err := http.NewRequest("POST", "/migrate/export", bytes.NewReader(body))
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	http.HandlerFunc(src.migrateExportHandler).ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var bundle = recorder.Body.Bytes()
+	assert.NotContains(t, string(bundle), "sekret")
+
+	t.Run("import truncated bundle", func(t *testing.T) {
+		dst, dstData := newMigrateTestServer(t, filepath.Join(dir, "truncated"), api.DeploymentStatus{})
+		req, err := http.NewRequest("POST", "/migrate/import", bytes.NewReader(bundle[:len(bundle)-100]))
+		require.NoError(t, err)
+		req.Header.Set(api.MigrationPassphraseHeader, "hunter2")
+		recorder := httptest.NewRecorder()
+		http.HandlerFunc(dst.migrateImportHandler).ServeHTTP(recorder, req)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+		// nothing should have been imported
+		vars, _, err := dstData.GetDeploymentEnvVariables("")
+		assert.NoError(t, err)
+		assert.Empty(t, vars)
+		builds, err := dstData.GetProjectBuildData("wow")
+		assert.NoError(t, err)
+		assert.Empty(t, builds)
+		_, err = os.Stat(filepath.Join(dir, "truncated", "persist", "data"))
+		assert.True(t, os.IsNotExist(err))
+		assert.Empty(t, dst.state.WebhookSecret)
+	})
+
+	t.Run("import corrupt persistent data", func(t *testing.T) {
+		// rebuild the bundle with a persisted data archive that can't be extracted
+		var corrupt bytes.Buffer
+		bundleWriter, err := migration.NewWriter(&corrupt, "hunter2")
+		require.NoError(t, err)
+		bundleReader, err := migration.NewReader(bytes.NewReader(bundle), "hunter2")
+		require.NoError(t, err)
+		for {
+			name, entry, err := bundleReader.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			data, err := ioutil.ReadAll(entry)
+			require.NoError(t, err)
+			if name == migration.EntryPersist {
+				data = []byte("not an archive")
+			}
+			require.NoError(t, bundleWriter.WriteFile(name, data))
+		}
+		require.NoError(t, bundleWriter.Close())
+
+		dst, dstData := newMigrateTestServer(t, filepath.Join(dir, "corrupt"), api.DeploymentStatus{})
+		req, err := http.NewRequest("POST", "/migrate/import", &corrupt)
+		require.NoError(t, err)
+		req.Header.Set(api.MigrationPassphraseHeader, "hunter2")
+		recorder := httptest.NewRecorder()
+		http.HandlerFunc(dst.migrateImportHandler).ServeHTTP(recorder, req)
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+
+		// nothing should have been imported
+		vars, _, err := dstData.GetDeploymentEnvVariables("")
+		assert.NoError(t, err)
+		assert.Empty(t, vars)
+		builds, err := dstData.GetProjectBuildData("wow")
+		assert.NoError(t, err)
+		assert.Empty(t, builds)
+		_, found, err := dstData.GetWebhookSecret()
+		assert.NoError(t, err)
+		assert.False(t, found)
+		assert.Empty(t, dst.state.WebhookSecret)
+		key, err := ioutil.ReadFile(crypto.DaemonInertiaKeyLocation)
+		assert.NoError(t, err)
+		assert.Equal(t, deployKey, key)
+		_, err = os.Stat(crypto.DaemonInertiaKeyLocation + ".pub")
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("export without passphrase", func(t *testing.T) {
+		req, err := http.NewRequest("POST", "/migrate/export", bytes.NewReader([]byte(`{}`)))
+		require.NoError(t, err)
+		recorder := httptest.NewRecorder()
+		http.HandlerFunc(src.migrateExportHandler).ServeHTTP(recorder, req)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("import onto active deployment", func(t *testing.T) {
+		req, err := http.NewRequest("POST", "/migrate/import", bytes.NewReader(bundle))
+		require.NoError(t, err)
+		req.Header.Set(api.MigrationPassphraseHeader, "hunter2")
+		recorder := httptest.NewRecorder()
+		http.HandlerFunc(src.migrateImportHandler).ServeHTTP(recorder, req)
+		assert.Equal(t, http.StatusPreconditionFailed, recorder.Code)
+	})
+
+	t.Run("import with wrong passphrase", func(t *testing.T) {
+		dst, _ := newMigrateTestServer(t, filepath.Join(dir, "wrong"), api.DeploymentStatus{})
+		req, err := http.NewRequest("POST", "/migrate/import", bytes.NewReader(bundle))
+		require.NoError(t, err)
+		req.Header.Set(api.MigrationPassphraseHeader, "hunter3")
+		recorder := httptest.NewRecorder()
+		http.HandlerFunc(dst.migrateImportHandler).ServeHTTP(recorder, req)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("import", func(t *testing.T) {
+		require.NoError(t, os.Remove(crypto.DaemonInertiaKeyLocation))
+		dst, dstData := newMigrateTestServer(t, filepath.Join(dir, "dst"), api.DeploymentStatus{})
+		req, err := http.NewRequest("POST", "/migrate/import", bytes.NewReader(bundle))
+		require.NoError(t, err)
+		req.Header.Set(api.MigrationPassphraseHeader, "hunter2")
+		recorder := httptest.NewRecorder()
+		http.HandlerFunc(dst.migrateImportHandler).ServeHTTP(recorder, req)
+		require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+
+		var manifest api.MigrationManifest
+		_, err = api.Unmarshal(recorder.Body, api.KV{Key: "manifest", Value: &manifest})
+		require.NoError(t, err)
+		assert.Equal(t, "abcde", manifest.Deployment.CommitHash)
+		assert.Equal(t, "master", manifest.Deployment.Branch)
+
+		vars, _, err := dstData.GetDeploymentEnvVariables("")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"SECRET=sekret"}, vars)
+		data, err := ioutil.ReadFile(filepath.Join(dir, "dst", "persist", "data"))
+		assert.NoError(t, err)
+		assert.Equal(t, persisted, data)
+		key, err := ioutil.ReadFile(crypto.DaemonInertiaKeyLocation)
+		assert.NoError(t, err)
+		assert.Equal(t, deployKey, key)
+		_, err = os.Stat(crypto.DaemonInertiaKeyLocation + ".pub")
+		assert.NoError(t, err)
+
+		assert.Equal(t, "webhooksekret", dst.state.WebhookSecret)
+		secret, found, err := dstData.GetWebhookSecret()
+		assert.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, "webhooksekret", secret)
+		builds, err := dstData.GetProjectBuildData("wow")
+		assert.NoError(t, err)
+		require.Len(t, builds, 1)
+		assert.Equal(t, "abcde", builds[0].Hash)
+	})
+}
@@ -0,0 +1,81 @@
+package daemon
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/render"
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/crypto"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/project"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/res"
+)
+
+// planHandler describes what bringing the deployment online with the given
+// request would change, without changing the deployment
+func (s *Server) planHandler(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		render.Render(w, r, res.ErrBadRequest(err.Error()))
+		return
+	}
+	defer r.Body.Close()
+	var upReq api.UpRequest
+	if err = json.Unmarshal(body, &upReq); err != nil {
+		render.Render(w, r, res.ErrBadRequest(err.Error()))
+		return
+	}
+	var gitOpts = upReq.GitOptions
+
+	// Check for matching remotes if the project has been deployed
+	if status, _ := s.deployment.GetStatus(s.docker); status.CommitHash != "" {
+		if err := s.deployment.CompareRemotes(gitOpts.RemoteURL); err != nil {
+			render.Render(w, r, res.Err(err.Error(), http.StatusPreconditionFailed))
+			return
+		}
+	}
+
+	plan, err := s.deployment.Plan(s.docker, project.DeploymentConfig{
+		ProjectName:            upReq.Project,
+		Profile:                upReq.Profile,
+		BuildType:              upReq.BuildType,
+		BuildFilePath:          upReq.BuildFilePath,
+		RemoteURL:              gitOpts.RemoteURL,
+		Branch:                 gitOpts.Branch,
+		PemFilePath:            crypto.DaemonInertiaKeyLocation,
+		IntermediaryContainers: upReq.IntermediaryContainers,
+		SlackNotificationURL:   upReq.SlackNotificationURL,
+	}, project.DeployOptions{Commit: gitOpts.Commit})
+	if err != nil {
+		render.Render(w, r, res.ErrInternalServer("failed to plan deployment", err))
+		return
+	}
+
+	// Log sinks are configured by the daemon rather than the deployment
+	var current []string
+	if manager, found := s.deployment.GetDataManager(); found {
+		if conf, found, err := manager.GetLogSinkConfiguration(); err == nil && found {
+			current = logSinkTypes(conf.Sinks)
+		}
+	}
+	plan.Settings = append(plan.Settings, api.PlanSetting{
+		Name:    "log sinks",
+		Current: strings.Join(current, ", "),
+		Planned: strings.Join(logSinkTypes(upReq.LogSinks), ", "),
+	})
+
+	render.Render(w, r, res.MsgOK("deployment planned",
+		"plan", plan))
+}
+
+// logSinkTypes lists the types of the given sinks, without their URLs, which
+// may contain credentials
+func logSinkTypes(sinks []api.LogSink) []string {
+	var types = make([]string, len(sinks))
+	for i, sink := range sinks {
+		types[i] = sink.Type
+	}
+	return types
+}
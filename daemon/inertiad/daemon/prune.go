@@ -2,9 +2,9 @@ package daemon
 
 import (
 	"net/http"
-	"os"
 
 	"github.com/go-chi/render"
+	"github.com/sirupsen/logrus"
 
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/log"
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/res"
@@ -19,7 +19,7 @@ func (s *Server) pruneHandler(w http.ResponseWriter, r *http.Request) {
 
 	var stream = log.NewStreamer(log.StreamerOptions{
 		Request:    r,
-		Stdout:     os.Stdout,
+		Stdout:     log.Writer(s.requestLogger(r), logrus.InfoLevel),
 		HTTPWriter: w,
 	})
 	defer stream.Close()
@@ -2,9 +2,10 @@ package daemon
 
 import (
 	"net/http"
-	"os"
 
 	"github.com/go-chi/render"
+	"github.com/sirupsen/logrus"
+	"github.com/ubclaunchpad/inertia/api"
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/log"
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/res"
 )
@@ -18,16 +19,30 @@ func (s *Server) resetHandler(w http.ResponseWriter, r *http.Request) {
 
 	var stream = log.NewStreamer(log.StreamerOptions{
 		Request:    r,
-		Stdout:     os.Stdout,
+		Stdout:     log.Writer(s.requestLogger(r), logrus.InfoLevel),
 		HTTPWriter: w,
 	})
 	defer stream.Close()
 
+	// resetting clears persistent data, so keep a copy
+	if s.backups != nil && s.backups.HasData() {
+		created, err := s.backups.Create(r.Context())
+		if err != nil {
+			stream.Error(res.ErrInternalServer("failed to back up persistent data", err))
+			return
+		}
+		stream.Println("Persistent data backed up to " + created.Name)
+	}
+
 	// Goodbye deployment
 	if err := s.deployment.Destroy(s.docker, stream); err != nil {
 		stream.Error(res.ErrInternalServer("failed to remove deployment", err))
 		return
 	}
 
+	// Log sinks are configured per deployment - an empty configuration is
+	// always valid
+	s.logSinks.Configure(api.LogSinkConfiguration{})
+
 	stream.Success(res.MsgOK("project removed"))
 }
@@ -0,0 +1,83 @@
+package daemon
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/go-chi/render"
+
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/res"
+)
+
+// secretsHandler manages requests to manage secret files
+func (s *Server) secretsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method == "POST" {
+		secretsPostHandler(s, w, r)
+	} else if r.Method == "GET" {
+		secretsGetHandler(s, w, r)
+	}
+}
+
+func secretsPostHandler(s *Server, w http.ResponseWriter, r *http.Request) {
+	// Parse request
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		render.Render(w, r, res.ErrBadRequest(err.Error()))
+		return
+	}
+	defer r.Body.Close()
+	var secretReq api.SecretRequest
+	if err = json.Unmarshal(body, &secretReq); err != nil {
+		render.Render(w, r, res.ErrBadRequest(err.Error()))
+		return
+	}
+	if secretReq.Name == "" {
+		render.Render(w, r, res.ErrBadRequest("no secret name provided"))
+		return
+	}
+	if !secretReq.Remove && len(secretReq.Value) == 0 {
+		render.Render(w, r, res.ErrBadRequest("no secret value provided"))
+		return
+	}
+
+	manager, found := s.deployment.GetDataManager()
+	if !found {
+		render.Render(w, r, res.Err("no secrets manager found", http.StatusPreconditionFailed))
+		return
+	}
+
+	// Add, update, or remove secrets from storage
+	if secretReq.Remove {
+		err = manager.RemoveSecrets(secretReq.Name)
+	} else {
+		err = manager.AddSecret(secretReq.Name, secretReq.Value)
+	}
+	if err != nil {
+		render.Render(w, r, res.ErrInternalServer("failed to update secret", err))
+		return
+	}
+
+	render.Render(w, r, res.Msg(
+		"secret updated - this will be applied the next time your project is deployed",
+		http.StatusAccepted,
+		"secret", secretReq.Name))
+}
+
+func secretsGetHandler(s *Server, w http.ResponseWriter, r *http.Request) {
+	manager, found := s.deployment.GetDataManager()
+	if !found {
+		render.Render(w, r, res.Err("no secrets manager found", http.StatusPreconditionFailed))
+		return
+	}
+
+	names, err := manager.GetSecrets()
+	if err != nil {
+		render.Render(w, r, res.ErrInternalServer("failed to retrieve secrets", err))
+		return
+	}
+
+	render.Render(w, r, res.Msg("configured secrets retrieved", http.StatusOK,
+		"secrets", names))
+}
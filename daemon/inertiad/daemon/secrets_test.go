@@ -0,0 +1,39 @@
+package daemon
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/project/mocks"
+)
+
+func TestSecretsHandler(t *testing.T) {
+	var s = &Server{deployment: &mocks.FakeDeployer{}}
+	var handler = http.HandlerFunc(s.secretsHandler)
+
+	tests := []struct {
+		name     string
+		req      api.SecretRequest
+		wantCode int
+	}{
+		{"no name", api.SecretRequest{Value: []byte("wow")}, http.StatusBadRequest},
+		{"no value", api.SecretRequest{Name: "tls.key"}, http.StatusBadRequest},
+		{"no data manager", api.SecretRequest{Name: "tls.key", Value: []byte("wow")}, http.StatusPreconditionFailed},
+		{"remove without data manager", api.SecretRequest{Name: "tls.key", Remove: true}, http.StatusPreconditionFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(tt.req)
+			req, err := http.NewRequest("POST", "/secrets", bytes.NewReader(body))
+			assert.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			assert.Equal(t, tt.wantCode, recorder.Code)
+		})
+	}
+}
@@ -6,25 +6,26 @@ import (
 
 	"github.com/docker/docker/api/types"
 	docker "github.com/docker/docker/client"
+	"github.com/sirupsen/logrus"
 )
 
-func downloadDeps(cli *docker.Client, images ...string) {
+func downloadDeps(cli *docker.Client, logger *logrus.Entry, images ...string) {
 	var wait sync.WaitGroup
 	wait.Add(len(images))
 	for _, i := range images {
-		go dockerPull(i, cli, &wait)
+		go dockerPull(i, cli, logger, &wait)
 	}
 	wait.Wait()
 	cli.Close()
 }
 
-func dockerPull(image string, cli *docker.Client, wait *sync.WaitGroup) {
+func dockerPull(image string, cli *docker.Client, logger *logrus.Entry, wait *sync.WaitGroup) {
 	defer wait.Done()
-	println("Downloading " + image)
+	logger.WithField("image", image).Info("downloading image")
 	_, err := cli.ImagePull(context.Background(), image, types.ImagePullOptions{})
 	if err != nil {
-		println(err.Error())
+		logger.WithError(err).WithField("image", image).Error("failed to download image")
 	} else {
-		println(image + " download complete")
+		logger.WithField("image", image).Info("image download complete")
 	}
 }
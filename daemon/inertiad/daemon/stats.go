@@ -0,0 +1,163 @@
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/render"
+	"github.com/sirupsen/logrus"
+
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/containers"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/host"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/log"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/res"
+)
+
+const (
+	defaultStatsInterval = 2 * time.Second
+	minimumStatsInterval = 1 * time.Second
+)
+
+// statsHandler handles requests for the resource usage of the host and project
+// containers, optionally streaming updates over a websocket
+func (s *Server) statsHandler(w http.ResponseWriter, r *http.Request) {
+	var (
+		params       = r.URL.Query()
+		shouldStream bool
+		interval     = defaultStatsInterval
+		err          error
+	)
+	if streamParam := params.Get(api.Stream); streamParam != "" {
+		if shouldStream, err = strconv.ParseBool(streamParam); err != nil {
+			render.Render(w, r, res.ErrBadRequest(err.Error()))
+			return
+		}
+	}
+	if intervalParam := params.Get(api.Interval); intervalParam != "" {
+		seconds, err := strconv.Atoi(intervalParam)
+		if err != nil {
+			render.Render(w, r, res.ErrBadRequest("invalid interval", "error", err))
+			return
+		}
+		interval = time.Duration(seconds) * time.Second
+		if interval < minimumStatsInterval {
+			interval = minimumStatsInterval
+		}
+	}
+
+	if !shouldStream {
+		stats, err := s.getStats(r.Context())
+		if err != nil {
+			render.Render(w, r, res.ErrInternalServer("failed to retrieve stats", err))
+			return
+		}
+		render.Render(w, r, res.MsgOK("stats retrieved",
+			"stats", stats))
+		return
+	}
+
+	socket, err := s.websocket.Upgrade(w, r, nil)
+	if err != nil {
+		render.Render(w, r,
+			res.ErrInternalServer("failed to esablish websocket connection", err))
+		return
+	}
+	var stream = log.NewStreamer(log.StreamerOptions{
+		Request:    r,
+		Stdout:     log.Writer(s.requestLogger(r), logrus.InfoLevel),
+		Socket:     socket,
+		HTTPWriter: w,
+	})
+	defer stream.Close()
+	writer, err := stream.GetSocketWriter()
+	if err != nil {
+		stream.Error(res.ErrInternalServer("failed to write to socket", err))
+		return
+	}
+
+	// the connection is hijacked, so watch for the client going away by reading
+	// from the socket until it is closed
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := socket.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	var ticker = time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		stats, err := s.getStats(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				stream.Error(res.ErrInternalServer("failed to retrieve stats", err))
+			}
+			return
+		}
+		b, _ := json.Marshal(stats)
+		if _, err := writer.Write(b); err != nil {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// getStats samples the resource usage of the host and project containers
+func (s *Server) getStats(ctx context.Context) (*api.Stats, error) {
+	var stats = &api.Stats{
+		Time:       time.Now(),
+		Containers: make([]api.ContainerStats, 0),
+	}
+
+	mem, err := host.GetMemory()
+	if err != nil {
+		return nil, err
+	}
+	stats.Host.MemoryTotal = mem.Total
+	stats.Host.MemoryAvailable = mem.Available
+	if s.state.DataDirectory != "" {
+		disk, err := host.GetDisk(s.state.DataDirectory)
+		if err != nil {
+			return nil, err
+		}
+		stats.Host.DiskPath = disk.Path
+		stats.Host.DiskTotal = disk.Total
+		stats.Host.DiskFree = disk.Free
+	}
+
+	list, err := containers.ListStats(ctx, s.docker)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range list {
+		stats.Containers = append(stats.Containers, containerStats(c))
+	}
+	return stats, nil
+}
+
+func containerStats(c containers.Stats) api.ContainerStats {
+	return api.ContainerStats{
+		Name:        c.Name,
+		CPUPercent:  c.CPUPercent,
+		MemoryUsage: c.MemoryUsage,
+		MemoryLimit: c.MemoryLimit,
+		NetworkRx:   c.NetworkRx,
+		NetworkTx:   c.NetworkTx,
+		BlockRead:   c.BlockRead,
+		BlockWrite:  c.BlockWrite,
+		PIDs:        c.PIDs,
+	}
+}
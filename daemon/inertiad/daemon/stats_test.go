@@ -0,0 +1,51 @@
+package daemon
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/containers"
+)
+
+func TestStatsHandler_invalidParams(t *testing.T) {
+	var s = &Server{}
+	var handler = http.HandlerFunc(s.statsHandler)
+	for _, query := range []string{
+		"?stream=maybe",
+		"?stream=true&interval=often",
+	} {
+		t.Run(query, func(t *testing.T) {
+			var recorder = httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/stats"+query, nil))
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		})
+	}
+}
+
+func Test_containerStats(t *testing.T) {
+	assert.Equal(t, api.ContainerStats{
+		Name:        "/web",
+		CPUPercent:  12.5,
+		MemoryUsage: 1,
+		MemoryLimit: 2,
+		NetworkRx:   3,
+		NetworkTx:   4,
+		BlockRead:   5,
+		BlockWrite:  6,
+		PIDs:        7,
+	}, containerStats(containers.Stats{
+		Name:        "/web",
+		CPUPercent:  12.5,
+		MemoryUsage: 1,
+		MemoryLimit: 2,
+		NetworkRx:   3,
+		NetworkTx:   4,
+		BlockRead:   5,
+		BlockWrite:  6,
+		PIDs:        7,
+	}))
+}
@@ -2,14 +2,18 @@ package daemon
 
 import (
 	"encoding/json"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
-	"os"
+	"time"
 
 	"github.com/go-chi/render"
+	"github.com/sirupsen/logrus"
 	"github.com/ubclaunchpad/inertia/api"
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/crypto"
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/log"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/logsink"
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/project"
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/res"
 )
@@ -29,12 +33,21 @@ func (s *Server) upHandler(w http.ResponseWriter, r *http.Request) {
 	}
 	var gitOpts = upReq.GitOptions
 
-	// apply configuration updates
-	if upReq.WebHookSecret != "" {
-		s.state.WebhookSecret = upReq.WebHookSecret
+	// Check the profile's log sinks, which are only applied once the project
+	// has been deployed
+	var sinkConf = api.LogSinkConfiguration{
+		Labels: map[string]string{"project": upReq.Project, "profile": upReq.Profile},
+		Sinks:  upReq.LogSinks,
+	}
+	if err = logsink.Validate(sinkConf); err != nil {
+		render.Render(w, r, res.ErrBadRequest(err.Error()))
+		return
 	}
+
+	// apply configuration updates
 	conf := project.DeploymentConfig{
 		ProjectName:            upReq.Project,
+		Profile:                upReq.Profile,
 		BuildType:              upReq.BuildType,
 		BuildFilePath:          upReq.BuildFilePath,
 		RemoteURL:              gitOpts.RemoteURL,
@@ -45,15 +58,23 @@ func (s *Server) upHandler(w http.ResponseWriter, r *http.Request) {
 	}
 	s.deployment.SetConfig(conf)
 
-	// Configure streamer
+	// Configure streamer, which also logs output for this deploy
+	var out = log.Writer(s.deployLogger(r), logrus.InfoLevel)
+	defer out.Close()
 	var stream = log.NewStreamer(log.StreamerOptions{
 		Request:    r,
-		Stdout:     os.Stdout,
+		Stdout:     out,
 		HTTPWriter: w,
 		HTTPStream: upReq.Stream,
 	})
 	defer stream.Close()
 
+	// Refuse to build if the build would likely run out of disk space
+	if err = s.disk.Check(r.Context()); err != nil {
+		stream.Error(res.Err(err.Error(), http.StatusInsufficientStorage))
+		return
+	}
+
 	// Check for existing git repository, clone if no git repository exists.
 	var skipUpdate = false
 	if status, _ := s.deployment.GetStatus(s.docker); status.CommitHash == "" {
@@ -76,27 +97,113 @@ func (s *Server) upHandler(w http.ResponseWriter, r *http.Request) {
 	// Change deployment parameters if necessary
 	s.deployment.SetConfig(project.DeploymentConfig{
 		ProjectName: upReq.Project,
+		Profile:     upReq.Profile,
 		Branch:      gitOpts.Branch,
 	})
 
 	// Deploy project
-	deploy, err := s.deployment.Deploy(s.docker, stream, project.DeployOptions{
+	buildErr, deployErr := s.deploy("up", stream, project.DeployOptions{
 		SkipUpdate: skipUpdate,
+		Commit:     gitOpts.Commit,
 	})
-	if err != nil {
-		stream.Error(res.ErrInternalServer("failed to build project", err))
+	if buildErr != nil {
+		stream.Error(res.ErrInternalServer("failed to build project", buildErr))
+		return
+	} else if deployErr != nil {
+		stream.Error(res.ErrInternalServer("failed to deploy project", deployErr))
 		return
 	}
 
-	if err = deploy(); err != nil {
-		stream.Error(res.ErrInternalServer("failed to deploy project", err))
+	// Apply settings that should only take effect with a successful deploy
+	s.applyUpSettings(r, upReq.WebHookSecret, sinkConf)
+
+	stream.Success(res.Msg("Project startup initiated!", http.StatusCreated))
+}
+
+// applyUpSettings applies and saves the webhook secret, if one is given, and
+// forwards logs to the given log sinks from now on
+func (s *Server) applyUpSettings(r *http.Request, webhookSecret string, sinkConf api.LogSinkConfiguration) {
+	manager, found := s.deployment.GetDataManager()
+	if webhookSecret != "" {
+		s.state.WebhookSecret = webhookSecret
+		if found {
+			if err := manager.SetWebhookSecret(webhookSecret); err != nil {
+				s.requestLogger(r).Error("failed to save webhook secret", "error", err)
+			}
+		}
+	}
+	if err := s.logSinks.Configure(sinkConf); err != nil {
+		s.requestLogger(r).Error("failed to configure log sinks", "error", err)
 		return
 	}
+	if found {
+		if err := manager.SetLogSinkConfiguration(sinkConf); err != nil {
+			s.requestLogger(r).Error("failed to save log sink configuration", "error", err)
+		}
+	}
+}
 
-	// Update container management history following a successful build and deployment
-	if err = s.deployment.UpdateContainerHistory(s.docker); err != nil {
-		stream.Println("warning: failed to update container history:", err)
+// redeployHandler rebuilds and restarts the active deployment with its current
+// configuration
+func (s *Server) redeployHandler(w http.ResponseWriter, r *http.Request) {
+	if status, _ := s.deployment.GetStatus(s.docker); status.CommitHash == "" {
+		render.Render(w, r, res.Err(msgNoDeployment, http.StatusPreconditionFailed))
+		return
+	}
+
+	// Configure streamer, which also logs output for this deploy
+	var out = log.Writer(s.deployLogger(r), logrus.InfoLevel)
+	defer out.Close()
+	var stream = log.NewStreamer(log.StreamerOptions{
+		Request:    r,
+		Stdout:     out,
+		HTTPWriter: w,
+		HTTPStream: r.URL.Query().Get(api.Stream) == "true",
+	})
+	defer stream.Close()
+
+	// Refuse to build if the build would likely run out of disk space
+	if err := s.disk.Check(r.Context()); err != nil {
+		stream.Error(res.Err(err.Error(), http.StatusInsufficientStorage))
+		return
+	}
+
+	buildErr, deployErr := s.deploy("redeploy", stream, project.DeployOptions{})
+	if buildErr != nil {
+		stream.Error(res.ErrInternalServer("failed to build project", buildErr))
+		return
+	} else if deployErr != nil {
+		stream.Error(res.ErrInternalServer("failed to deploy project", deployErr))
+		return
 	}
 
 	stream.Success(res.Msg("Project startup initiated!", http.StatusCreated))
 }
+
+// deploy builds and starts the project, recording metrics, events, and the
+// deployment history. Errors building the project are returned as buildErr,
+// and errors starting it as deployErr.
+func (s *Server) deploy(trigger string, out io.Writer, opts project.DeployOptions) (buildErr, deployErr error) {
+	var start = time.Now()
+	var finished = s.buildStarted(trigger)
+	deploy, changes, err := s.deployment.Deploy(s.docker, out, opts)
+	s.metrics.buildCompleted(start, err)
+	if err != nil {
+		s.metrics.deploymentCompleted(start, err)
+		finished(err)
+		return err, nil
+	}
+
+	err = deploy()
+	s.metrics.deploymentCompleted(start, err)
+	finished(err)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update container management history following a successful build and deployment
+	if err = s.deployment.UpdateContainerHistory(s.docker, changes); err != nil {
+		fmt.Fprintln(out, "warning: failed to update container history:", err)
+	}
+	return nil, nil
+}
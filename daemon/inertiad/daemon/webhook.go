@@ -1,14 +1,15 @@
 package daemon
 
 import (
-	"fmt"
+	"context"
 	"io/ioutil"
 	"net/http"
-	"os"
 
 	"github.com/go-chi/render"
+	"github.com/sirupsen/logrus"
 	"github.com/ubclaunchpad/inertia/api"
 	"github.com/ubclaunchpad/inertia/common"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/log"
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/project"
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/res"
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/webhook"
@@ -22,7 +23,7 @@ func (s *Server) webhookHandler(w http.ResponseWriter, r *http.Request) {
 	body, err := ioutil.ReadAll(r.Body)
 	if err != nil {
 		msg := "unable to read payload: " + err.Error()
-		println(msg)
+		s.logger.Warn(msg)
 		render.Render(w, r, res.ErrBadRequest(msg))
 		return
 	}
@@ -32,11 +33,13 @@ func (s *Server) webhookHandler(w http.ResponseWriter, r *http.Request) {
 
 	// ensure validity
 	if s.state.WebhookSecret == "" {
-		println("warning: no webhook secret is set up yet! set one in inertia.toml and run inertia [remote] up")
+		s.logger.Warn("no webhook secret is set up yet! set one in inertia.toml and run inertia [remote] up")
 	}
 	if err := webhook.Verify(host, s.state.WebhookSecret, r.Header, body); err != nil {
+		s.metrics.webhookReceived(host, "unverified")
 		msg := "unable to verify payload: " + err.Error()
-		println(msg)
+		s.logger.WithField("source", host).Warn(msg)
+		s.events.Publish(api.EventWebhookIgnored, msg, "source", host)
 		render.Render(w, r, res.ErrBadRequest(msg))
 		return
 	}
@@ -44,8 +47,10 @@ func (s *Server) webhookHandler(w http.ResponseWriter, r *http.Request) {
 	// retrieve payload
 	payload, err := webhook.Parse(host, event, r.Header, body)
 	if err != nil {
+		s.metrics.webhookReceived(host, "invalid")
 		msg := "unable to parse payload: " + err.Error()
-		println(msg)
+		s.logger.WithField("source", host).Warn(msg)
+		s.events.Publish(api.EventWebhookIgnored, msg, "source", host)
 		render.Render(w, r, res.ErrBadRequest(msg))
 		return
 	}
@@ -53,69 +58,93 @@ func (s *Server) webhookHandler(w http.ResponseWriter, r *http.Request) {
 	// process event
 	switch event := payload.GetEventType(); event {
 	case webhook.PingEvent:
-		println("ping webhook received")
+		s.metrics.webhookReceived(host, "ping")
+		s.logger.WithField("source", host).Info("ping webhook received")
+		s.events.Publish(api.EventWebhookReceived, "ping webhook received",
+			"source", host, "event", event)
 		render.Render(w, r, res.Msg(api.MsgDaemonOK, http.StatusAccepted))
 		return
 	case webhook.PushEvent:
+		s.metrics.webhookReceived(host, "accepted")
 		render.Render(w, r, res.Msg(api.MsgDaemonOK, http.StatusAccepted))
 		processPushEvent(s, payload)
 	// case webhook.PullEvent:
 	//	fmt.Fprint(w, common.MsgDaemonOK)
 	// 	processPullRequestEvent(payload)
 	default:
-		println("unrecognized event type")
+		s.metrics.webhookReceived(host, "unsupported")
+		s.logger.WithFields(logrus.Fields{"source": host, "type": event}).Warn("unrecognized webhook event type")
+		s.events.Publish(api.EventWebhookIgnored, "unrecognized webhook event type",
+			"source", host, "event", event)
 		render.Render(w, r, res.ErrBadRequest("unrecognized event type",
 			"type", event))
 	}
 }
 
 // specialized handler for docker webhooks
-func dockerWebhookHandler(w http.ResponseWriter, r *http.Request) {
+func (s *Server) dockerWebhookHandler(w http.ResponseWriter, r *http.Request) {
 	p, err := webhook.ParseDocker(r)
 	if err != nil {
-		fmt.Fprintln(os.Stdout, err.Error())
+		s.logger.WithError(err).Warn("unable to parse dockerhub payload")
 		return
 	}
 
-	fmt.Printf("Received dockerhub webhook event: %s:%s\n", p.GetRepoName(), p.GetTag())
+	s.logger.WithFields(logrus.Fields{"repository": p.GetRepoName(), "tag": p.GetTag()}).Info("received dockerhub webhook event")
 }
 
 // processPushEvent prints information about the given PushEvent.
 func processPushEvent(s *Server, p webhook.Payload) {
-	fmt.Printf("Received %s push event: %s (%s)\n",
-		p.GetSource(), p.GetRepoName(), p.GetRef())
+	s.logger.WithFields(logrus.Fields{
+		"source":     p.GetSource(),
+		"repository": p.GetRepoName(),
+		"ref":        p.GetRef(),
+	}).Info("received push event")
+	s.events.Publish(api.EventWebhookReceived, "push webhook received",
+		"source", p.GetSource(), "event", webhook.PushEvent,
+		"repository", p.GetRepoName(), "ref", p.GetRef())
 
 	// Ignore event if repository not set up yet, otherwise
 	// let deploy() handle the update.
 	if status, _ := s.deployment.GetStatus(s.docker); status.CommitHash == "" {
-		fmt.Println(msgNoDeployment)
+		s.logger.Info("ignoring event: no deployment is currently active")
+		s.events.Publish(api.EventWebhookIgnored, "no deployment is currently active",
+			"source", p.GetSource(), "ref", p.GetRef())
 		return
 	}
 
 	// Check for matching remotes
 	if err := s.deployment.CompareRemotes(p.GetSSHURL()); err != nil {
-		fmt.Println(err.Error())
+		s.logger.WithError(err).Warn("ignoring event")
+		s.events.Publish(api.EventWebhookIgnored, err.Error(),
+			"source", p.GetSource(), "ref", p.GetRef())
 		return
 	}
 
 	// Check for matching branch
 	var branch = common.GetBranchFromRef(p.GetRef())
 	if s.deployment.GetBranch() != branch {
-		fmt.Printf("Ignoring event: event branch %s does not match deployed branch %s\n",
-			branch, s.deployment.GetBranch())
+		s.logger.WithFields(logrus.Fields{"branch": branch, "deployed": s.deployment.GetBranch()}).Info("ignoring event: event branch does not match deployed branch")
+		s.events.Publish(api.EventWebhookIgnored, "event branch does not match deployed branch",
+			"source", p.GetSource(), "ref", p.GetRef(), "branch", branch)
 		return
 	}
 
 	// If branches match, deploy
-	fmt.Printf("Accepting event: event branch %s matches deployed branch %s\n",
-		branch, s.deployment.GetBranch())
-	deploy, err := s.deployment.Deploy(s.docker, os.Stdout, project.DeployOptions{})
-	if err != nil {
-		fmt.Println("Build failed: " + err.Error())
+	var logger = s.deployLogger(nil)
+	logger.WithField("branch", branch).Info("accepting event: event branch matches deployed branch")
+	if err := s.disk.Check(context.Background()); err != nil {
+		logger.WithError(err).Error("refusing to build")
 		return
 	}
-
-	if err = deploy(); err != nil {
-		fmt.Println("Deploy failed: " + err.Error())
+	var out = log.Writer(logger, logrus.InfoLevel)
+	defer out.Close()
+	buildErr, deployErr := s.deploy("webhook", out, project.DeployOptions{})
+	if buildErr != nil {
+		logger.WithError(buildErr).Error("build failed")
+		return
+	} else if deployErr != nil {
+		logger.WithError(deployErr).Error("deploy failed")
+		return
 	}
+	logger.Info("deploy complete")
 }
@@ -59,7 +59,8 @@ func Test_webhookHandler(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			var s = &Server{
-				state: cfg.Config{WebhookSecret: tt.args.secret},
+				logger: newTestLogger(),
+				state:  cfg.Config{WebhookSecret: tt.args.secret},
 			}
 			recorder := httptest.NewRecorder()
 			handler := http.HandlerFunc(s.webhookHandler)
@@ -0,0 +1,3 @@
+// Package disk implements monitoring of free disk space, pruning Docker assets
+// and warning when space runs low
+package disk
@@ -0,0 +1,94 @@
+package disk
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/host"
+)
+
+// DefaultMinimumFree is the threshold used when none is configured
+const DefaultMinimumFree = "10%"
+
+// threshold is a minimum amount of free space, either in bytes or as a
+// percentage of the filesystem
+type threshold struct {
+	bytes   uint64
+	percent float64
+}
+
+// parseThreshold parses a size such as "2GiB", or a percentage such as "10%"
+func parseThreshold(s string) (threshold, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasSuffix(s, "%") {
+		p, err := strconv.ParseFloat(strings.TrimSuffix(s, "%"), 64)
+		if err != nil || p <= 0 || p >= 100 {
+			return threshold{}, fmt.Errorf("invalid percentage '%s': must be between 0%% and 100%%", s)
+		}
+		return threshold{percent: p}, nil
+	}
+	b, err := parseSize(s)
+	if err != nil {
+		return threshold{}, err
+	}
+	return threshold{bytes: b}, nil
+}
+
+// below reports whether the given disk has less free space than the threshold
+func (t threshold) below(d host.Disk) bool {
+	if t.percent > 0 {
+		return 100-d.UsedPercent() < t.percent
+	}
+	return d.Free < t.bytes
+}
+
+func (t threshold) String() string {
+	if t.percent > 0 {
+		return strconv.FormatFloat(t.percent, 'f', -1, 64) + "%"
+	}
+	return formatSize(t.bytes)
+}
+
+// units are binary multiples, keyed by all accepted spellings
+var units = map[string]uint64{
+	"": 1, "b": 1,
+	"k": 1 << 10, "kb": 1 << 10, "kib": 1 << 10,
+	"m": 1 << 20, "mb": 1 << 20, "mib": 1 << 20,
+	"g": 1 << 30, "gb": 1 << 30, "gib": 1 << 30,
+	"t": 1 << 40, "tb": 1 << 40, "tib": 1 << 40,
+}
+
+// parseSize parses a size such as "512MiB" or "2G"
+func parseSize(s string) (uint64, error) {
+	var i = strings.IndexFunc(s, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+	if i < 0 {
+		i = len(s)
+	}
+	var number, unit = s[:i], strings.ToLower(strings.TrimSpace(s[i:]))
+	multiplier, ok := units[unit]
+	if !ok {
+		return 0, fmt.Errorf("invalid size '%s': unknown unit '%s'", s, s[i:])
+	}
+	v, err := strconv.ParseFloat(number, 64)
+	if err != nil || v <= 0 {
+		return 0, fmt.Errorf("invalid size '%s'", s)
+	}
+	return uint64(v * float64(multiplier)), nil
+}
+
+// formatSize renders the given number of bytes in human-readable units
+func formatSize(size uint64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%dB", size)
+	}
+	var div, exp = uint64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
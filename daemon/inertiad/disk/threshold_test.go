@@ -0,0 +1,52 @@
+package disk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/host"
+)
+
+func Test_parseThreshold(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    threshold
+		wantErr bool
+	}{
+		{"10%", threshold{percent: 10}, false},
+		{"2.5%", threshold{percent: 2.5}, false},
+		{"2GiB", threshold{bytes: 2 << 30}, false},
+		{"512 MB", threshold{bytes: 512 << 20}, false},
+		{"1.5g", threshold{bytes: 3 << 29}, false},
+		{"1024", threshold{bytes: 1024}, false},
+		{"0%", threshold{}, true},
+		{"100%", threshold{}, true},
+		{"lots", threshold{}, true},
+		{"2PB", threshold{}, true},
+		{"0GiB", threshold{}, true},
+		{"", threshold{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parseThreshold(tt.in)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_threshold_below(t *testing.T) {
+	var disk = host.Disk{Path: "/app/host", Total: 100 << 30, Free: 5 << 30}
+	assert.True(t, threshold{percent: 10}.below(disk))
+	assert.False(t, threshold{percent: 5}.below(disk))
+	assert.True(t, threshold{bytes: 6 << 30}.below(disk))
+	assert.False(t, threshold{bytes: 5 << 30}.below(disk))
+
+	assert.Equal(t, "10%", threshold{percent: 10}.String())
+	assert.Equal(t, "2.0GiB", threshold{bytes: 2 << 30}.String())
+}
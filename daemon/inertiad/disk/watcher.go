@@ -0,0 +1,271 @@
+package disk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/host"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/notify"
+)
+
+// ErrInsufficientSpace indicates that there is not enough free disk space to
+// safely build a project, even after pruning
+var ErrInsufficientSpace = errors.New("insufficient disk space")
+
+// PruneFunc frees disk space, returning the number of bytes reclaimed
+type PruneFunc func(context.Context) (uint64, error)
+
+// NotifyFunc delivers a warning about disk space
+type NotifyFunc func(msg string, opts notify.Options) error
+
+// Options configures a Watcher
+type Options struct {
+	// Paths are locations on each filesystem to watch
+	Paths []string
+
+	// Prune are run in order when space is low, until enough space is free,
+	// so they should be ordered from least to most disruptive
+	Prune []PruneFunc
+
+	// Notify is used to warn about low disk space
+	Notify NotifyFunc
+
+	// Out is where disk space activity is logged
+	Out io.Writer
+}
+
+// Watcher monitors free space on a set of filesystems
+type Watcher struct {
+	paths  []string
+	prune  []PruneFunc
+	notify NotifyFunc
+	out    io.Writer
+	usage  func(path string) (host.Disk, error)
+
+	// mux guards configuration
+	mux      sync.RWMutex
+	conf     api.DiskConfiguration
+	minimum  threshold
+	schedule context.CancelFunc
+
+	// check serializes checks, and guards the state below
+	check sync.Mutex
+	// warned tracks paths that low disk space warnings have been sent for
+	warned map[string]bool
+	// unavailable tracks paths that could not be checked
+	unavailable map[string]bool
+}
+
+// NewWatcher creates a watcher that uses the default threshold. Checks only
+// happen on demand until Start is called.
+func NewWatcher(opts Options) *Watcher {
+	if opts.Out == nil {
+		opts.Out = ioutil.Discard
+	}
+	minimum, _ := parseThreshold(DefaultMinimumFree)
+	return &Watcher{
+		paths:  opts.Paths,
+		prune:  opts.Prune,
+		notify: opts.Notify,
+		out:    opts.Out,
+		usage:  host.GetDisk,
+
+		conf:    api.DiskConfiguration{MinimumFree: DefaultMinimumFree},
+		minimum: minimum,
+
+		warned:      make(map[string]bool),
+		unavailable: make(map[string]bool),
+	}
+}
+
+// Configure validates and applies the given configuration
+func (w *Watcher) Configure(conf api.DiskConfiguration) error {
+	if conf.MinimumFree == "" {
+		conf.MinimumFree = DefaultMinimumFree
+	}
+	minimum, err := parseThreshold(conf.MinimumFree)
+	if err != nil {
+		return err
+	}
+
+	w.mux.Lock()
+	w.conf = conf
+	w.minimum = minimum
+	w.mux.Unlock()
+	return nil
+}
+
+// GetConfiguration returns the current configuration
+func (w *Watcher) GetConfiguration() api.DiskConfiguration {
+	w.mux.RLock()
+	defer w.mux.RUnlock()
+	return w.conf
+}
+
+// Start checks disk space at the given interval until the watcher is closed
+func (w *Watcher) Start(interval time.Duration) {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	if w.schedule != nil {
+		w.schedule()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	w.schedule = cancel
+	go func() {
+		var ticker = time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			w.Check(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Close stops scheduled checks
+func (w *Watcher) Close() {
+	if w == nil {
+		return
+	}
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	if w.schedule != nil {
+		w.schedule()
+		w.schedule = nil
+	}
+}
+
+// Check prunes Docker assets if any watched filesystem is low on space, and
+// sends warnings when a filesystem becomes low on space or recovers. It returns
+// an error wrapping ErrInsufficientSpace if space is still low after pruning.
+func (w *Watcher) Check(ctx context.Context) error {
+	if w == nil {
+		return nil
+	}
+	w.mux.RLock()
+	var disabled, minimum = w.conf.Disabled, w.minimum
+	w.mux.RUnlock()
+	if disabled {
+		return nil
+	}
+
+	w.check.Lock()
+	defer w.check.Unlock()
+
+	var low = w.lowDisks(minimum)
+	if len(low) == 0 {
+		w.update(nil, minimum)
+		return nil
+	}
+
+	var reclaimed uint64
+	for _, prune := range w.prune {
+		if ctx.Err() != nil {
+			break
+		}
+		r, err := prune(ctx)
+		if err != nil {
+			fmt.Fprintf(w.out, "[disk] failed to prune Docker assets: %s\n", err.Error())
+			continue
+		}
+		reclaimed += r
+		if low = w.lowDisks(minimum); len(low) == 0 {
+			break
+		}
+	}
+	fmt.Fprintf(w.out, "[disk] pruned %s of unused Docker assets\n", formatSize(reclaimed))
+	if len(low) == 0 {
+		w.warn(fmt.Sprintf("Disk space was low - pruned %s of unused Docker assets",
+			formatSize(reclaimed)), notify.Yellow)
+	}
+
+	w.update(low, minimum)
+	if len(low) > 0 {
+		return fmt.Errorf("%w: %s", ErrInsufficientSpace, describe(low, minimum))
+	}
+	return nil
+}
+
+// lowDisks returns the watched filesystems that are below the given threshold
+func (w *Watcher) lowDisks(minimum threshold) []host.Disk {
+	var low []host.Disk
+	for _, p := range w.paths {
+		d, err := w.usage(p)
+		if err != nil {
+			if !w.unavailable[p] {
+				fmt.Fprintf(w.out, "[disk] unable to check free space on %s: %s\n", p, err.Error())
+				w.unavailable[p] = true
+			}
+			continue
+		}
+		delete(w.unavailable, p)
+		if minimum.below(d) {
+			low = append(low, d)
+		}
+	}
+	return low
+}
+
+// update sends warnings for filesystems that have just become low on space,
+// and notices for those that have recovered
+func (w *Watcher) update(low []host.Disk, minimum threshold) {
+	var (
+		isLow    = make(map[string]bool, len(low))
+		newlyLow []host.Disk
+	)
+	for _, d := range low {
+		isLow[d.Path] = true
+		if !w.warned[d.Path] {
+			newlyLow = append(newlyLow, d)
+			w.warned[d.Path] = true
+		}
+	}
+	if len(newlyLow) > 0 {
+		w.warn(fmt.Sprintf("Low disk space: %s - builds will be refused until space is freed",
+			describe(newlyLow, minimum)), notify.Red)
+	}
+
+	var recovered []string
+	for p := range w.warned {
+		if !isLow[p] {
+			recovered = append(recovered, p)
+			delete(w.warned, p)
+		}
+	}
+	if len(recovered) > 0 {
+		sort.Strings(recovered)
+		w.warn(fmt.Sprintf("Disk space recovered on %s", strings.Join(recovered, ", ")),
+			notify.Green)
+	}
+}
+
+// warn logs the given message and delivers it to notifiers
+func (w *Watcher) warn(msg string, color notify.Color) {
+	fmt.Fprintln(w.out, "[disk] "+msg)
+	if w.notify == nil {
+		return
+	}
+	if err := w.notify(msg, notify.Options{Color: color}); err != nil {
+		fmt.Fprintf(w.out, "[disk] failed to send notification: %s\n", err.Error())
+	}
+}
+
+// describe summarizes free space on the given filesystems
+func describe(disks []host.Disk, minimum threshold) string {
+	var parts = make([]string, len(disks))
+	for i, d := range disks {
+		parts[i] = fmt.Sprintf("%s free on %s", formatSize(d.Free), d.Path)
+	}
+	return fmt.Sprintf("%s (minimum %s)", strings.Join(parts, ", "), minimum)
+}
@@ -0,0 +1,148 @@
+package disk
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/host"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/notify"
+)
+
+type notification struct {
+	msg   string
+	color notify.Color
+}
+
+// newTestWatcher creates a watcher over fake filesystems, where each pruning
+// stage frees the given number of bytes on every filesystem
+func newTestWatcher(free map[string]uint64, stages ...uint64) (*Watcher, *[]notification, *int) {
+	var (
+		sent   []notification
+		pruned int
+		prune  []PruneFunc
+	)
+	for _, freed := range stages {
+		var freed = freed
+		prune = append(prune, func(context.Context) (uint64, error) {
+			pruned++
+			for p := range free {
+				free[p] += freed
+			}
+			return freed, nil
+		})
+	}
+	var paths []string
+	for p := range free {
+		paths = append(paths, p)
+	}
+	var w = NewWatcher(Options{
+		Paths: paths,
+		Prune: prune,
+		Notify: func(msg string, opts notify.Options) error {
+			sent = append(sent, notification{msg, opts.Color})
+			return nil
+		},
+		Out: &bytes.Buffer{},
+	})
+	w.usage = func(p string) (host.Disk, error) {
+		f, ok := free[p]
+		if !ok {
+			return host.Disk{}, errors.New("no such filesystem")
+		}
+		return host.Disk{Path: p, Total: 100 << 30, Free: f}, nil
+	}
+	return w, &sent, &pruned
+}
+
+func TestWatcher_Check(t *testing.T) {
+	t.Run("enough space", func(t *testing.T) {
+		w, sent, pruned := newTestWatcher(map[string]uint64{"/app/host": 50 << 30}, 1<<30)
+		assert.NoError(t, w.Check(context.Background()))
+		assert.Zero(t, *pruned)
+		assert.Empty(t, *sent)
+	})
+
+	t.Run("pruning frees enough space", func(t *testing.T) {
+		w, sent, pruned := newTestWatcher(map[string]uint64{"/app/host": 5 << 30}, 2<<30, 10<<30, 10<<30)
+		assert.NoError(t, w.Check(context.Background()))
+		assert.Equal(t, 2, *pruned)
+		require.Len(t, *sent, 1)
+		assert.Equal(t, notify.Yellow, (*sent)[0].color)
+		assert.Contains(t, (*sent)[0].msg, "pruned 12.0GiB")
+	})
+
+	t.Run("still low after pruning", func(t *testing.T) {
+		var free = map[string]uint64{"/app/host": 1 << 30}
+		w, sent, pruned := newTestWatcher(free, 1<<30)
+
+		var err = w.Check(context.Background())
+		assert.True(t, errors.Is(err, ErrInsufficientSpace))
+		assert.Contains(t, err.Error(), "2.0GiB free on /app/host (minimum 10%)")
+		assert.Equal(t, 1, *pruned)
+		require.Len(t, *sent, 1)
+		assert.Equal(t, notify.Red, (*sent)[0].color)
+
+		// warnings should not be repeated
+		assert.Error(t, w.Check(context.Background()))
+		assert.Len(t, *sent, 1)
+
+		// recovery should be announced
+		free["/app/host"] = 50 << 30
+		assert.NoError(t, w.Check(context.Background()))
+		require.Len(t, *sent, 2)
+		assert.Equal(t, notify.Green, (*sent)[1].color)
+		assert.Equal(t, "Disk space recovered on /app/host", (*sent)[1].msg)
+	})
+
+	t.Run("unavailable paths are skipped", func(t *testing.T) {
+		w, sent, _ := newTestWatcher(map[string]uint64{"/app/host": 50 << 30})
+		w.paths = append(w.paths, "/app/docker")
+		assert.NoError(t, w.Check(context.Background()))
+		assert.Empty(t, *sent)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		w, sent, pruned := newTestWatcher(map[string]uint64{"/app/host": 0}, 1<<30)
+		require.NoError(t, w.Configure(api.DiskConfiguration{Disabled: true}))
+		assert.NoError(t, w.Check(context.Background()))
+		assert.Zero(t, *pruned)
+		assert.Empty(t, *sent)
+	})
+
+	t.Run("nil watcher", func(t *testing.T) {
+		var w *Watcher
+		assert.NoError(t, w.Check(context.Background()))
+		assert.NotPanics(t, w.Close)
+	})
+}
+
+func TestWatcher_Configure(t *testing.T) {
+	var w = NewWatcher(Options{})
+	assert.Equal(t, DefaultMinimumFree, w.GetConfiguration().MinimumFree)
+
+	assert.Error(t, w.Configure(api.DiskConfiguration{MinimumFree: "lots"}))
+	assert.Equal(t, DefaultMinimumFree, w.GetConfiguration().MinimumFree)
+
+	require.NoError(t, w.Configure(api.DiskConfiguration{MinimumFree: "5GiB"}))
+	assert.Equal(t, "5GiB", w.GetConfiguration().MinimumFree)
+	assert.Equal(t, threshold{bytes: 5 << 30}, w.minimum)
+
+	// an empty threshold resets to the default
+	require.NoError(t, w.Configure(api.DiskConfiguration{}))
+	assert.Equal(t, DefaultMinimumFree, w.GetConfiguration().MinimumFree)
+}
+
+func TestWatcher_Start(t *testing.T) {
+	w, _, _ := newTestWatcher(map[string]uint64{"/app/host": 50 << 30})
+	w.Start(time.Hour)
+	w.Start(time.Hour)
+	w.Close()
+	w.Close()
+}
@@ -9,7 +9,10 @@ import (
 	gogit "github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
 	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/storage/memory"
 )
 
 var (
@@ -123,3 +126,109 @@ func UpdateRepository(repo *gogit.Repository, opts RepoOptions, out io.Writer) e
 	})
 	return SimplifyGitErr(err)
 }
+
+// CheckoutCommit checks out the given commit, which must already have been
+// fetched into the repository
+func CheckoutCommit(repo *gogit.Repository, commit string, out io.Writer) error {
+	hash, err := repo.ResolveRevision(plumbing.Revision(commit))
+	if err != nil {
+		return fmt.Errorf("could not find commit '%s': %s", commit, err.Error())
+	}
+
+	tree, err := repo.Worktree()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Checking out commit '%s'...\n", hash.String())
+	return tree.Checkout(&gogit.CheckoutOptions{
+		Hash:  *hash,
+		Force: true,
+	})
+}
+
+// FetchBranch fetches the latest commit of the given branch from origin into a
+// remote-tracking reference, without checking it out or moving HEAD
+func FetchBranch(repo *gogit.Repository, opts RepoOptions, out io.Writer) (*object.Commit, error) {
+	var ref = plumbing.NewRemoteReferenceName("origin", opts.Branch)
+	fmt.Fprintf(out, "Fetching '%s'...\n", opts.Branch)
+	err := repo.Fetch(&gogit.FetchOptions{
+		RemoteName: "origin",
+		Auth:       opts.Auth,
+		RefSpecs: []config.RefSpec{
+			config.RefSpec(fmt.Sprintf("+refs/heads/%s:%s", opts.Branch, ref)),
+		},
+		Progress: out,
+		Force:    true,
+	})
+	if err = SimplifyGitErr(err); err != nil {
+		return nil, err
+	}
+
+	resolved, err := repo.Reference(ref, true)
+	if err != nil {
+		return nil, fmt.Errorf("could not find branch '%s': %s", opts.Branch, err.Error())
+	}
+	return repo.CommitObject(resolved.Hash())
+}
+
+// ResolveRemoteBranch looks up the latest commit of the given branch in the
+// repository at remoteURL, without cloning it
+func ResolveRemoteBranch(remoteURL string, opts RepoOptions) (plumbing.Hash, error) {
+	var remote = gogit.NewRemote(memory.NewStorage(), &config.RemoteConfig{
+		Name: "origin",
+		URLs: []string{remoteURL},
+	})
+	refs, err := remote.List(&gogit.ListOptions{Auth: opts.Auth})
+	if err = SimplifyGitErr(err); err != nil {
+		return plumbing.ZeroHash, err
+	}
+	var name = plumbing.NewBranchReferenceName(opts.Branch)
+	for _, ref := range refs {
+		if ref.Name() == name {
+			return ref.Hash(), nil
+		}
+	}
+	return plumbing.ZeroHash, fmt.Errorf("could not find branch '%s'", opts.Branch)
+}
+
+// CommitRange lists up to max commits that are reachable from to but not from
+// from, starting with to. truncated is set if there were more commits than max.
+// If from is not in the repository, the commits leading up to to are listed.
+func CommitRange(
+	repo *gogit.Repository,
+	from, to plumbing.Hash,
+	max int,
+) (commits []*object.Commit, truncated bool, err error) {
+	if from == to {
+		return nil, false, nil
+	}
+	target, err := repo.CommitObject(to)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// Stop at the commits shared with from, which may not be from itself if
+	// the branch was switched or history was rewritten
+	var shared []plumbing.Hash
+	if previous, err := repo.CommitObject(from); err == nil {
+		bases, err := previous.MergeBase(target)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, base := range bases {
+			shared = append(shared, base.Hash)
+		}
+	}
+
+	var iter = object.NewCommitPreorderIter(target, nil, shared)
+	defer iter.Close()
+	err = iter.ForEach(func(c *object.Commit) error {
+		if len(commits) == max {
+			truncated = true
+			return storer.ErrStop
+		}
+		commits = append(commits, c)
+		return nil
+	})
+	return commits, truncated, err
+}
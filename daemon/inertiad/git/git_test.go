@@ -1,10 +1,15 @@
 package git
 
 import (
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -48,3 +53,127 @@ func TestUpdateRepositoryIntegration(t *testing.T) {
 	err = UpdateRepository(repo, RepoOptions{Branch: "dev"}, os.Stdout)
 	assert.NoError(t, err)
 }
+
+func TestCheckoutCommit(t *testing.T) {
+	var dir = "./test_checkout/"
+	repo, err := git.PlainInit(dir, false)
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	tree, err := repo.Worktree()
+	assert.NoError(t, err)
+	var commit = func(contents string) plumbing.Hash {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "README"), []byte(contents), 0644))
+		_, err := tree.Add("README")
+		assert.NoError(t, err)
+		hash, err := tree.Commit(contents, &git.CommitOptions{
+			Author: &object.Signature{Name: "bob", Email: "bob@inertia.com", When: time.Now()},
+		})
+		assert.NoError(t, err)
+		return hash
+	}
+	var first = commit("first")
+	commit("second")
+
+	assert.NoError(t, CheckoutCommit(repo, first.String(), os.Stdout))
+	head, err := repo.Head()
+	assert.NoError(t, err)
+	assert.Equal(t, first, head.Hash())
+	contents, err := ioutil.ReadFile(filepath.Join(dir, "README"))
+	assert.NoError(t, err)
+	assert.Equal(t, "first", string(contents))
+
+	assert.Error(t, CheckoutCommit(repo, "0000000000000000000000000000000000000000", os.Stdout))
+}
+
+// newTestRepo creates a repository in dir, and returns a function that commits
+// the given contents to it
+func newTestRepo(t *testing.T, dir string) (*git.Repository, func(string) plumbing.Hash) {
+	repo, err := git.PlainInit(dir, false)
+	assert.NoError(t, err)
+	tree, err := repo.Worktree()
+	assert.NoError(t, err)
+	return repo, func(contents string) plumbing.Hash {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "README"), []byte(contents), 0644))
+		_, err := tree.Add("README")
+		assert.NoError(t, err)
+		hash, err := tree.Commit(contents, &git.CommitOptions{
+			Author: &object.Signature{Name: "bob", Email: "bob@inertia.com", When: time.Now()},
+		})
+		assert.NoError(t, err)
+		return hash
+	}
+}
+
+func TestFetchBranch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "inertia-git")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	var origin = filepath.Join(dir, "origin")
+	_, commit := newTestRepo(t, origin)
+	var first = commit("first")
+
+	// set up a deployed clone of origin
+	repo, err := git.PlainClone(filepath.Join(dir, "clone"), false, &git.CloneOptions{URL: origin})
+	assert.NoError(t, err)
+
+	var second = commit("second")
+	head, err := ResolveRemoteBranch(origin, RepoOptions{Branch: "master"})
+	assert.NoError(t, err)
+	assert.Equal(t, second, head)
+	_, err = ResolveRemoteBranch(origin, RepoOptions{Branch: "nope"})
+	assert.Error(t, err)
+
+	fetched, err := FetchBranch(repo, RepoOptions{Branch: "master"}, ioutil.Discard)
+	assert.NoError(t, err)
+	assert.Equal(t, second, fetched.Hash)
+
+	// the deployed commit should not have changed
+	ref, err := repo.Head()
+	assert.NoError(t, err)
+	assert.Equal(t, first, ref.Hash())
+
+	commits, truncated, err := CommitRange(repo, first, second, 10)
+	assert.NoError(t, err)
+	assert.False(t, truncated)
+	if assert.Len(t, commits, 1) {
+		assert.Equal(t, second, commits[0].Hash)
+	}
+}
+
+func TestCommitRange(t *testing.T) {
+	dir, err := ioutil.TempDir("", "inertia-git")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	repo, commit := newTestRepo(t, dir)
+	var (
+		first  = commit("first")
+		second = commit("second")
+		third  = commit("third")
+		fourth = commit("fourth")
+	)
+
+	commits, truncated, err := CommitRange(repo, first, fourth, 10)
+	assert.NoError(t, err)
+	assert.False(t, truncated)
+	var hashes []plumbing.Hash
+	for _, c := range commits {
+		hashes = append(hashes, c.Hash)
+	}
+	assert.Equal(t, []plumbing.Hash{fourth, third, second}, hashes)
+
+	commits, truncated, err = CommitRange(repo, first, fourth, 2)
+	assert.NoError(t, err)
+	assert.True(t, truncated)
+	assert.Len(t, commits, 2)
+
+	// nothing to list if the commits are the same
+	commits, _, err = CommitRange(repo, fourth, fourth, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, commits)
+
+	// unknown commits list everything leading up to the target
+	commits, _, err = CommitRange(repo, plumbing.ZeroHash, second, 10)
+	assert.NoError(t, err)
+	assert.Len(t, commits, 2)
+}
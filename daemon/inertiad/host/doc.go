@@ -0,0 +1,3 @@
+// Package host provides information about the resources of the machine the
+// daemon is running on
+package host
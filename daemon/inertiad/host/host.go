@@ -0,0 +1,106 @@
+package host
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// meminfo is where the kernel reports memory usage
+var meminfo = "/proc/meminfo"
+
+// Memory describes the host's memory usage, in bytes
+type Memory struct {
+	Total     uint64
+	Available uint64
+}
+
+// Used returns the amount of memory in use
+func (m Memory) Used() uint64 { return m.Total - m.Available }
+
+// GetMemory retrieves the host's memory usage
+func GetMemory() (Memory, error) {
+	f, err := os.Open(meminfo)
+	if err != nil {
+		return Memory{}, err
+	}
+	defer f.Close()
+	return parseMeminfo(f)
+}
+
+// parseMeminfo reads lines such as 'MemTotal:  16318872 kB'
+func parseMeminfo(r io.Reader) (Memory, error) {
+	var (
+		mem     Memory
+		scanner = bufio.NewScanner(r)
+		found   int
+	)
+	for scanner.Scan() {
+		var fields = strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		var dst *uint64
+		switch fields[0] {
+		case "MemTotal:":
+			dst = &mem.Total
+		case "MemAvailable:":
+			dst = &mem.Available
+		default:
+			continue
+		}
+		v, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return Memory{}, fmt.Errorf("invalid value for %s: %w", fields[0], err)
+		}
+		if len(fields) > 2 && fields[2] == "kB" {
+			v *= 1024
+		}
+		*dst = v
+		found++
+	}
+	if err := scanner.Err(); err != nil {
+		return Memory{}, err
+	}
+	if found < 2 {
+		return Memory{}, fmt.Errorf("could not find memory usage in %s", meminfo)
+	}
+	return mem, nil
+}
+
+// Disk describes the usage of a filesystem, in bytes
+type Disk struct {
+	Path string
+	// Total is the size of the filesystem
+	Total uint64
+	// Free is the space available to unprivileged users
+	Free uint64
+}
+
+// Used returns the amount of space in use
+func (d Disk) Used() uint64 { return d.Total - d.Free }
+
+// UsedPercent returns the percentage of space in use
+func (d Disk) UsedPercent() float64 {
+	if d.Total == 0 {
+		return 0
+	}
+	return float64(d.Used()) / float64(d.Total) * 100
+}
+
+// GetDisk retrieves the usage of the filesystem containing the given path
+func GetDisk(path string) (Disk, error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return Disk{}, err
+	}
+	return Disk{
+		Path:  path,
+		Total: st.Blocks * uint64(st.Bsize),
+		Free:  st.Bavail * uint64(st.Bsize),
+	}, nil
+}
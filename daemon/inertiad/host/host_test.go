@@ -0,0 +1,39 @@
+package host
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseMeminfo(t *testing.T) {
+	mem, err := parseMeminfo(strings.NewReader(`MemTotal:        2048 kB
+MemFree:          512 kB
+MemAvailable:    1024 kB
+Buffers:           64 kB
+`))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2048*1024), mem.Total)
+	assert.Equal(t, uint64(1024*1024), mem.Available)
+	assert.Equal(t, uint64(1024*1024), mem.Used())
+
+	_, err = parseMeminfo(strings.NewReader("MemTotal: 2048 kB\n"))
+	assert.Error(t, err)
+	_, err = parseMeminfo(strings.NewReader("MemTotal: lots kB\nMemAvailable: 1 kB\n"))
+	assert.Error(t, err)
+}
+
+func TestGetDisk(t *testing.T) {
+	disk, err := GetDisk(os.TempDir())
+	require.NoError(t, err)
+	assert.Equal(t, os.TempDir(), disk.Path)
+	assert.NotZero(t, disk.Total)
+	assert.True(t, disk.Free <= disk.Total)
+	assert.True(t, disk.UsedPercent() >= 0 && disk.UsedPercent() <= 100)
+
+	_, err = GetDisk("/definitely/not/a/path")
+	assert.Error(t, err)
+}
@@ -0,0 +1,62 @@
+package log
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	// levelField matches level fields in structured logs, such as level=warn
+	// or "level":"warn"
+	levelField = regexp.MustCompile(
+		`(?i)\b(?:level|lvl|severity)"?\s*[=:]\s*"?([a-z]+)`)
+	// levelWord matches level names written in capitals, such as "[WARN]" or
+	// "ERROR:", near the start of a line
+	levelWord = regexp.MustCompile(
+		`\b(TRACE|DEBUG|INFO|WARN|WARNING|ERROR|ERR|FATAL|PANIC|CRITICAL|CRIT)\b`)
+)
+
+// levelWordSearchLength is how far into a line levelWord is searched for
+const levelWordSearchLength = 64
+
+// DetectLevel guesses the level of a line of output from an application, based
+// on common logging formats, and returns false if no level could be found
+func DetectLevel(line string) (logrus.Level, bool) {
+	if m := levelField.FindStringSubmatch(line); m != nil {
+		if l, ok := levelFromName(m[1]); ok {
+			return l, true
+		}
+	}
+	var prefix = line
+	if len(prefix) > levelWordSearchLength {
+		prefix = prefix[:levelWordSearchLength]
+	}
+	if m := levelWord.FindStringSubmatch(prefix); m != nil {
+		return levelFromName(m[1])
+	}
+	return logrus.InfoLevel, false
+}
+
+// levelFromName maps the level names used by common logging libraries to
+// logrus levels
+func levelFromName(name string) (logrus.Level, bool) {
+	switch strings.ToLower(name) {
+	case "trace":
+		return logrus.TraceLevel, true
+	case "debug", "dbg":
+		return logrus.DebugLevel, true
+	case "info", "information", "notice":
+		return logrus.InfoLevel, true
+	case "warn", "warning":
+		return logrus.WarnLevel, true
+	case "error", "err":
+		return logrus.ErrorLevel, true
+	case "fatal", "critical", "crit", "alert", "emergency":
+		return logrus.FatalLevel, true
+	case "panic":
+		return logrus.PanicLevel, true
+	}
+	return logrus.InfoLevel, false
+}
@@ -0,0 +1,31 @@
+package log
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectLevel(t *testing.T) {
+	for _, tt := range []struct {
+		line      string
+		wantLevel logrus.Level
+		wantFound bool
+	}{
+		{`time=2020-01-02T03:04:05Z level=warn msg="disk is slow"`, logrus.WarnLevel, true},
+		{`{"time":"2020-01-02T03:04:05Z","level":"error","msg":"oh no"}`, logrus.ErrorLevel, true},
+		{`{"severity": "DEBUG", "message": "hi"}`, logrus.DebugLevel, true},
+		{`2020/01/02 03:04:05 [WARN] retrying`, logrus.WarnLevel, true},
+		{`ERROR:root:something broke`, logrus.ErrorLevel, true},
+		{`INFO  [main] server started`, logrus.InfoLevel, true},
+		{`panic: runtime error`, logrus.InfoLevel, false},
+		{`level=fatal msg="out of memory"`, logrus.FatalLevel, true},
+		{`listening on port 8080`, logrus.InfoLevel, false},
+		{`level=verbose msg=hi`, logrus.InfoLevel, false},
+	} {
+		level, found := DetectLevel(tt.line)
+		assert.Equal(t, tt.wantFound, found, tt.line)
+		assert.Equal(t, tt.wantLevel, level, tt.line)
+	}
+}
@@ -0,0 +1,88 @@
+package log
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// timestampFormat records entry times to the millisecond
+const timestampFormat = "2006-01-02T15:04:05.000Z07:00"
+
+// NewLogger creates a logger that writes entries at or above the given level
+// to w, using the given formatter
+func NewLogger(w io.Writer, level logrus.Level, formatter logrus.Formatter) *logrus.Entry {
+	var logger = logrus.New()
+	logger.SetOutput(w)
+	logger.SetLevel(level)
+	logger.SetFormatter(formatter)
+	return logrus.NewEntry(logger)
+}
+
+// ParseFormat returns the formatter for the name of a log format - "text" for
+// key=value pairs, or "json" for one JSON object per line
+func ParseFormat(name string) (logrus.Formatter, error) {
+	switch strings.ToLower(name) {
+	case "text":
+		return &logrus.TextFormatter{
+			DisableColors:   true,
+			FullTimestamp:   true,
+			TimestampFormat: timestampFormat,
+		}, nil
+	case "json":
+		return &logrus.JSONFormatter{TimestampFormat: timestampFormat}, nil
+	}
+	return nil, fmt.Errorf("invalid log format '%s' - must be one of text, json", name)
+}
+
+// Writer returns a writer that logs each line written to it as the message of
+// an entry at the given level. Unlike logrus' WriterLevel, lines of any length
+// are logged and blank lines are skipped. Close must be called to log any final
+// line that does not end in a newline.
+func Writer(logger *logrus.Entry, level logrus.Level) io.WriteCloser {
+	return &lineWriter{logger: logger, level: level}
+}
+
+// lineWriter logs lines written to it
+type lineWriter struct {
+	logger *logrus.Entry
+	level  logrus.Level
+
+	mux sync.Mutex
+	buf []byte
+}
+
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	w.buf = append(w.buf, p...)
+	for {
+		var i = bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+		w.log(w.buf[:i])
+		w.buf = w.buf[i+1:]
+	}
+	return len(p), nil
+}
+
+func (w *lineWriter) Close() error {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	w.log(w.buf)
+	w.buf = nil
+	return nil
+}
+
+func (w *lineWriter) log(line []byte) {
+	var msg = strings.TrimRight(string(line), "\r\n")
+	if strings.TrimSpace(msg) == "" {
+		return
+	}
+	w.logger.Log(w.level, msg)
+}
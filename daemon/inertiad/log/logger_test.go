@@ -0,0 +1,65 @@
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLogger(t *testing.T, level logrus.Level, format string) (*logrus.Entry, *bytes.Buffer) {
+	formatter, err := ParseFormat(format)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	return NewLogger(&buf, level, formatter), &buf
+}
+
+func TestParseFormat(t *testing.T) {
+	f, err := ParseFormat("JSON")
+	assert.NoError(t, err)
+	assert.IsType(t, &logrus.JSONFormatter{}, f)
+	f, err = ParseFormat("text")
+	assert.NoError(t, err)
+	assert.IsType(t, &logrus.TextFormatter{}, f)
+	_, err = ParseFormat("xml")
+	assert.Error(t, err)
+}
+
+func TestNewLogger(t *testing.T) {
+	l, buf := newTestLogger(t, logrus.WarnLevel, "json")
+	l.Info("info")
+	assert.Empty(t, buf.String())
+	l.WithField("project", "myproject").Warn("slow")
+
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, "warning", entry["level"])
+	assert.Equal(t, "slow", entry["msg"])
+	assert.Equal(t, "myproject", entry["project"])
+	assert.NotEmpty(t, entry["time"])
+}
+
+func TestWriter(t *testing.T) {
+	l, buf := newTestLogger(t, logrus.InfoLevel, "text")
+	var w = Writer(l.WithField("deploy", "abcd"), logrus.InfoLevel)
+	fmt.Fprint(w, "Building ")
+	fmt.Fprint(w, "project...\r\n\nStep 1/2")
+	assert.Equal(t, 1, strings.Count(buf.String(), "\n"))
+	assert.Contains(t, buf.String(), `level=info msg="Building project..." deploy=abcd`)
+	buf.Reset()
+
+	// partial lines are logged when the writer is closed
+	assert.NoError(t, w.Close())
+	assert.Contains(t, buf.String(), `msg="Step 1/2" deploy=abcd`)
+
+	// long lines are logged whole
+	buf.Reset()
+	w = Writer(l, logrus.InfoLevel)
+	fmt.Fprintln(w, strings.Repeat("a", 128*1024))
+	assert.Contains(t, buf.String(), strings.Repeat("a", 128*1024))
+}
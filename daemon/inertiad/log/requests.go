@@ -0,0 +1,86 @@
+package log
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/middleware"
+	"github.com/sirupsen/logrus"
+)
+
+// NewRequestFormatter returns a chi middleware.LogFormatter that logs requests
+// to the given logger, for use with middleware.RequestLogger. Entries include
+// the request ID set by middleware.RequestID, and the user set using
+// SetRequestUser.
+func NewRequestFormatter(logger *logrus.Entry) middleware.LogFormatter {
+	return &requestFormatter{logger: logger}
+}
+
+type requestFormatter struct{ logger *logrus.Entry }
+
+func (f *requestFormatter) NewLogEntry(r *http.Request) middleware.LogEntry {
+	return &requestEntry{logger: f.logger, req: r}
+}
+
+// SetRequestUser records the user who made the given request in its log entry
+func SetRequestUser(r *http.Request, user string) {
+	if e, ok := middleware.GetLogEntry(r).(*requestEntry); ok {
+		e.mux.Lock()
+		e.user = user
+		e.mux.Unlock()
+	}
+}
+
+// requestEntry logs a request once it has been served
+type requestEntry struct {
+	logger *logrus.Entry
+	req    *http.Request
+
+	mux  sync.Mutex
+	user string
+}
+
+func (e *requestEntry) Write(status, bytes int, header http.Header, elapsed time.Duration, extra interface{}) {
+	if status == 0 {
+		// nothing was written, or the connection was hijacked
+		if strings.EqualFold(e.req.Header.Get("Upgrade"), "websocket") {
+			status = http.StatusSwitchingProtocols
+		} else {
+			status = http.StatusOK
+		}
+	}
+	var level = logrus.InfoLevel
+	switch {
+	case status >= 500:
+		level = logrus.ErrorLevel
+	case status >= 400:
+		level = logrus.WarnLevel
+	}
+	e.entry().WithFields(logrus.Fields{
+		"status":  status,
+		"bytes":   bytes,
+		"latency": elapsed.String(),
+	}).Log(level, "request served")
+}
+
+func (e *requestEntry) Panic(v interface{}, stack []byte) {
+	e.entry().WithFields(logrus.Fields{
+		"panic": v,
+		"stack": string(stack),
+	}).Error("panic while serving request")
+}
+
+func (e *requestEntry) entry() *logrus.Entry {
+	e.mux.Lock()
+	var user = e.user
+	e.mux.Unlock()
+	return e.logger.WithFields(logrus.Fields{
+		"request": middleware.GetReqID(e.req.Context()),
+		"user":    user,
+		"method":  e.req.Method,
+		"path":    e.req.URL.Path,
+		"remote":  e.req.RemoteAddr,
+	})
+}
@@ -0,0 +1,88 @@
+package log
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/chi/middleware"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestLogger(t *testing.T) {
+	l, buf := newTestLogger(t, logrus.InfoLevel, "json")
+	var handler = chi.Chain(
+		middleware.RequestID,
+		middleware.RequestLogger(NewRequestFormatter(l)),
+		middleware.Recoverer,
+	).HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		SetRequestUser(r, "bobheadxi")
+		switch r.URL.Path {
+		case "/panic":
+			panic("oh no")
+		case "/missing":
+			w.WriteHeader(http.StatusNotFound)
+		}
+		w.Write([]byte("hello"))
+	})
+
+	type entry struct {
+		Level   string `json:"level"`
+		Msg     string `json:"msg"`
+		Request string `json:"request"`
+		User    string `json:"user"`
+		Method  string `json:"method"`
+		Path    string `json:"path"`
+		Status  int    `json:"status"`
+		Bytes   int    `json:"bytes"`
+		Latency string `json:"latency"`
+		Panic   string `json:"panic"`
+	}
+	var serve = func(method, path string) []entry {
+		buf.Reset()
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(method, path, nil))
+		var entries []entry
+		for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+			var e entry
+			require.NoError(t, json.Unmarshal([]byte(line), &e))
+			entries = append(entries, e)
+		}
+		return entries
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		var entries = serve("GET", "/status")
+		require.Len(t, entries, 1)
+		var e = entries[0]
+		assert.Equal(t, "info", e.Level)
+		assert.NotEmpty(t, e.Request)
+		assert.Equal(t, "bobheadxi", e.User)
+		assert.Equal(t, "GET", e.Method)
+		assert.Equal(t, "/status", e.Path)
+		assert.Equal(t, http.StatusOK, e.Status)
+		assert.Equal(t, 5, e.Bytes)
+		assert.NotEmpty(t, e.Latency)
+	})
+
+	t.Run("client error", func(t *testing.T) {
+		var entries = serve("POST", "/missing")
+		require.Len(t, entries, 1)
+		assert.Equal(t, "warning", entries[0].Level)
+		assert.Equal(t, http.StatusNotFound, entries[0].Status)
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		var entries = serve("GET", "/panic")
+		require.Len(t, entries, 2)
+		assert.Equal(t, "error", entries[0].Level)
+		assert.Equal(t, "oh no", entries[0].Panic)
+		assert.Equal(t, "error", entries[1].Level)
+		assert.Equal(t, http.StatusInternalServerError, entries[1].Status)
+		assert.Equal(t, entries[0].Request, entries[1].Request)
+	})
+}
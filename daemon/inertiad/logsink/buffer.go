@@ -0,0 +1,157 @@
+package logsink
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// sender delivers batches of entries to an external service
+type sender interface {
+	send(ctx context.Context, entries []Entry) error
+	close() error
+}
+
+// bufferedSink queues entries and delivers them to a sender in batches from a
+// background goroutine, retrying failed deliveries. If the queue fills up, the
+// oldest entries are dropped.
+type bufferedSink struct {
+	name   string
+	sender sender
+	opts   Options
+
+	mux     sync.Mutex
+	queue   []Entry
+	dropped int
+
+	// failing is only used by the delivery goroutine
+	failing bool
+
+	wake chan struct{}
+	stop chan struct{}
+	done chan struct{}
+}
+
+func newBufferedSink(name string, s sender, opts Options) *bufferedSink {
+	var b = &bufferedSink{
+		name:   name,
+		sender: s,
+		opts:   opts,
+		wake:   make(chan struct{}, 1),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	go b.run()
+	return b
+}
+
+// add queues an entry without blocking
+func (b *bufferedSink) add(e Entry) {
+	b.mux.Lock()
+	if len(b.queue) >= b.opts.BufferSize {
+		b.queue = b.queue[1:]
+		b.dropped++
+	}
+	b.queue = append(b.queue, e)
+	var full = len(b.queue) >= b.opts.BatchSize
+	b.mux.Unlock()
+
+	if full {
+		select {
+		case b.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// close delivers remaining entries, then stops the sink
+func (b *bufferedSink) close() {
+	close(b.stop)
+	<-b.done
+}
+
+func (b *bufferedSink) run() {
+	defer close(b.done)
+	defer b.sender.close()
+
+	var ticker = time.NewTicker(b.opts.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-b.stop:
+			// make a final attempt at delivering what is left
+			ctx, cancel := context.WithTimeout(context.Background(), b.opts.FlushInterval+b.opts.Timeout)
+			for b.flush(ctx, false) > 0 && ctx.Err() == nil {
+			}
+			cancel()
+			return
+		case <-ticker.C:
+		case <-b.wake:
+		}
+		for b.flush(context.Background(), true) >= b.opts.BatchSize {
+		}
+	}
+}
+
+// flush delivers a batch of queued entries, and returns the number of entries
+// left in the queue
+func (b *bufferedSink) flush(ctx context.Context, retry bool) int {
+	b.mux.Lock()
+	var n = len(b.queue)
+	if n > b.opts.BatchSize {
+		n = b.opts.BatchSize
+	}
+	var batch = b.queue[:n:n]
+	b.queue = b.queue[n:]
+	var dropped = b.dropped
+	b.dropped = 0
+	var remaining = len(b.queue)
+	b.mux.Unlock()
+	if n == 0 {
+		return 0
+	}
+
+	var err = b.deliver(ctx, batch, retry)
+	if err != nil {
+		err = fmt.Errorf("failed to deliver %d entries: %w", n, err)
+	} else if dropped > 0 {
+		err = fmt.Errorf("buffer full - dropped %d entries", dropped)
+	}
+	b.report(err)
+	return remaining
+}
+
+// deliver sends the batch, retrying with exponential backoff if allowed
+func (b *bufferedSink) deliver(ctx context.Context, batch []Entry, retry bool) error {
+	var (
+		backoff = b.opts.RetryBackoff
+		err     error
+	)
+	for attempt := 0; ; attempt++ {
+		sendCtx, cancel := context.WithTimeout(ctx, b.opts.Timeout)
+		err = b.sender.send(sendCtx, batch)
+		cancel()
+		if err == nil || !retry || attempt >= b.opts.Retries {
+			return err
+		}
+		select {
+		case <-b.stop:
+			return err
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// report passes errors to OnError when a sink starts failing, rather than on
+// every failure, to avoid flooding logs
+func (b *bufferedSink) report(err error) {
+	var wasFailing = b.failing
+	b.failing = err != nil
+	if err != nil && !wasFailing && b.opts.OnError != nil {
+		b.opts.OnError(b.name, err)
+	}
+}
@@ -0,0 +1,3 @@
+// Package logsink implements forwarding of container and daemon logs to
+// external services, such as syslog servers and Loki
+package logsink
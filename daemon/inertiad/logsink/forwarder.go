@@ -0,0 +1,233 @@
+package logsink
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ubclaunchpad/inertia/api"
+)
+
+// SourceDaemon is the source of entries logged by the daemon itself
+const SourceDaemon = "inertiad"
+
+// Entry is a line of output to forward
+type Entry struct {
+	Time time.Time
+	// Source is the name of the container that logged the line, or SourceDaemon
+	Source  string
+	Stderr  bool
+	Message string
+}
+
+// Options configures how entries are delivered to sinks
+type Options struct {
+	// BufferSize is the number of entries held for each sink while waiting to
+	// be delivered - when it is exceeded, the oldest entries are dropped
+	BufferSize int
+	// BatchSize is the maximum number of entries delivered at once
+	BatchSize int
+	// FlushInterval is how often entries are delivered if a batch has not
+	// filled up
+	FlushInterval time.Duration
+	// Timeout is how long each delivery attempt may take
+	Timeout time.Duration
+	// Retries is the number of times a failed delivery is retried before its
+	// entries are dropped, waiting RetryBackoff before the first retry and
+	// twice as long before each one after
+	Retries      int
+	RetryBackoff time.Duration
+
+	// OnError is called when a sink starts failing to deliver entries
+	OnError func(sink string, err error)
+}
+
+func (o *Options) setDefaults() {
+	if o.BufferSize <= 0 {
+		o.BufferSize = 10000
+	}
+	if o.BatchSize <= 0 {
+		o.BatchSize = 500
+	}
+	if o.FlushInterval <= 0 {
+		o.FlushInterval = time.Second
+	}
+	if o.Timeout <= 0 {
+		o.Timeout = 10 * time.Second
+	}
+	if o.Retries <= 0 {
+		o.Retries = 5
+	}
+	if o.RetryBackoff <= 0 {
+		o.RetryBackoff = 500 * time.Millisecond
+	}
+}
+
+// Forwarder delivers entries to the configured sinks. Entries written while no
+// sinks are configured are discarded.
+type Forwarder struct {
+	opts Options
+
+	mux   sync.RWMutex
+	conf  api.LogSinkConfiguration
+	sinks []*bufferedSink
+}
+
+// NewForwarder creates a forwarder with no sinks configured
+func NewForwarder(opts Options) *Forwarder {
+	opts.setDefaults()
+	return &Forwarder{opts: opts}
+}
+
+// Configure validates the given configuration, and replaces existing sinks
+// with the configured ones. Entries buffered for replaced sinks are delivered
+// in the background.
+func (f *Forwarder) Configure(conf api.LogSinkConfiguration) error {
+	var sinks = make([]*bufferedSink, 0, len(conf.Sinks))
+	for i, sc := range conf.Sinks {
+		s, err := newSender(sc, conf.Labels)
+		if err != nil {
+			for _, created := range sinks {
+				created.close()
+			}
+			return fmt.Errorf("invalid log sink %d: %w", i+1, err)
+		}
+		sinks = append(sinks, newBufferedSink(describe(sc), s, f.opts))
+	}
+
+	f.mux.Lock()
+	var old = f.sinks
+	f.conf = conf
+	f.sinks = sinks
+	f.mux.Unlock()
+
+	go func() {
+		for _, s := range old {
+			s.close()
+		}
+	}()
+	return nil
+}
+
+// Validate returns the error Configure would return for the given
+// configuration, without changing any sinks
+func Validate(conf api.LogSinkConfiguration) error {
+	for i, sc := range conf.Sinks {
+		if _, err := newSender(sc, conf.Labels); err != nil {
+			return fmt.Errorf("invalid log sink %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+// GetConfiguration returns the current configuration
+func (f *Forwarder) GetConfiguration() api.LogSinkConfiguration {
+	f.mux.RLock()
+	defer f.mux.RUnlock()
+	return f.conf
+}
+
+// Enabled returns true if any sinks are configured
+func (f *Forwarder) Enabled() bool {
+	if f == nil {
+		return false
+	}
+	f.mux.RLock()
+	defer f.mux.RUnlock()
+	return len(f.sinks) > 0
+}
+
+// Write queues the given entry for delivery to every sink
+func (f *Forwarder) Write(e Entry) {
+	if f == nil {
+		return
+	}
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	f.mux.RLock()
+	for _, s := range f.sinks {
+		s.add(e)
+	}
+	f.mux.RUnlock()
+}
+
+// Writer returns a writer that forwards each line written to it as an entry
+// from the given source
+func (f *Forwarder) Writer(source string) io.Writer {
+	return writerFunc(func(p []byte) (int, error) {
+		if !f.Enabled() {
+			return len(p), nil
+		}
+		for _, line := range bytes.Split(bytes.TrimRight(p, "\r\n"), []byte{'\n'}) {
+			f.Write(Entry{Source: source, Message: strings.TrimRight(string(line), "\r")})
+		}
+		return len(p), nil
+	})
+}
+
+// Close delivers buffered entries and stops all sinks
+func (f *Forwarder) Close() {
+	if f == nil {
+		return
+	}
+	f.mux.Lock()
+	var sinks = f.sinks
+	f.sinks = nil
+	f.mux.Unlock()
+	for _, s := range sinks {
+		s.close()
+	}
+}
+
+type writerFunc func(p []byte) (int, error)
+
+func (w writerFunc) Write(p []byte) (int, error) { return w(p) }
+
+// labelName is the format of label names accepted by Loki and Prometheus
+var labelName = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// newSender creates a sender for the given sink
+func newSender(conf api.LogSink, labels map[string]string) (sender, error) {
+	if conf.URL == "" {
+		return nil, fmt.Errorf("no URL provided for %s sink", conf.Type)
+	}
+	u, err := url.Parse(conf.URL)
+	if err != nil {
+		return nil, err
+	}
+	var merged = make(map[string]string, len(labels)+len(conf.Labels))
+	for _, l := range []map[string]string{labels, conf.Labels} {
+		for k, v := range l {
+			if !labelName.MatchString(k) {
+				return nil, fmt.Errorf("invalid label name '%s'", k)
+			}
+			merged[k] = v
+		}
+	}
+
+	switch conf.Type {
+	case api.LogSinkSyslog:
+		return newSyslogSender(u)
+	case api.LogSinkHTTP:
+		return newHTTPSender(u, conf.Headers, merged)
+	case api.LogSinkLoki:
+		return newLokiSender(u, conf.Headers, merged)
+	default:
+		return nil, fmt.Errorf("unknown sink type '%s' - must be one of %s, %s, %s",
+			conf.Type, api.LogSinkSyslog, api.LogSinkHTTP, api.LogSinkLoki)
+	}
+}
+
+// describe identifies a sink without revealing credentials in its URL
+func describe(conf api.LogSink) string {
+	if u, err := url.Parse(conf.URL); err == nil {
+		return fmt.Sprintf("%s (%s://%s%s)", conf.Type, u.Scheme, u.Host, u.Path)
+	}
+	return conf.Type
+}
@@ -0,0 +1,176 @@
+package logsink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// lokiPushPath is the Loki endpoint entries are pushed to, if a Loki sink is
+// configured with just a host
+const lokiPushPath = "/loki/api/v1/push"
+
+// httpEntry is the format of entries sent to HTTP sinks
+type httpEntry struct {
+	Time    time.Time         `json:"time"`
+	Source  string            `json:"source"`
+	Stream  string            `json:"stream"`
+	Message string            `json:"message"`
+	Labels  map[string]string `json:"labels,omitempty"`
+}
+
+// httpSender posts batches of entries to an endpoint as a JSON array
+type httpSender struct {
+	url     string
+	headers map[string]string
+	labels  map[string]string
+	client  *http.Client
+}
+
+func newHTTPSender(u *url.URL, headers, labels map[string]string) (*httpSender, error) {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("URL must use http:// or https://, got '%s'", u.Scheme)
+	}
+	return &httpSender{
+		url:     u.String(),
+		headers: headers,
+		labels:  labels,
+		client:  &http.Client{},
+	}, nil
+}
+
+func (s *httpSender) send(ctx context.Context, entries []Entry) error {
+	var batch = make([]httpEntry, len(entries))
+	for i, e := range entries {
+		batch[i] = httpEntry{
+			Time:    e.Time,
+			Source:  e.Source,
+			Stream:  stream(e),
+			Message: e.Message,
+			Labels:  s.labels,
+		}
+	}
+	body, err := json.Marshal(batch)
+	if err != nil {
+		return err
+	}
+	return post(ctx, s.client, s.url, s.headers, body)
+}
+
+func (s *httpSender) close() error { return nil }
+
+// lokiPush is the body of a request to Loki's push API
+type lokiPush struct {
+	Streams []lokiStream `json:"streams"`
+}
+
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+// lokiSender pushes entries to Loki, labelled with their source and stream
+type lokiSender struct {
+	url     string
+	headers map[string]string
+	labels  map[string]string
+	client  *http.Client
+}
+
+func newLokiSender(u *url.URL, headers, labels map[string]string) (*lokiSender, error) {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("URL must use http:// or https://, got '%s'", u.Scheme)
+	}
+	if u.Path == "" || u.Path == "/" {
+		u.Path = lokiPushPath
+	}
+	return &lokiSender{
+		url:     u.String(),
+		headers: headers,
+		labels:  labels,
+		client:  &http.Client{},
+	}, nil
+}
+
+func (s *lokiSender) send(ctx context.Context, entries []Entry) error {
+	// group entries into streams, each of which must be in chronological order
+	var sorted = make([]Entry, len(entries))
+	copy(sorted, entries)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Time.Before(sorted[j].Time) })
+	type key struct{ source, stream string }
+	var (
+		streams = make(map[key]*lokiStream)
+		keys    []key
+	)
+	for _, e := range sorted {
+		var k = key{e.Source, stream(e)}
+		st, ok := streams[k]
+		if !ok {
+			var labels = make(map[string]string, len(s.labels)+2)
+			for l, v := range s.labels {
+				labels[l] = v
+			}
+			labels["source"] = k.source
+			labels["stream"] = k.stream
+			st = &lokiStream{Stream: labels}
+			streams[k] = st
+			keys = append(keys, k)
+		}
+		st.Values = append(st.Values, [2]string{
+			strconv.FormatInt(e.Time.UnixNano(), 10),
+			e.Message,
+		})
+	}
+
+	var push = lokiPush{Streams: make([]lokiStream, 0, len(keys))}
+	for _, k := range keys {
+		push.Streams = append(push.Streams, *streams[k])
+	}
+	body, err := json.Marshal(push)
+	if err != nil {
+		return err
+	}
+	return post(ctx, s.client, s.url, s.headers, body)
+}
+
+func (s *lokiSender) close() error { return nil }
+
+// post sends a JSON body to the given URL, and errors on unsuccessful responses
+func post(ctx context.Context, client *http.Client, url string, headers map[string]string, body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("request failed with status %d: %s", resp.StatusCode, bytes.TrimSpace(msg))
+	}
+	io.Copy(ioutil.Discard, resp.Body)
+	return nil
+}
+
+// stream returns the name of the output stream an entry was logged to
+func stream(e Entry) string {
+	if e.Stderr {
+		return "stderr"
+	}
+	return "stdout"
+}
@@ -0,0 +1,228 @@
+package logsink
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ubclaunchpad/inertia/api"
+)
+
+func TestHTTPSink(t *testing.T) {
+	var received = make(chan []httpEntry, 10)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/logs", r.URL.Path)
+		assert.Equal(t, "Bearer abcde", r.Header.Get("Authorization"))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		var batch []httpEntry
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&batch))
+		received <- batch
+	}))
+	defer ts.Close()
+
+	var f = NewForwarder(Options{FlushInterval: 10 * time.Millisecond})
+	require.NoError(t, f.Configure(api.LogSinkConfiguration{
+		Labels: map[string]string{"project": "myproject"},
+		Sinks: []api.LogSink{{
+			Type:    api.LogSinkHTTP,
+			URL:     ts.URL + "/logs",
+			Headers: map[string]string{"Authorization": "Bearer abcde"},
+			Labels:  map[string]string{"env": "prod"},
+		}},
+	}))
+	f.Write(Entry{Time: testTime, Source: "web", Message: "hello"})
+	f.Write(Entry{Time: testTime, Source: "web", Stderr: true, Message: "oh no"})
+	f.Close()
+
+	var entries []httpEntry
+	for len(entries) < 2 {
+		select {
+		case batch := <-received:
+			entries = append(entries, batch...)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for entries")
+		}
+	}
+	var labels = map[string]string{"project": "myproject", "env": "prod"}
+	assert.Equal(t, []httpEntry{
+		{Time: testTime, Source: "web", Stream: "stdout", Message: "hello", Labels: labels},
+		{Time: testTime, Source: "web", Stream: "stderr", Message: "oh no", Labels: labels},
+	}, entries)
+}
+
+func TestLokiSink(t *testing.T) {
+	var received = make(chan lokiPush, 10)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, lokiPushPath, r.URL.Path)
+		assert.Equal(t, "tenant", r.Header.Get("X-Scope-OrgID"))
+		var push lokiPush
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&push))
+		received <- push
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	var f = NewForwarder(Options{BatchSize: 3, FlushInterval: time.Hour})
+	defer f.Close()
+	require.NoError(t, f.Configure(api.LogSinkConfiguration{
+		Labels: map[string]string{"project": "myproject"},
+		Sinks: []api.LogSink{{
+			Type:    api.LogSinkLoki,
+			URL:     ts.URL,
+			Headers: map[string]string{"X-Scope-OrgID": "tenant"},
+		}},
+	}))
+	// a full batch should be delivered right away
+	f.Write(Entry{Time: testTime.Add(time.Second), Source: "web", Message: "second"})
+	f.Write(Entry{Time: testTime, Source: "web", Message: "first"})
+	f.Write(Entry{Time: testTime, Source: "db", Stderr: true, Message: "oh no"})
+
+	select {
+	case push := <-received:
+		assert.Equal(t, []lokiStream{
+			{
+				Stream: map[string]string{"project": "myproject", "source": "web", "stream": "stdout"},
+				Values: [][2]string{
+					{"1577934245600000000", "first"},
+					{"1577934246600000000", "second"},
+				},
+			},
+			{
+				Stream: map[string]string{"project": "myproject", "source": "db", "stream": "stderr"},
+				Values: [][2]string{{"1577934245600000000", "oh no"}},
+			},
+		}, push.Streams)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for push")
+	}
+}
+
+func TestSinkRetries(t *testing.T) {
+	var (
+		attempts = make(chan int, 10)
+		count    int32
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var n = int(atomic.AddInt32(&count, 1))
+		attempts <- n
+		if n < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer ts.Close()
+
+	var errs = make(chan error, 10)
+	var f = NewForwarder(Options{
+		FlushInterval: 10 * time.Millisecond,
+		Retries:       3,
+		RetryBackoff:  time.Millisecond,
+		OnError:       func(sink string, err error) { errs <- err },
+	})
+	defer f.Close()
+	require.NoError(t, f.Configure(api.LogSinkConfiguration{
+		Sinks: []api.LogSink{{Type: api.LogSinkHTTP, URL: ts.URL}},
+	}))
+	f.Write(Entry{Message: "hello"})
+
+	for want := 1; want <= 3; want++ {
+		select {
+		case got := <-attempts:
+			assert.Equal(t, want, got)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for retry")
+		}
+	}
+	// delivery eventually succeeded, so no errors should be reported
+	assert.Len(t, errs, 0)
+}
+
+func TestSinkFailure(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("bad entries"))
+	}))
+	defer ts.Close()
+
+	var errs = make(chan error, 10)
+	var f = NewForwarder(Options{
+		FlushInterval: 10 * time.Millisecond,
+		Retries:       1,
+		RetryBackoff:  time.Millisecond,
+		OnError:       func(sink string, err error) { errs <- err },
+	})
+	defer f.Close()
+	require.NoError(t, f.Configure(api.LogSinkConfiguration{
+		Sinks: []api.LogSink{{Type: api.LogSinkHTTP, URL: ts.URL}},
+	}))
+	f.Write(Entry{Message: "hello"})
+
+	select {
+	case err := <-errs:
+		assert.Contains(t, err.Error(), "failed to deliver 1 entries")
+		assert.Contains(t, err.Error(), "bad entries")
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for error")
+	}
+
+	// further failures should not be reported while the sink is failing
+	f.Write(Entry{Message: "hello again"})
+	time.Sleep(100 * time.Millisecond)
+	assert.Len(t, errs, 0)
+}
+
+func TestForwarder_Configure(t *testing.T) {
+	var f = NewForwarder(Options{})
+	defer f.Close()
+	assert.False(t, f.Enabled())
+
+	for _, sink := range []api.LogSink{
+		{Type: "carrier-pigeon", URL: "http://127.0.0.1"},
+		{Type: api.LogSinkHTTP},
+		{Type: api.LogSinkHTTP, URL: "tcp://127.0.0.1:514"},
+		{Type: api.LogSinkLoki, URL: "http://127.0.0.1", Labels: map[string]string{"bad-label": "a"}},
+	} {
+		assert.Error(t, f.Configure(api.LogSinkConfiguration{Sinks: []api.LogSink{sink}}), sink.Type)
+	}
+	assert.False(t, f.Enabled())
+
+	var conf = api.LogSinkConfiguration{
+		Sinks: []api.LogSink{{Type: api.LogSinkLoki, URL: "http://127.0.0.1:3100"}},
+	}
+	assert.NoError(t, f.Configure(conf))
+	assert.True(t, f.Enabled())
+	assert.Equal(t, conf, f.GetConfiguration())
+
+	assert.NoError(t, f.Configure(api.LogSinkConfiguration{}))
+	assert.False(t, f.Enabled())
+}
+
+func TestForwarder_Writer(t *testing.T) {
+	var f = NewForwarder(Options{BufferSize: 2})
+	defer f.Close()
+	var w = f.Writer(SourceDaemon)
+
+	// nothing is buffered when there are no sinks
+	w.Write([]byte("hello\n"))
+
+	require.NoError(t, f.Configure(api.LogSinkConfiguration{
+		Sinks: []api.LogSink{{Type: api.LogSinkHTTP, URL: "http://127.0.0.1:1"}},
+	}))
+	w.Write([]byte("one\ntwo\nthree\n"))
+	f.mux.RLock()
+	var sink = f.sinks[0]
+	f.mux.RUnlock()
+	sink.mux.Lock()
+	defer sink.mux.Unlock()
+	// the oldest entry should be dropped from the full buffer
+	require.Len(t, sink.queue, 2)
+	assert.Equal(t, "two", sink.queue[0].Message)
+	assert.Equal(t, "three", sink.queue[1].Message)
+	assert.Equal(t, SourceDaemon, sink.queue[0].Source)
+	assert.Equal(t, 1, sink.dropped)
+}
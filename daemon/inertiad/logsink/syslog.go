@@ -0,0 +1,130 @@
+package logsink
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+)
+
+const (
+	// syslogFacility is the "user-level messages" facility
+	syslogFacility = 1
+	// syslog severities for stdout and stderr
+	syslogInfo  = 6
+	syslogError = 3
+
+	syslogTimeFormat = "2006-01-02T15:04:05.000000Z07:00"
+)
+
+// syslogSender sends entries as RFC5424 messages. Over TCP, messages are
+// framed using octet counting as described in RFC6587; over UDP, each message
+// is sent in its own datagram.
+type syslogSender struct {
+	network  string
+	address  string
+	hostname string
+
+	conn net.Conn
+}
+
+func newSyslogSender(u *url.URL) (*syslogSender, error) {
+	switch u.Scheme {
+	case "tcp", "udp":
+	default:
+		return nil, fmt.Errorf("syslog URL must use tcp:// or udp://, got '%s'", u.Scheme)
+	}
+	if u.Port() == "" {
+		return nil, fmt.Errorf("syslog URL must include a port")
+	}
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+	return &syslogSender{
+		network:  u.Scheme,
+		address:  u.Host,
+		hostname: syslogField(hostname, 255),
+	}, nil
+}
+
+func (s *syslogSender) send(ctx context.Context, entries []Entry) error {
+	if s.conn == nil {
+		var dialer net.Dialer
+		conn, err := dialer.DialContext(ctx, s.network, s.address)
+		if err != nil {
+			return err
+		}
+		s.conn = conn
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		s.conn.SetWriteDeadline(deadline)
+	}
+
+	var buf bytes.Buffer
+	for _, e := range entries {
+		var msg = s.format(e)
+		if s.network == "udp" {
+			if _, err := s.conn.Write(msg); err != nil {
+				s.close()
+				return err
+			}
+			continue
+		}
+		buf.WriteString(strconv.Itoa(len(msg)))
+		buf.WriteByte(' ')
+		buf.Write(msg)
+	}
+	if buf.Len() > 0 {
+		if _, err := s.conn.Write(buf.Bytes()); err != nil {
+			// reconnect on the next attempt
+			s.close()
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *syslogSender) close() error {
+	if s.conn == nil {
+		return nil
+	}
+	var err = s.conn.Close()
+	s.conn = nil
+	return err
+}
+
+// format renders an entry as an RFC5424 message
+func (s *syslogSender) format(e Entry) []byte {
+	var severity = syslogInfo
+	if e.Stderr {
+		severity = syslogError
+	}
+	return []byte(fmt.Sprintf("<%d>1 %s %s %s - - - %s",
+		syslogFacility*8+severity,
+		e.Time.UTC().Format(syslogTimeFormat),
+		s.hostname,
+		syslogField(e.Source, 48),
+		e.Message))
+}
+
+// syslogField makes a value safe for use as a header field, which may only
+// contain printable ASCII characters without spaces
+func syslogField(v string, max int) string {
+	var b = []byte(v)
+	for i, c := range b {
+		if c < 33 || c > 126 {
+			b[i] = '_'
+		}
+	}
+	if len(b) > max {
+		b = b[:max]
+	}
+	if len(b) == 0 {
+		return "-"
+	}
+	return string(b)
+}
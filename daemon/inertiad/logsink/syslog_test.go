@@ -0,0 +1,109 @@
+package logsink
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ubclaunchpad/inertia/api"
+)
+
+var testTime = time.Date(2020, 1, 2, 3, 4, 5, 600000000, time.UTC)
+
+func TestSyslogTCP(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	var received = make(chan string, 10)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		// read octet-counted frames
+		var r = bufio.NewReader(conn)
+		for {
+			length, err := r.ReadString(' ')
+			if err != nil {
+				return
+			}
+			n, _ := strconv.Atoi(strings.TrimSpace(length))
+			var msg = make([]byte, n)
+			if _, err := io.ReadFull(r, msg); err != nil {
+				return
+			}
+			received <- string(msg)
+		}
+	}()
+
+	var f = NewForwarder(Options{FlushInterval: 10 * time.Millisecond})
+	defer f.Close()
+	require.NoError(t, f.Configure(api.LogSinkConfiguration{
+		Sinks: []api.LogSink{{Type: api.LogSinkSyslog, URL: "tcp://" + listener.Addr().String()}},
+	}))
+	f.Write(Entry{Time: testTime, Source: "web", Message: "hello world"})
+	f.Write(Entry{Time: testTime, Source: "web", Stderr: true, Message: "oh no"})
+
+	var hostname = mustHostname(t)
+	for _, want := range []string{
+		"<14>1 2020-01-02T03:04:05.600000Z " + hostname + " web - - - hello world",
+		"<11>1 2020-01-02T03:04:05.600000Z " + hostname + " web - - - oh no",
+	} {
+		select {
+		case got := <-received:
+			assert.Equal(t, want, got)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for message")
+		}
+	}
+}
+
+func TestSyslogUDP(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	var f = NewForwarder(Options{FlushInterval: 10 * time.Millisecond})
+	defer f.Close()
+	require.NoError(t, f.Configure(api.LogSinkConfiguration{
+		Sinks: []api.LogSink{{Type: api.LogSinkSyslog, URL: "udp://" + conn.LocalAddr().String()}},
+	}))
+	f.Write(Entry{Time: testTime, Source: SourceDaemon, Message: "level=info msg=hello"})
+
+	var buf = make([]byte, 1024)
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	n, _, err := conn.ReadFrom(buf)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(buf[:n]), "<14>1 2020-01-02T03:04:05.600000Z "))
+	assert.True(t, strings.HasSuffix(string(buf[:n]), " inertiad - - - level=info msg=hello"))
+}
+
+func Test_newSyslogSender(t *testing.T) {
+	for _, raw := range []string{"http://127.0.0.1:514", "tcp://127.0.0.1"} {
+		u, err := url.Parse(raw)
+		require.NoError(t, err)
+		_, err = newSyslogSender(u)
+		assert.Error(t, err, raw)
+	}
+}
+
+func Test_syslogField(t *testing.T) {
+	assert.Equal(t, "-", syslogField("", 48))
+	assert.Equal(t, "my_app", syslogField("my app", 48))
+	assert.Equal(t, "abc", syslogField("abcdef", 3))
+}
+
+func mustHostname(t *testing.T) string {
+	var s, err = newSyslogSender(&url.URL{Scheme: "udp", Host: "127.0.0.1:514"})
+	require.NoError(t, err)
+	return s.hostname
+}
@@ -1,16 +1,22 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
 	"path"
+	"syscall"
+	"time"
 
+	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/build"
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/cfg"
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/containers"
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/crypto"
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/daemon"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/log"
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/project"
 )
 
@@ -25,12 +31,22 @@ var runCmd = &cobra.Command{
 	Long: `Runs the daemon on a port, default 4303. Requires
 host address as an argument.
 
+Logging can be configured with flags, or with the INERTIA_LOG_LEVEL and
+INERTIA_LOG_FORMAT environment variables.
+
 Example:
-    inertia daemon run 0.0.0.0 --port 8081`,
+    inertia daemon run 0.0.0.0 --port 8081 --log.format json`,
 	Args: cobra.MinimumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		var conf = cfg.New()
 
+		// Set up logging
+		logger, err := newLogger(cmd, conf)
+		if err != nil {
+			println(err.Error())
+			return
+		}
+
 		// Init webhook secret
 		var webhookSecret, _ = cmd.Flags().GetString("webhook.secret")
 		conf.WebhookSecret = webhookSecret
@@ -45,23 +61,58 @@ Example:
 			projectDatabaseKeypath,
 			build.NewBuilder(*conf, containers.StopActiveContainers))
 		if err != nil {
-			println(err.Error())
+			logger.WithError(err).Error("failed to set up deployment")
 			return
 		}
 
 		// Initialize daemon
-		server, err := daemon.New(Version, *conf, deployment)
+		server, err := daemon.New(Version, *conf, deployment, logger)
 		if err != nil {
-			println(err.Error())
+			logger.WithError(err).Error("failed to start daemon")
 			return
 		}
 		defer server.Close()
 
+		// Shut down gracefully on SIGTERM, leaving project containers running
+		var signals = make(chan os.Signal, 1)
+		signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+		go func() {
+			<-signals
+			logger.Info("shutting down daemon")
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			if err := server.Shutdown(ctx); err != nil {
+				logger.WithError(err).Error("failed to shut down gracefully")
+			}
+		}()
+
 		var port, _ = cmd.Flags().GetString("port")
-		println(server.Run(args[0], port))
+		if err := server.Run(args[0], port); err != nil {
+			logger.WithError(err).Error("daemon stopped")
+		}
 	},
 }
 
+// newLogger creates a logger configured by the run command's flags, falling
+// back to the environment for flags that are not set
+func newLogger(cmd *cobra.Command, conf *cfg.Config) (*logrus.Entry, error) {
+	if cmd.Flags().Changed("log.level") || conf.LogLevel == "" {
+		conf.LogLevel, _ = cmd.Flags().GetString("log.level")
+	}
+	if cmd.Flags().Changed("log.format") || conf.LogFormat == "" {
+		conf.LogFormat, _ = cmd.Flags().GetString("log.format")
+	}
+	level, err := logrus.ParseLevel(conf.LogLevel)
+	if err != nil {
+		return nil, err
+	}
+	format, err := log.ParseFormat(conf.LogFormat)
+	if err != nil {
+		return nil, err
+	}
+	return log.NewLogger(os.Stdout, level, format), nil
+}
+
 // tokenCmd retrieves the daemon token
 var tokenCmd = &cobra.Command{
 	Use:   "token",
@@ -99,6 +150,8 @@ func init() {
 	rootCmd.AddCommand(runCmd)
 	rootCmd.AddCommand(tokenCmd)
 	runCmd.Flags().StringP("port", "p", "4303", "Set port for daemon to run on")
+	runCmd.Flags().String("log.level", "info", "Set minimum level of logs (debug, info, warn, error)")
+	runCmd.Flags().String("log.format", "text", "Set format of logs (text, json)")
 }
 
 func main() {
@@ -0,0 +1,114 @@
+package migration
+
+import (
+	"archive/tar"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"time"
+
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/crypto"
+)
+
+// Names of entries in a bundle
+const (
+	EntryManifest      = "manifest.json"
+	EntryUsers         = "users.json"
+	EntryData          = "data.json"
+	EntryHistory       = "history.json"
+	EntryDeployKey     = "deploy_key"
+	EntryWebhookSecret = "webhook_secret"
+	EntryPersist       = "persist.tar.gz"
+)
+
+// Writer writes a bundle - a tar archive encrypted with a passphrase
+type Writer struct {
+	enc io.WriteCloser
+	tw  *tar.Writer
+	now time.Time
+}
+
+// NewWriter starts a bundle written to w, encrypted with the given passphrase
+func NewWriter(w io.Writer, passphrase string) (*Writer, error) {
+	if passphrase == "" {
+		return nil, errors.New("a passphrase is required")
+	}
+	var salt = crypto.GenerateSalt()
+	if _, err := w.Write(salt); err != nil {
+		return nil, err
+	}
+	enc, err := crypto.NewEncryptWriter(crypto.DeriveKey(passphrase, salt), w)
+	if err != nil {
+		return nil, err
+	}
+	return &Writer{enc: enc, tw: tar.NewWriter(enc), now: time.Now()}, nil
+}
+
+// WriteFile adds an entry with the given contents to the bundle
+func (b *Writer) WriteFile(name string, data []byte) error {
+	return b.WriteFrom(name, int64(len(data)), bytes.NewReader(data))
+}
+
+// WriteFrom adds an entry of the given size, read from r, to the bundle
+func (b *Writer) WriteFrom(name string, size int64, r io.Reader) error {
+	if err := b.tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     size,
+		Mode:     0600,
+		ModTime:  b.now,
+	}); err != nil {
+		return err
+	}
+	if _, err := io.CopyN(b.tw, r, size); err != nil {
+		return fmt.Errorf("failed to write '%s': %s", name, err.Error())
+	}
+	return nil
+}
+
+// Close finishes the bundle. It must be called for the bundle to be valid.
+func (b *Writer) Close() error {
+	if err := b.tw.Close(); err != nil {
+		return err
+	}
+	return b.enc.Close()
+}
+
+// Reader reads entries from a bundle written by Writer
+type Reader struct {
+	dec io.Reader
+	tr  *tar.Reader
+}
+
+// NewReader opens a bundle read from r using the given passphrase
+func NewReader(r io.Reader, passphrase string) (*Reader, error) {
+	var salt = make([]byte, crypto.KeyDerivationSaltLength)
+	if _, err := io.ReadFull(r, salt); err != nil {
+		return nil, errors.New("bundle is corrupt")
+	}
+	dec, err := crypto.NewDecryptReader(crypto.DeriveKey(passphrase, salt), r)
+	if err != nil {
+		return nil, errors.New("bundle is corrupt")
+	}
+	return &Reader{dec: dec, tr: tar.NewReader(dec)}, nil
+}
+
+// Next advances to the next entry in the bundle, and returns its name and a
+// reader for its contents. It returns io.EOF once all entries have been read and
+// the integrity of the bundle has been verified.
+func (b *Reader) Next() (string, io.Reader, error) {
+	hdr, err := b.tr.Next()
+	if err == io.EOF {
+		// read to the end of the encrypted stream, so that truncation is
+		// detected
+		if _, err := io.Copy(ioutil.Discard, b.dec); err != nil {
+			return "", nil, fmt.Errorf("bundle is corrupt: %s", err.Error())
+		}
+		return "", nil, io.EOF
+	} else if err != nil {
+		return "", nil, fmt.Errorf("failed to read bundle - check that the passphrase is correct: %s", err.Error())
+	}
+	return hdr.Name, b.tr, nil
+}
@@ -0,0 +1,78 @@
+package migration
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestBundle(t *testing.T, passphrase string) []byte {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, passphrase)
+	require.NoError(t, err)
+	require.NoError(t, w.WriteFile(EntryManifest, []byte(`{"version":1}`)))
+	var persist = strings.Repeat("persisted data ", 10000)
+	require.NoError(t, w.WriteFrom(EntryPersist, int64(len(persist)), strings.NewReader(persist)))
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestBundle(t *testing.T) {
+	var bundle = writeTestBundle(t, "hunter2")
+	assert.NotContains(t, string(bundle), "persisted data")
+
+	r, err := NewReader(bytes.NewReader(bundle), "hunter2")
+	require.NoError(t, err)
+	var entries = map[string]string{}
+	for {
+		name, entry, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(entry)
+		require.NoError(t, err)
+		entries[name] = string(data)
+	}
+	assert.Equal(t, `{"version":1}`, entries[EntryManifest])
+	assert.Len(t, entries[EntryPersist], 150000)
+}
+
+func TestBundle_invalid(t *testing.T) {
+	_, err := NewWriter(ioutil.Discard, "")
+	assert.Error(t, err)
+
+	var bundle = writeTestBundle(t, "hunter2")
+	var readAll = func(r io.Reader, passphrase string) error {
+		br, err := NewReader(r, passphrase)
+		if err != nil {
+			return err
+		}
+		for {
+			_, entry, err := br.Next()
+			if err == io.EOF {
+				return nil
+			} else if err != nil {
+				return err
+			}
+			if _, err := io.Copy(ioutil.Discard, entry); err != nil {
+				return err
+			}
+		}
+	}
+
+	t.Run("wrong passphrase", func(t *testing.T) {
+		assert.Error(t, readAll(bytes.NewReader(bundle), "hunter3"))
+	})
+	t.Run("truncated", func(t *testing.T) {
+		assert.Error(t, readAll(bytes.NewReader(bundle[:len(bundle)-10]), "hunter2"))
+	})
+	t.Run("empty", func(t *testing.T) {
+		assert.Error(t, readAll(bytes.NewReader(nil), "hunter2"))
+	})
+}
@@ -0,0 +1,3 @@
+// Package migration implements bundles of daemon state, used to move a
+// deployment from one remote to another
+package migration
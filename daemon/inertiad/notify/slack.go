@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 )
 
 // SlackNotifier represents slack notifications
@@ -22,16 +23,21 @@ func NewSlackNotifier(webhookURL string) Notifier {
 	}
 }
 
-// Notify sends the notification
+// Notify sends the notification. The first line of the given text is used as
+// the title of the message.
 func (n *SlackNotifier) Notify(text string, options Options) error {
 	if n.hookURL == "" {
 		return nil
 	}
 
+	var title, details = text, ""
+	if i := strings.Index(text, "\n"); i >= 0 {
+		title, details = text[:i], text[i:]
+	}
 	b, err := json.Marshal(MessageArray{
 		Attachments: []Message{
 			{
-				Text:  fmt.Sprintf("*%s*", text),
+				Text:  fmt.Sprintf("*%s*%s", title, details),
 				Color: colorToString(options.Color),
 			},
 		},
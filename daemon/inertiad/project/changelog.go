@@ -0,0 +1,80 @@
+package project
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/git"
+)
+
+const (
+	// maxChangelogCommits is the most commits recorded for each deploy
+	maxChangelogCommits = 50
+
+	// maxNotifyCommits is the most commits listed in a notification
+	maxNotifyCommits = 10
+)
+
+// Changelog describes the commits shipped by a deploy
+type Changelog struct {
+	Commits   []api.Commit
+	Truncated bool
+}
+
+// newChangelog lists the commits between the previously deployed commit, as
+// recorded in the deployment history, and the commit currently checked out.
+// The changelog is empty if the project has not been deployed before.
+func (d *Deployment) newChangelog() (Changelog, error) {
+	var log Changelog
+	if d.dataManager == nil || d.repo == nil {
+		return log, nil
+	}
+	builds, err := d.dataManager.GetProjectBuildData(d.project)
+	if err != nil || len(builds) == 0 {
+		return log, err
+	}
+	head, err := d.repo.Head()
+	if err != nil {
+		return log, err
+	}
+
+	commits, truncated, err := git.CommitRange(d.repo,
+		plumbing.NewHash(builds[0].Hash), head.Hash(), maxChangelogCommits)
+	if err != nil {
+		return log, err
+	}
+	for _, c := range commits {
+		log.Commits = append(log.Commits, newCommit(c))
+	}
+	log.Truncated = truncated
+	return log, nil
+}
+
+// String renders a short list of the commits and their authors for use in
+// notifications
+func (l Changelog) String() string {
+	var lines = make([]string, 0, maxNotifyCommits+1)
+	for i, c := range l.Commits {
+		if i == maxNotifyCommits {
+			break
+		}
+		var hash = c.Hash
+		if len(hash) > 7 {
+			hash = hash[:7]
+		}
+		lines = append(lines, fmt.Sprintf("• %s %s (%s)", hash, c.Message, c.Author))
+	}
+	if len(l.Commits) > maxNotifyCommits || l.Truncated {
+		if l.Truncated {
+			lines = append(lines, fmt.Sprintf("• and more than %d other commits",
+				len(l.Commits)-len(lines)))
+		} else {
+			lines = append(lines, fmt.Sprintf("• and %d other commits",
+				len(l.Commits)-len(lines)))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
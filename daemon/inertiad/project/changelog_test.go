@@ -0,0 +1,76 @@
+package project
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ubclaunchpad/inertia/api"
+)
+
+func TestNewChangelog(t *testing.T) {
+	dir := "./test_changelog"
+	assert.NoError(t, os.Mkdir(dir, os.ModePerm))
+	defer os.RemoveAll(dir)
+
+	c, err := NewDataManager(path.Join(dir, "deployment.db"), path.Join(dir, "key"))
+	assert.NoError(t, err)
+	repo, err := gogit.PlainInit(path.Join(dir, "project"), false)
+	assert.NoError(t, err)
+	tree, err := repo.Worktree()
+	assert.NoError(t, err)
+	var commit = func(message string) plumbing.Hash {
+		assert.NoError(t, ioutil.WriteFile(path.Join(dir, "project", "README"), []byte(message), 0644))
+		_, err := tree.Add("README")
+		assert.NoError(t, err)
+		hash, err := tree.Commit(message+"\n\nmore details", &gogit.CommitOptions{
+			Author: &object.Signature{Name: "bob", Email: "bob@inertia.com", When: time.Now()},
+		})
+		assert.NoError(t, err)
+		return hash
+	}
+	var d = &Deployment{project: "wow", repo: repo, dataManager: c}
+
+	// nothing has been deployed
+	var first = commit("first")
+	log, err := d.newChangelog()
+	assert.NoError(t, err)
+	assert.Empty(t, log.Commits)
+
+	assert.NoError(t, c.AddProjectBuildData("wow", DeploymentMetadata{Hash: first.String()}))
+	var second, third = commit("second"), commit("third")
+	log, err = d.newChangelog()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{third.String(), second.String()},
+		[]string{log.Commits[0].Hash, log.Commits[1].Hash})
+	assert.Equal(t, "third", log.Commits[0].Message)
+	assert.Equal(t, "bob", log.Commits[0].Author)
+	assert.False(t, log.Truncated)
+}
+
+func TestChangelog_String(t *testing.T) {
+	var log = Changelog{Commits: []api.Commit{
+		{Hash: "1234567890", Author: "bob", Message: "fix things"},
+	}}
+	assert.Equal(t, "• 1234567 fix things (bob)", log.String())
+
+	log = Changelog{}
+	for i := 0; i < maxNotifyCommits+2; i++ {
+		log.Commits = append(log.Commits, api.Commit{Hash: "1234567890", Author: "bob", Message: "wow"})
+	}
+	var lines = strings.Split(log.String(), "\n")
+	assert.Len(t, lines, maxNotifyCommits+1)
+	assert.Equal(t, "• and 2 other commits", lines[maxNotifyCommits])
+
+	log.Truncated = true
+	lines = strings.Split(log.String(), "\n")
+	assert.Equal(t, "• and more than 2 other commits", lines[maxNotifyCommits])
+}
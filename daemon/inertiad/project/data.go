@@ -7,8 +7,11 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/ubclaunchpad/inertia/api"
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/crypto"
 	bolt "go.etcd.io/bbolt"
 )
@@ -16,6 +19,8 @@ import (
 var (
 	// database buckets
 	envVariableBucket      = []byte("envVariables")
+	secretBucket           = []byte("secrets")
+	settingsBucket         = []byte("settings")
 	deployedProjectsBucket = []byte("deployedProjects")
 )
 
@@ -27,22 +32,29 @@ type DeploymentDataManager struct {
 
 	// Keys for encrypting data
 	symmetricKey []byte
+	keyPath      string
 }
 
-// NewDataManager instantiates a database associated with a deployment
+// NewDataManager instantiates a database associated with a deployment. A new
+// key is only generated if no key exists at keyPath - an unreadable or invalid
+// key is an error, since regenerating it would make existing encrypted values
+// unrecoverable.
 func NewDataManager(dbPath string, keyPath string) (*DeploymentDataManager, error) {
 	// retrieve AES key, generate if not present
-	var key []byte
-	var err error
-	if key, err = ioutil.ReadFile(keyPath); err != nil || len(key) != crypto.SymmetricKeyLength {
-		key = make([]byte, crypto.SymmetricKeyLength)
-		if _, err := rand.Read(key); err != nil {
-			return nil, fmt.Errorf("failed to generate key: %s", key)
-		}
-		os.Remove(keyPath)
+	key, err := ioutil.ReadFile(keyPath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, fmt.Errorf("failed to read key at '%s': %s", keyPath, err.Error())
+		}
+		if key, err = generateSymmetricKey(); err != nil {
+			return nil, err
+		}
 		if err := ioutil.WriteFile(keyPath, key, 0600); err != nil {
 			return nil, fmt.Errorf("failed to write key to '%s': %s", keyPath, err.Error())
 		}
+	} else if len(key) != crypto.SymmetricKeyLength {
+		return nil, fmt.Errorf("key at '%s' is invalid: expected %d bytes, found %d",
+			keyPath, crypto.SymmetricKeyLength, len(key))
 	}
 
 	// Set up database
@@ -56,24 +68,41 @@ func NewDataManager(dbPath string, keyPath string) (*DeploymentDataManager, erro
 			return fmt.Errorf("failed to created env variable bucket: %s", err.Error())
 		}
 
+		_, err = tx.CreateBucketIfNotExists(secretBucket)
+		if err != nil {
+			return fmt.Errorf("failed to created secrets bucket: %s", err.Error())
+		}
+
 		_, err = tx.CreateBucketIfNotExists(deployedProjectsBucket)
 		if err != nil {
 			return fmt.Errorf("failed to created deployed projects bucket: %s", err.Error())
 		}
+
+		_, err = tx.CreateBucketIfNotExists(settingsBucket)
+		if err != nil {
+			return fmt.Errorf("failed to created settings bucket: %s", err.Error())
+		}
 		return err
 	}); err != nil {
 		return nil, fmt.Errorf("failed to instantiate database: %s", err.Error())
 	}
 
 	return &DeploymentDataManager{
-		db,
-		key,
+		db:           db,
+		symmetricKey: key,
+		keyPath:      keyPath,
 	}, nil
 }
 
 // AddEnvVariable adds a new environment variable that will be applied
 // to all project containers
 func (c *DeploymentDataManager) AddEnvVariable(name, value string, encrypt bool) error {
+	return c.AddScopedEnvVariable(EnvScope{}, name, value, encrypt)
+}
+
+// AddScopedEnvVariable adds a new environment variable that will only be
+// applied to deployments and containers matching the given scope
+func (c *DeploymentDataManager) AddScopedEnvVariable(scope EnvScope, name, value string, encrypt bool) error {
 	if len(name) == 0 || len(value) == 0 {
 		return errors.New("invalid env configuration")
 	}
@@ -90,22 +119,31 @@ func (c *DeploymentDataManager) AddEnvVariable(name, value string, encrypt bool)
 	return c.db.Update(func(tx *bolt.Tx) error {
 		vars := tx.Bucket(envVariableBucket)
 		bytes, err := json.Marshal(envVariable{
+			Name:      name,
 			Value:     valueBytes,
 			Encrypted: encrypt,
+			Profile:   scope.Profile,
+			Container: scope.Container,
 		})
 		if err != nil {
 			return err
 		}
-		return vars.Put([]byte(name), bytes)
+		return vars.Put(scope.key(name), bytes)
 	})
 }
 
 // RemoveEnvVariables removes previously set env variables
 func (c *DeploymentDataManager) RemoveEnvVariables(names ...string) error {
+	return c.RemoveScopedEnvVariables(EnvScope{}, names...)
+}
+
+// RemoveScopedEnvVariables removes previously set env variables in the given
+// scope
+func (c *DeploymentDataManager) RemoveScopedEnvVariables(scope EnvScope, names ...string) error {
 	return c.db.Update(func(tx *bolt.Tx) error {
 		var vars = tx.Bucket(envVariableBucket)
 		for _, n := range names {
-			if err := vars.Delete([]byte(n)); err != nil {
+			if err := vars.Delete(scope.key(n)); err != nil {
 				return err
 			}
 		}
@@ -113,69 +151,304 @@ func (c *DeploymentDataManager) RemoveEnvVariables(names ...string) error {
 	})
 }
 
-// GetEnvVariables retrieves all stored environment variables
+// GetEnvVariables retrieves all stored environment variables. Scoped variables
+// are annotated with their scope.
 func (c *DeploymentDataManager) GetEnvVariables(decrypt bool) ([]string, error) {
 	var envs = []string{}
-	var faulty = []string{}
-	var err = c.db.View(func(tx *bolt.Tx) error {
+	var err = c.forEachEnvVariable(func(variable envVariable) error {
+		var value string
+		if !variable.Encrypted {
+			value = string(variable.Value)
+		} else if !decrypt {
+			value = "[ENCRYPTED]"
+		} else {
+			decrypted, err := crypto.Decrypt(c.symmetricKey, variable.Value)
+			if err != nil {
+				return fmt.Errorf("failed to decrypt variable '%s': %s", variable.Name, err.Error())
+			}
+			value = string(decrypted)
+		}
+
+		var env = variable.Name + "=" + value
+		if scope := variable.scope().String(); scope != "" {
+			env += " (" + scope + ")"
+		}
+		envs = append(envs, env)
+		return nil
+	})
+
+	return envs, err
+}
+
+// ListEnvVariables describes all stored environment variables, without the
+// values of encrypted ones
+func (c *DeploymentDataManager) ListEnvVariables() ([]api.EnvVariable, error) {
+	var envs = []api.EnvVariable{}
+	var err = c.forEachEnvVariable(func(variable envVariable) error {
+		var env = api.EnvVariable{
+			Name:      variable.Name,
+			Encrypted: variable.Encrypted,
+			Profile:   variable.Profile,
+			Container: variable.Container,
+		}
+		if !variable.Encrypted {
+			env.Value = string(variable.Value)
+		}
+		envs = append(envs, env)
+		return nil
+	})
+
+	return envs, err
+}
+
+// GetDeploymentEnvVariables retrieves decrypted environment variables that
+// apply to a deployment using the given profile. Variables that apply to all
+// containers are returned in shared, and variables scoped to specific containers
+// are returned in containers, keyed by container name. Profile-scoped variables
+// take precedence over unscoped ones with the same name.
+func (c *DeploymentDataManager) GetDeploymentEnvVariables(profile string) (
+	shared []string,
+	containers map[string][]string,
+	err error,
+) {
+	var (
+		// index 0 holds unscoped values, index 1 holds profile-scoped ones
+		sharedVals    = [2]map[string]string{{}, {}}
+		containerVals = map[string]*[2]map[string]string{}
+	)
+	if err = c.forEachEnvVariable(func(variable envVariable) error {
+		if variable.Profile != "" && variable.Profile != profile {
+			return nil
+		}
+
+		var value = variable.Value
+		if variable.Encrypted {
+			decrypted, err := crypto.Decrypt(c.symmetricKey, variable.Value)
+			if err != nil {
+				return fmt.Errorf("failed to decrypt variable '%s': %s", variable.Name, err.Error())
+			}
+			value = decrypted
+		}
+
+		var precedence = 0
+		if variable.Profile != "" {
+			precedence = 1
+		}
+		if variable.Container == "" {
+			sharedVals[precedence][variable.Name] = string(value)
+		} else {
+			vals, ok := containerVals[variable.Container]
+			if !ok {
+				vals = &[2]map[string]string{{}, {}}
+				containerVals[variable.Container] = vals
+			}
+			vals[precedence][variable.Name] = string(value)
+		}
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	shared = mergeEnvValues(sharedVals)
+	containers = make(map[string][]string, len(containerVals))
+	for name, vals := range containerVals {
+		containers[name] = mergeEnvValues(*vals)
+	}
+	return shared, containers, nil
+}
+
+// forEachEnvVariable iterates over all stored environment variables
+func (c *DeploymentDataManager) forEachEnvVariable(fn func(envVariable) error) error {
+	return c.db.View(func(tx *bolt.Tx) error {
 		var variables = tx.Bucket(envVariableBucket)
-		return variables.ForEach(func(name, variableBytes []byte) error {
-			var variable = &envVariable{}
-			if err := json.Unmarshal(variableBytes, variable); err != nil {
+		return variables.ForEach(func(key, variableBytes []byte) error {
+			var variable = envVariable{}
+			if err := json.Unmarshal(variableBytes, &variable); err != nil {
 				return err
 			}
-
-			var nameString = string(name)
-			if !variable.Encrypted {
-				envs = append(envs, nameString+"="+string(variable.Value))
-			} else if !decrypt {
-				envs = append(envs, nameString+"=[ENCRYPTED]")
-			} else {
-				decrypted, err := crypto.Decrypt(c.symmetricKey, variable.Value)
-				if err != nil {
-					// If decrypt fails, key is no longer valid - remove var
-					faulty = append(faulty, nameString)
-				}
-				envs = append(envs, nameString+"="+string(decrypted))
+			if variable.Name == "" {
+				// variables set before scoping was introduced do not track
+				// their name
+				variable.Name = string(key)
 			}
-			return nil
+			return fn(variable)
 		})
 	})
+}
 
-	c.RemoveEnvVariables(faulty...)
+// mergeEnvValues flattens the given sets of values into a sorted list of
+// environment variables, with later sets taking precedence
+func mergeEnvValues(sets [2]map[string]string) []string {
+	var merged = map[string]string{}
+	for _, set := range sets {
+		for k, v := range set {
+			merged[k] = v
+		}
+	}
+	var envs = make([]string, 0, len(merged))
+	for k, v := range merged {
+		envs = append(envs, k+"="+v)
+	}
+	sort.Strings(envs)
+	return envs
+}
 
-	return envs, err
+// ExportEnvVariables decrypts all stored environment variables and encrypts
+// them together using the given passphrase, so that they can be imported into
+// another deployment using ImportEnvVariables.
+func (c *DeploymentDataManager) ExportEnvVariables(passphrase string) ([]byte, error) {
+	var exported = []envVariable{}
+	if err := c.forEachEnvVariable(func(variable envVariable) error {
+		if variable.Encrypted {
+			decrypted, err := crypto.Decrypt(c.symmetricKey, variable.Value)
+			if err != nil {
+				return fmt.Errorf("failed to decrypt variable '%s': %s", variable.Name, err.Error())
+			}
+			variable.Value = decrypted
+		}
+		exported = append(exported, variable)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	bytes, err := json.Marshal(exported)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode variables: %s", err.Error())
+	}
+	return crypto.EncryptWithPassphrase(passphrase, bytes)
 }
 
-// AddProjectBuildData stores and tracks metadata from successful builds
-// TODO: Change name, error check, only insert project mdata inside private helper 'update build'
-func (c *DeploymentDataManager) AddProjectBuildData(projectName string, mdata DeploymentMetadata) error {
-	// encode metadata so it can be stored as byte array
-	encodedMdata, err := json.Marshal(mdata)
+// ImportEnvVariables decrypts variables exported with ExportEnvVariables and
+// stores them, overwriting existing variables with the same name. Variables
+// that were encrypted when exported are encrypted with this deployment's key.
+func (c *DeploymentDataManager) ImportEnvVariables(passphrase string, data []byte) (int, error) {
+	decrypted, err := crypto.DecryptWithPassphrase(passphrase, data)
 	if err != nil {
-		return fmt.Errorf("failure encrypting metadata: %s", err.Error())
+		return 0, errors.New("failed to decrypt variables - check that your passphrase is correct")
 	}
-	err = c.db.Update(func(tx *bolt.Tx) error {
-		depProjectsBkt := tx.Bucket(deployedProjectsBucket)
-		// if bkt with project name doesnt exist create new bkt, otherwise update existing bucket
-		if projectBkt := depProjectsBkt.Bucket([]byte(projectName)); projectBkt == nil {
-			projectBkt, err := depProjectsBkt.CreateBucket([]byte(projectName))
+	var imported []envVariable
+	if err := json.Unmarshal(decrypted, &imported); err != nil {
+		return 0, fmt.Errorf("failed to decode variables: %s", err.Error())
+	}
+
+	return len(imported), c.db.Update(func(tx *bolt.Tx) error {
+		var vars = tx.Bucket(envVariableBucket)
+		for _, v := range imported {
+			if v.Name == "" {
+				return errors.New("invalid variable with no name found")
+			}
+			var value = v.Value
+			if v.Encrypted {
+				if value, err = crypto.Encrypt(c.symmetricKey, value); err != nil {
+					return err
+				}
+			}
+			v.Value = value
+			bytes, err := json.Marshal(v)
 			if err != nil {
-				return fmt.Errorf("failure creating project bkt: %s", err.Error())
+				return err
 			}
-
-			if err := projectBkt.Put([]byte(time.Now().String()), encodedMdata); err != nil {
-				return fmt.Errorf("failure inserting project metadata: %s", err.Error())
+			if err := vars.Put(v.scope().key(v.Name), bytes); err != nil {
+				return err
 			}
 		}
 		return nil
 	})
+}
+
+// RotateKey generates a new symmetric key and re-encrypts all encrypted
+// environment variables and secrets with it. The new key only replaces the old
+// one once all values have been successfully re-encrypted.
+func (c *DeploymentDataManager) RotateKey() error {
+	key, err := generateSymmetricKey()
+	if err != nil {
+		return err
+	}
+
+	// stage the new key next to the current one, so that the database update and
+	// key replacement are as close to atomic as we can get
+	var stagedKeyPath = c.keyPath + ".new"
+	if err := ioutil.WriteFile(stagedKeyPath, key, 0600); err != nil {
+		return fmt.Errorf("failed to write key to '%s': %s", stagedKeyPath, err.Error())
+	}
+
+	if err := c.db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range [][]byte{envVariableBucket, secretBucket, settingsBucket} {
+			if err := c.rotateBucket(tx.Bucket(bucket), key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		os.Remove(stagedKeyPath)
+		return fmt.Errorf("failed to re-encrypt variables: %s", err.Error())
+	}
+
+	if err := os.Rename(stagedKeyPath, c.keyPath); err != nil {
+		return fmt.Errorf("variables were re-encrypted but the new key could not be moved from '%s' to '%s': %s",
+			stagedKeyPath, c.keyPath, err.Error())
+	}
+	c.symmetricKey = key
+	return nil
+}
+
+// rotateBucket re-encrypts all encrypted values in the given bucket with key
+func (c *DeploymentDataManager) rotateBucket(bucket *bolt.Bucket, key []byte) error {
+	var rotated = make(map[string][]byte)
+	if err := bucket.ForEach(func(name, variableBytes []byte) error {
+		var variable = envVariable{}
+		if err := json.Unmarshal(variableBytes, &variable); err != nil {
+			return err
+		}
+		if !variable.Encrypted {
+			return nil
+		}
+		decrypted, err := crypto.Decrypt(c.symmetricKey, variable.Value)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt '%s': %s", string(name), err.Error())
+		}
+		if variable.Value, err = crypto.Encrypt(key, decrypted); err != nil {
+			return err
+		}
+		bytes, err := json.Marshal(variable)
+		if err != nil {
+			return err
+		}
+		rotated[string(name)] = bytes
+		return nil
+	}); err != nil {
+		return err
+	}
+	for name, bytes := range rotated {
+		if err := bucket.Put([]byte(name), bytes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddProjectBuildData stores and tracks metadata from successful builds
+func (c *DeploymentDataManager) AddProjectBuildData(projectName string, mdata DeploymentMetadata) error {
+	if err := c.db.Update(func(tx *bolt.Tx) error {
+		// if bkt with project name doesnt exist create new bkt, otherwise update existing bucket
+		_, err := tx.Bucket(deployedProjectsBucket).CreateBucketIfNotExists([]byte(projectName))
+		if err != nil {
+			return fmt.Errorf("failure creating project bkt: %s", err.Error())
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
 	return c.UpdateProjectBuildData(projectName, mdata)
 }
 
 // UpdateProjectBuildData updates existing project bkt with recent build's metadata
 func (c *DeploymentDataManager) UpdateProjectBuildData(projectName string,
 	mdata DeploymentMetadata) error {
+	if mdata.DeployedAt.IsZero() {
+		mdata.DeployedAt = time.Now()
+	}
 	// encode metadata so it can be stored as byte array
 	encodedMdata, err := json.Marshal(mdata)
 	if err != nil {
@@ -184,8 +457,11 @@ func (c *DeploymentDataManager) UpdateProjectBuildData(projectName string,
 	return c.db.Update(func(tx *bolt.Tx) error {
 		depProjectBkt := tx.Bucket(deployedProjectsBucket)
 		projectBkt := depProjectBkt.Bucket([]byte(projectName))
+		if projectBkt == nil {
+			return fmt.Errorf("no builds of project '%s' found", projectName)
+		}
 
-		if err := projectBkt.Put([]byte(time.Now().String()), encodedMdata); err != nil {
+		if err := projectBkt.Put([]byte(mdata.DeployedAt.String()), encodedMdata); err != nil {
 			return fmt.Errorf("failure updating db with project metadata: %s", err.Error())
 		}
 		return nil
@@ -193,6 +469,47 @@ func (c *DeploymentDataManager) UpdateProjectBuildData(projectName string,
 
 }
 
+// GetProjectBuildData retrieves metadata of the given project's builds, most
+// recent first
+func (c *DeploymentDataManager) GetProjectBuildData(projectName string) ([]DeploymentMetadata, error) {
+	var builds = make([]DeploymentMetadata, 0)
+	if err := c.db.View(func(tx *bolt.Tx) error {
+		projectBkt := tx.Bucket(deployedProjectsBucket).Bucket([]byte(projectName))
+		if projectBkt == nil {
+			return nil
+		}
+		return projectBkt.ForEach(func(k, v []byte) error {
+			var mdata DeploymentMetadata
+			if err := json.Unmarshal(v, &mdata); err != nil {
+				return fmt.Errorf("failed to decode build metadata: %s", err.Error())
+			}
+			if mdata.DeployedAt.IsZero() {
+				// older builds are only timestamped by their key
+				mdata.DeployedAt = parseBuildKey(string(k))
+			}
+			builds = append(builds, mdata)
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(builds, func(i, j int) bool {
+		return builds[i].DeployedAt.After(builds[j].DeployedAt)
+	})
+	return builds, nil
+}
+
+// parseBuildKey parses the time a build was stored at from its key, which is
+// formatted by time.Time.String
+func parseBuildKey(key string) time.Time {
+	// strip monotonic clock reading, if there is one
+	if i := strings.Index(key, " m="); i >= 0 {
+		key = key[:i]
+	}
+	t, _ := time.Parse("2006-01-02 15:04:05.999999999 -0700 MST", key)
+	return t
+}
+
 // GetNumOfDeployedProjects returns number of projects currently deployed
 func (c *DeploymentDataManager) GetNumOfDeployedProjects(projectName string) (int, error) {
 	var numBkts int
@@ -205,12 +522,33 @@ func (c *DeploymentDataManager) GetNumOfDeployedProjects(projectName string) (in
 	return numBkts, err
 }
 
+func generateSymmetricKey() ([]byte, error) {
+	var key = make([]byte, crypto.SymmetricKeyLength)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate key: %s", err.Error())
+	}
+	return key, nil
+}
+
+// destroy removes the environment variables, secrets, and settings of the
+// active deployment when the project is reset. Configuration of the daemon
+// itself, such as backup, metrics, and disk configuration and the webhook
+// secret, is kept.
 func (c *DeploymentDataManager) destroy() error {
 	return c.db.Update(func(tx *bolt.Tx) error {
-		if err := tx.DeleteBucket(envVariableBucket); err != nil {
-			return err
+		for _, bucket := range [][]byte{envVariableBucket, secretBucket} {
+			if err := tx.DeleteBucket(bucket); err != nil {
+				return err
+			}
+			if _, err := tx.CreateBucket(bucket); err != nil {
+				return err
+			}
 		}
-		_, err := tx.CreateBucket(envVariableBucket)
-		return err
+		for _, setting := range []string{settingDeployment, settingDeployedEnv, settingLogSinks} {
+			if err := tx.Bucket(settingsBucket).Delete([]byte(setting)); err != nil {
+				return err
+			}
+		}
+		return nil
 	})
 }
@@ -1,11 +1,15 @@
 package project
 
 import (
+	"io/ioutil"
 	"os"
 	"path"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/ubclaunchpad/inertia/api"
 )
 
 func TestDataManager_EnvVariableOperations(t *testing.T) {
@@ -76,7 +80,8 @@ func TestDataManager_ProjectBuildDataOperations(t *testing.T) {
 		args    args
 		wantErr bool
 	}{
-		{"valid project build", args{"projectB", DeploymentMetadata{"hash", "ID", "status", "time"}, 2}, false},
+		{"valid project build", args{"projectB", DeploymentMetadata{
+			Hash: "hash", ContainerID: "ID", ContainerStatus: "status", StartedAt: "time"}, 2}, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -104,10 +109,31 @@ func TestDataManager_ProjectBuildDataOperations(t *testing.T) {
 			numBkts, err = c.GetNumOfDeployedProjects(tt.args.projectName)
 			assert.Nil(t, err)
 			assert.Equal(t, tt.args.numProjects+1, numBkts)
+
+			// Builds are retrieved most recent first
+			var later = tt.args.metadata
+			later.Hash = "later"
+			later.DeployedAt = time.Now().Add(time.Hour)
+			err = c.AddProjectBuildData(tt.args.projectName, later)
+			assert.Nil(t, err)
+			builds, err := c.GetProjectBuildData(tt.args.projectName)
+			assert.Nil(t, err)
+			assert.Len(t, builds, 3)
+			assert.Equal(t, "later", builds[0].Hash)
+			assert.False(t, builds[2].DeployedAt.IsZero())
+			builds, err = c.GetProjectBuildData("nope")
+			assert.Nil(t, err)
+			assert.Empty(t, builds)
 		})
 	}
 }
 
+func TestParseBuildKey(t *testing.T) {
+	var now = time.Now()
+	assert.True(t, now.Equal(parseBuildKey(now.String())))
+	assert.True(t, parseBuildKey("wow").IsZero())
+}
+
 func TestDataManager_destroy(t *testing.T) {
 	dir := "./test_config"
 	err := os.Mkdir(dir, os.ModePerm)
@@ -126,3 +152,137 @@ func TestDataManager_destroy(t *testing.T) {
 	_, err = c.GetEnvVariables(false)
 	assert.NoError(t, err)
 }
+
+func TestNewDataManager_InvalidKey(t *testing.T) {
+	dir := "./test_config"
+	err := os.Mkdir(dir, os.ModePerm)
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// Invalid keys should not be silently replaced
+	var keyPath = path.Join(dir, "key")
+	assert.NoError(t, ioutil.WriteFile(keyPath, []byte("not a key"), 0600))
+	_, err = NewDataManager(path.Join(dir, "deployment.db"), keyPath)
+	assert.Error(t, err)
+	key, err := ioutil.ReadFile(keyPath)
+	assert.NoError(t, err)
+	assert.Equal(t, "not a key", string(key))
+}
+
+func TestDataManager_ExportImportEnvVariables(t *testing.T) {
+	dir := "./test_config"
+	err := os.Mkdir(dir, os.ModePerm)
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// Instantiate two managers with different keys
+	src, err := NewDataManager(path.Join(dir, "src.db"), path.Join(dir, "src.key"))
+	assert.NoError(t, err)
+	dst, err := NewDataManager(path.Join(dir, "dst.db"), path.Join(dir, "dst.key"))
+	assert.NoError(t, err)
+	assert.NotEqual(t, src.symmetricKey, dst.symmetricKey)
+
+	assert.NoError(t, src.AddEnvVariable("plain", "wow", false))
+	assert.NoError(t, src.AddEnvVariable("secret", "sekret", true))
+
+	// Export
+	data, err := src.ExportEnvVariables("hunter7")
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "sekret")
+
+	// Import with wrong passphrase
+	_, err = dst.ImportEnvVariables("hunter8", data)
+	assert.Error(t, err)
+
+	// Import
+	count, err := dst.ImportEnvVariables("hunter7", data)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	vars, err := dst.GetEnvVariables(false)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"plain=wow", "secret=[ENCRYPTED]"}, vars)
+	vars, err = dst.GetEnvVariables(true)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"plain=wow", "secret=sekret"}, vars)
+}
+
+func TestDataManager_RotateKey(t *testing.T) {
+	dir := "./test_config"
+	err := os.Mkdir(dir, os.ModePerm)
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		dbPath  = path.Join(dir, "deployment.db")
+		keyPath = path.Join(dir, "key")
+	)
+	c, err := NewDataManager(dbPath, keyPath)
+	assert.NoError(t, err)
+	assert.NoError(t, c.AddEnvVariable("plain", "wow", false))
+	assert.NoError(t, c.AddEnvVariable("secret", "sekret", true))
+	oldKey, err := ioutil.ReadFile(keyPath)
+	assert.NoError(t, err)
+
+	// Rotate
+	assert.NoError(t, c.RotateKey())
+	newKey, err := ioutil.ReadFile(keyPath)
+	assert.NoError(t, err)
+	assert.NotEqual(t, oldKey, newKey)
+	assert.Equal(t, newKey, c.symmetricKey)
+	_, err = os.Stat(keyPath + ".new")
+	assert.True(t, os.IsNotExist(err))
+
+	// Values should still be readable
+	vars, err := c.GetEnvVariables(true)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"plain=wow", "secret=sekret"}, vars)
+
+	// Values should no longer be readable with the old key
+	c.symmetricKey = oldKey
+	_, err = c.GetEnvVariables(true)
+	assert.Error(t, err)
+}
+
+func TestDataManager_GetDeploymentEnvVariables(t *testing.T) {
+	dir := "./test_config"
+	assert.NoError(t, os.Mkdir(dir, os.ModePerm))
+	defer os.RemoveAll(dir)
+
+	c, err := NewDataManager(path.Join(dir, "deployment.db"), path.Join(dir, "key"))
+	assert.NoError(t, err)
+
+	assert.NoError(t, c.AddEnvVariable("A", "global", false))
+	assert.NoError(t, c.AddEnvVariable("B", "global", true))
+	assert.NoError(t, c.AddScopedEnvVariable(EnvScope{Profile: "staging"}, "B", "staging", true))
+	assert.NoError(t, c.AddScopedEnvVariable(EnvScope{Profile: "prod"}, "C", "prod", false))
+	assert.NoError(t, c.AddScopedEnvVariable(EnvScope{Container: "web"}, "D", "web", false))
+	assert.NoError(t, c.AddScopedEnvVariable(EnvScope{Profile: "staging", Container: "web"}, "D", "staging-web", false))
+
+	vars, err := c.GetEnvVariables(false)
+	assert.NoError(t, err)
+	assert.Len(t, vars, 6)
+	assert.Contains(t, vars, "B=[ENCRYPTED] (profile: staging)")
+	assert.Contains(t, vars, "D=staging-web (profile: staging, container: web)")
+
+	listed, err := c.ListEnvVariables()
+	assert.NoError(t, err)
+	assert.Len(t, listed, 6)
+	assert.Contains(t, listed, api.EnvVariable{Name: "B", Encrypted: true, Profile: "staging"})
+	assert.Contains(t, listed, api.EnvVariable{Name: "D", Value: "staging-web", Profile: "staging", Container: "web"})
+
+	shared, containers, err := c.GetDeploymentEnvVariables("staging")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"A=global", "B=staging"}, shared)
+	assert.Equal(t, map[string][]string{"web": {"D=staging-web"}}, containers)
+
+	shared, containers, err = c.GetDeploymentEnvVariables("")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"A=global", "B=global"}, shared)
+	assert.Equal(t, map[string][]string{"web": {"D=web"}}, containers)
+
+	// removing scoped variables should not affect other scopes
+	assert.NoError(t, c.RemoveScopedEnvVariables(EnvScope{Profile: "staging"}, "B"))
+	shared, _, err = c.GetDeploymentEnvVariables("staging")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"A=global", "B=global"}, shared)
+}
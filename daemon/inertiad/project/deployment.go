@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/filters"
@@ -29,18 +30,23 @@ import (
 //
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -o ./mocks/deployer.go ./deployment.go Deployer
 type Deployer interface {
-	Deploy(*docker.Client, io.Writer, DeployOptions) (func() error, error)
+	Deploy(*docker.Client, io.Writer, DeployOptions) (func() error, Changelog, error)
 	Initialize(cfg DeploymentConfig, out io.Writer) error
 	Down(*docker.Client, io.Writer) error
 	Destroy(*docker.Client, io.Writer) error
 	Prune(*docker.Client, io.Writer) error
 	GetStatus(*docker.Client) (api.DeploymentStatus, error)
+	Restore(*docker.Client, io.Writer) (bool, error)
+	Plan(*docker.Client, DeploymentConfig, DeployOptions) (api.DeploymentPlan, error)
 
 	SetConfig(DeploymentConfig)
+	GetProject() string
 	GetBranch() string
 	CompareRemotes(string) error
 
-	UpdateContainerHistory(cli *docker.Client) error
+	UpdateContainerHistory(cli *docker.Client, changes Changelog) error
+
+	Notify(string, notify.Options) error
 
 	GetDataManager() (*DeploymentDataManager, bool)
 
@@ -54,10 +60,14 @@ type Deployment struct {
 	persistDirectory string
 
 	project                string
+	profile                string
 	branch                 string
 	buildType              string
 	buildFilePath          string
 	intermediaryContainers []string
+	remoteURL              string
+	pemFilePath            string
+	slackNotificationURL   string
 
 	builder build.ContainerBuilder
 
@@ -65,6 +75,11 @@ type Deployment struct {
 	auth ssh.AuthMethod
 	mux  sync.Mutex
 
+	// repoMux serializes access to repo, since go-git repositories are not safe
+	// for concurrent use. It is taken without mux while fetching for a plan, so
+	// if both are needed, mux must be locked first.
+	repoMux sync.Mutex
+
 	dataManager *DeploymentDataManager
 
 	notifiers notify.Notifiers
@@ -73,6 +88,7 @@ type Deployment struct {
 // DeploymentConfig is used to configure Deployment
 type DeploymentConfig struct {
 	ProjectName            string
+	Profile                string
 	BuildType              string
 	BuildFilePath          string
 	RemoteURL              string
@@ -91,6 +107,12 @@ type DeploymentMetadata struct {
 	ContainerID     string
 	ContainerStatus string
 	StartedAt       string
+	Branch          string
+	DeployedAt      time.Time
+
+	// Changes lists the commits shipped since the previous deployment
+	Changes          []api.Commit
+	ChangesTruncated bool
 }
 
 // NewDeployment creates a new deployment
@@ -137,14 +159,12 @@ func (d *Deployment) Initialize(cfg DeploymentConfig, out io.Writer) error {
 		return err
 	}
 
-	// Remove existing git repo if there is one
+	// Remove existing git repo if there is one - /persist data is kept, since
+	// it may have been restored or migrated for this deployment
+	d.repoMux.Lock()
+	defer d.repoMux.Unlock()
 	os.RemoveAll(filepath.Join(d.directory, ".git"))
 
-	// Remove existing /persist data
-	if d.persistDirectory != "" {
-		os.RemoveAll(d.persistDirectory)
-	}
-
 	// Initialize repository
 	d.repo, err = git.InitializeRepository(cfg.RemoteURL, git.RepoOptions{
 		Directory: d.directory,
@@ -155,27 +175,24 @@ func (d *Deployment) Initialize(cfg DeploymentConfig, out io.Writer) error {
 }
 
 // SetConfig updates the deployment's configuration. Only supports
-// ProjectName, Branch, and BuildType for now.
+// ProjectName, Profile, Branch, and BuildType for now.
 func (d *Deployment) SetConfig(cfg DeploymentConfig) {
-	if cfg.ProjectName != "" {
-		d.project = cfg.ProjectName
-	}
-	if cfg.Branch != "" {
-		d.branch = cfg.Branch
-	}
-	if cfg.BuildType != "" {
-		d.buildType = cfg.BuildType
-	}
-	if cfg.BuildFilePath != "" {
-		d.buildFilePath = cfg.BuildFilePath
-	}
-	d.intermediaryContainers = cfg.IntermediaryContainers
+	var conf = d.config().withUpdates(cfg)
+	d.project = conf.ProjectName
+	d.profile = conf.Profile
+	d.branch = conf.Branch
+	d.buildType = conf.BuildType
+	d.buildFilePath = conf.BuildFilePath
+	d.remoteURL = conf.RemoteURL
+	d.pemFilePath = conf.PemFilePath
+	d.intermediaryContainers = conf.IntermediaryContainers
 
 	// register notifiers
 	if len(d.notifiers) == 0 {
 		d.notifiers = notify.Notifiers{}
 	}
 	if cfg.SlackNotificationURL != "" {
+		d.slackNotificationURL = cfg.SlackNotificationURL
 		nt := notify.NewSlackNotifier(cfg.SlackNotificationURL)
 		if !d.notifiers.Exists(nt) {
 			d.notifiers = append(d.notifiers, nt)
@@ -183,30 +200,122 @@ func (d *Deployment) SetConfig(cfg DeploymentConfig) {
 	}
 }
 
+// config returns the deployment's current configuration
+func (d *Deployment) config() DeploymentConfig {
+	return DeploymentConfig{
+		ProjectName:            d.project,
+		Profile:                d.profile,
+		BuildType:              d.buildType,
+		BuildFilePath:          d.buildFilePath,
+		RemoteURL:              d.remoteURL,
+		Branch:                 d.branch,
+		PemFilePath:            d.pemFilePath,
+		IntermediaryContainers: d.intermediaryContainers,
+		SlackNotificationURL:   d.slackNotificationURL,
+	}
+}
+
+// withUpdates returns the configuration with the set fields of update applied
+func (c DeploymentConfig) withUpdates(update DeploymentConfig) DeploymentConfig {
+	if update.ProjectName != "" {
+		c.ProjectName = update.ProjectName
+	}
+	if update.Profile != "" {
+		c.Profile = update.Profile
+	}
+	if update.Branch != "" {
+		c.Branch = update.Branch
+	}
+	if update.BuildType != "" {
+		c.BuildType = update.BuildType
+	}
+	if update.BuildFilePath != "" {
+		c.BuildFilePath = update.BuildFilePath
+	}
+	if update.RemoteURL != "" {
+		c.RemoteURL = update.RemoteURL
+	}
+	if update.PemFilePath != "" {
+		c.PemFilePath = update.PemFilePath
+	}
+	if update.IntermediaryContainers != nil {
+		c.IntermediaryContainers = update.IntermediaryContainers
+	}
+	if update.SlackNotificationURL != "" {
+		c.SlackNotificationURL = update.SlackNotificationURL
+	}
+	return c
+}
+
+// Restore reloads the configuration saved by the last successful deployment,
+// reopens its repository, and reattaches to its containers if they are still
+// running. Returns false if there is no deployment to restore.
+func (d *Deployment) Restore(cli *docker.Client, out io.Writer) (bool, error) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	if d.dataManager == nil {
+		return false, nil
+	}
+	conf, found, err := d.dataManager.GetDeploymentConfiguration()
+	if err != nil || !found {
+		return false, err
+	}
+	repo, err := gogit.PlainOpen(d.directory)
+	if err == gogit.ErrRepositoryNotExists {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("failed to open repository: %s", err.Error())
+	}
+	d.SetConfig(*conf)
+	d.repo = repo
+
+	// Retrieve authentication
+	if d.pemFilePath != "" {
+		pemFile, err := os.Open(d.pemFilePath)
+		if err != nil {
+			return true, err
+		}
+		defer pemFile.Close()
+		if d.auth, err = crypto.GetInertiaKey(pemFile); err != nil {
+			return true, err
+		}
+	}
+
+	// Reattach to project containers that are still running
+	status, err := d.GetStatus(cli)
+	if err != nil {
+		return true, err
+	}
+	d.active = len(status.Containers) > 0
+	fmt.Fprintf(out, "Restored deployment of project '%s' at commit %s (%d active containers)\n",
+		d.project, status.CommitHash, len(status.Containers))
+	return true, nil
+}
+
 // DeployOptions is used to configure how the deployment handles the deploy
 type DeployOptions struct {
 	SkipUpdate bool
+
+	// Commit pins the deployment to a specific commit instead of the latest
+	// commit on the configured branch
+	Commit string
 }
 
-// Deploy will update, build, and deploy the project
+// Deploy will update, build, and deploy the project, and returns the commits
+// shipped by the deploy
 func (d *Deployment) Deploy(
 	cli *docker.Client,
 	out io.Writer,
 	opts DeployOptions,
-) (func() error, error) {
+) (func() error, Changelog, error) {
 	d.mux.Lock()
 	defer d.mux.Unlock()
-	fmt.Println(out, "Preparing to deploy project")
+	fmt.Fprintln(out, "Preparing to deploy project")
 
 	// Update repository
-	if !opts.SkipUpdate {
-		if err := git.UpdateRepository(d.repo, git.RepoOptions{
-			Directory: d.directory,
-			Branch:    d.branch,
-			Auth:      d.auth,
-		}, out); err != nil {
-			return func() error { return nil }, err
-		}
+	changes, err := d.updateRepository(opts, out)
+	if err != nil {
+		return func() error { return nil }, Changelog{}, err
 	}
 
 	// Clean up
@@ -214,9 +323,9 @@ func (d *Deployment) Deploy(
 
 	// Kill active project containers if there are any
 	d.active = false
-	err := d.builder.StopContainers(cli, out)
+	err = d.builder.StopContainers(cli, out)
 	if err != nil {
-		return func() error { return nil }, err
+		return func() error { return nil }, Changelog{}, err
 	}
 
 	// Get config
@@ -234,11 +343,15 @@ func (d *Deployment) Deploy(
 		}); notifyErr != nil {
 			fmt.Fprintln(out, notifyErr.Error())
 		}
-		return func() error { return nil }, err
+		return func() error { return nil }, Changelog{}, err
 	}
 
 	// Send build complete slack notification
-	if notifyErr := d.notifiers.Notify("Build completed", notify.Options{
+	var msg = "Build completed"
+	if len(changes.Commits) > 0 {
+		msg += "\n" + changes.String()
+	}
+	if notifyErr := d.notifiers.Notify(msg, notify.Options{
 		Color: notify.Green,
 	}); notifyErr != nil {
 		fmt.Fprintln(out, notifyErr.Error())
@@ -247,8 +360,53 @@ func (d *Deployment) Deploy(
 	// Deploy
 	return func() error {
 		d.active = true
-		return deploy()
-	}, nil
+		if err := deploy(); err != nil {
+			return err
+		}
+
+		// Save configuration so that the deployment can be restored if the
+		// daemon restarts
+		if d.dataManager != nil {
+			if err := d.dataManager.SetDeploymentConfiguration(d.config()); err != nil {
+				fmt.Fprintln(out, "unable to save deployment configuration: "+err.Error())
+			}
+			// Record the environment the deployment was started with, so that
+			// later changes can be planned
+			if err := d.dataManager.SetDeployedEnv(
+				newEnvDigest(conf.EnvValues, conf.ContainerEnvValues)); err != nil {
+				fmt.Fprintln(out, "unable to save deployed environment: "+err.Error())
+			}
+		}
+		return nil
+	}, changes, nil
+}
+
+// updateRepository checks out the commit to deploy, and returns the commits
+// shipped by the deploy
+func (d *Deployment) updateRepository(opts DeployOptions, out io.Writer) (Changelog, error) {
+	d.repoMux.Lock()
+	defer d.repoMux.Unlock()
+	if !opts.SkipUpdate {
+		if err := git.UpdateRepository(d.repo, git.RepoOptions{
+			Directory: d.directory,
+			Branch:    d.branch,
+			Auth:      d.auth,
+		}, out); err != nil {
+			return Changelog{}, err
+		}
+	}
+	if opts.Commit != "" {
+		if err := git.CheckoutCommit(d.repo, opts.Commit, out); err != nil {
+			return Changelog{}, err
+		}
+	}
+
+	// Find the commits shipped by this deploy
+	changes, err := d.newChangelog()
+	if err != nil {
+		fmt.Fprintln(out, "unable to list deployed commits: "+err.Error())
+	}
+	return changes, nil
 }
 
 // Down shuts down the deployment
@@ -264,7 +422,7 @@ func (d *Deployment) Down(cli *docker.Client, out io.Writer) error {
 	if err != nil {
 		killErr := d.builder.StopContainers(cli, out)
 		if killErr != nil {
-			println(err)
+			fmt.Fprintln(out, killErr.Error())
 		}
 		return err
 	}
@@ -283,7 +441,8 @@ func (d *Deployment) Prune(cli *docker.Client, out io.Writer) error {
 	return d.builder.PruneAll(cli, out)
 }
 
-// Destroy shuts down the deployment and removes the repository
+// Destroy shuts down the deployment and removes the repository and /persist
+// data
 func (d *Deployment) Destroy(cli *docker.Client, out io.Writer) error {
 	d.Down(cli, out)
 
@@ -293,6 +452,11 @@ func (d *Deployment) Destroy(cli *docker.Client, out io.Writer) error {
 	if err != nil {
 		fmt.Fprint(out, "unable to clear database records: "+err.Error())
 	}
+	if d.persistDirectory != "" {
+		if err := common.RemoveContents(d.persistDirectory); err != nil && !os.IsNotExist(err) {
+			fmt.Fprint(out, "unable to clear persistent data: "+err.Error())
+		}
+	}
 	return common.RemoveContents(d.directory)
 }
 
@@ -313,11 +477,14 @@ func (d *Deployment) GetStatus(cli *docker.Client) (api.DeploymentStatus, error)
 	}
 
 	// Get repository status
+	d.repoMux.Lock()
 	head, err := d.repo.Head()
 	if err != nil {
+		d.repoMux.Unlock()
 		return api.DeploymentStatus{Containers: activeContainers}, err
 	}
 	commit, err := d.repo.CommitObject(head.Hash())
+	d.repoMux.Unlock()
 	if err != nil {
 		return api.DeploymentStatus{Containers: activeContainers}, err
 	}
@@ -347,6 +514,16 @@ func (d *Deployment) GetStatus(cli *docker.Client) (api.DeploymentStatus, error)
 	}, nil
 }
 
+// Notify delivers a message to the deployment's configured notifiers
+func (d *Deployment) Notify(msg string, opts notify.Options) error {
+	return d.notifiers.Notify(msg, opts)
+}
+
+// GetProject returns the name of the deployed project
+func (d *Deployment) GetProject() string {
+	return d.project
+}
+
 // GetBranch returns the currently deployed branch
 func (d *Deployment) GetBranch() string {
 	return d.branch
@@ -371,55 +548,57 @@ func (d *Deployment) CompareRemotes(remoteURL string) error {
 }
 
 // UpdateContainerHistory will update container bucket with recent build's
-// metadata
-func (d *Deployment) UpdateContainerHistory(cli *docker.Client) error {
+// metadata, including the commits it shipped. Container details are omitted if
+// no project container is found.
+func (d *Deployment) UpdateContainerHistory(cli *docker.Client, changes Changelog) error {
+	if d.dataManager == nil {
+		return errors.New("no data manager set up")
+	}
 
 	// Get project hash
+	d.repoMux.Lock()
 	head, err := d.repo.Head()
+	d.repoMux.Unlock()
 	if err != nil {
 		return fmt.Errorf("failed fetching repo head when updating container history: %s", err.Error())
 	}
+	metadata := DeploymentMetadata{
+		Hash:       head.Hash().String(),
+		Branch:     d.branch,
+		DeployedAt: time.Now(),
+
+		Changes:          changes.Commits,
+		ChangesTruncated: changes.Truncated,
+	}
+
 	// Retrieve container for recently deployed project
 	ctx := context.Background()
-	var recentlyBuiltContainer types.Container
 	containers, err := cli.ContainerList(ctx, types.ContainerListOptions{})
 	if err != nil {
 		return fmt.Errorf("failure fetching list of containers: %s", err.Error())
 	}
 	for _, container := range containers {
-		if container.Names[0] == d.project {
-			recentlyBuiltContainer = container
+		if len(container.Names) == 0 || strings.TrimPrefix(container.Names[0], "/") != d.project {
+			continue
 		}
-	}
 
-	// Get container metadata
-	var containerID string
-	if len(recentlyBuiltContainer.ID) > 0 {
-		containerID = recentlyBuiltContainer.ID
-	}
-	containerJSON, err := cli.ContainerInspect(ctx, containerID)
-	if err != nil {
-		return fmt.Errorf("failure fetching container metadata: %s", err.Error())
-	}
-	containerState := containerJSON.ContainerJSONBase.State // similar to running "docker inspect {container}"
-	var containerStatus, containerStartedAtTime string
-	if containerState != nil {
-		containerStatus = containerState.Status
-		containerStartedAtTime = containerState.StartedAt
+		// Get container metadata, similar to running "docker inspect {container}"
+		containerJSON, err := cli.ContainerInspect(ctx, container.ID)
+		if err != nil {
+			return fmt.Errorf("failure fetching container metadata: %s", err.Error())
+		}
+		metadata.ContainerID = container.ID
+		if state := containerJSON.ContainerJSONBase.State; state != nil {
+			metadata.ContainerStatus = state.Status
+			metadata.StartedAt = state.StartedAt
+		}
+		break
 	}
 
-	metadata := DeploymentMetadata{
-		Hash:            head.Hash().String(),
-		ContainerID:     containerID,
-		ContainerStatus: containerStatus,
-		StartedAt:       containerStartedAtTime}
-
 	// Update db with newly built container metadata
-	err = d.dataManager.AddProjectBuildData(d.project, metadata)
-	if err != nil {
+	if err = d.dataManager.AddProjectBuildData(d.project, metadata); err != nil {
 		return fmt.Errorf("failure adding build metadata: %s", err.Error())
 	}
-
 	return nil
 }
 
@@ -441,11 +620,18 @@ func (d *Deployment) GetBuildConfiguration() (*build.Config, error) {
 		PersistDirectory: d.persistDirectory,
 	}
 	if d.dataManager != nil {
-		env, err := d.dataManager.GetEnvVariables(true)
+		env, containerEnv, err := d.dataManager.GetDeploymentEnvVariables(d.profile)
 		if err != nil {
 			return conf, err
 		}
 		conf.EnvValues = env
+		conf.ContainerEnvValues = containerEnv
+
+		secrets, err := d.dataManager.GetSecretFiles()
+		if err != nil {
+			return conf, err
+		}
+		conf.Secrets = secrets
 	} else {
 		return conf, errors.New("no data manager")
 	}
@@ -3,6 +3,7 @@ package project
 import (
 	"io"
 	"os"
+	"path"
 	"testing"
 
 	docker "github.com/docker/docker/client"
@@ -64,7 +65,7 @@ func TestDeployMock(t *testing.T) {
 	assert.NoError(t, err)
 	defer cli.Close()
 
-	deploy, err := d.Deploy(cli, os.Stdout, DeployOptions{SkipUpdate: true})
+	deploy, _, err := d.Deploy(cli, os.Stdout, DeployOptions{SkipUpdate: true})
 	assert.NoError(t, err)
 
 	deploy()
@@ -126,6 +127,47 @@ func TestGetStatusIntegration(t *testing.T) {
 	assert.Equal(t, "test", status.BuildType)
 }
 
+func TestRestoreIntegration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	dir := "./test_restore"
+	assert.NoError(t, os.Mkdir(dir, os.ModePerm))
+	defer os.RemoveAll(dir)
+	manager, err := NewDataManager(path.Join(dir, "deployment.db"), path.Join(dir, "key"))
+	assert.NoError(t, err)
+
+	cli, err := containers.NewDockerClient()
+	assert.NoError(t, err)
+	defer cli.Close()
+
+	// Traverse back down to root directory of repository
+	var deployment = &Deployment{
+		directory:   "../../../",
+		builder:     newDefaultFakeBuilder(nil, nil),
+		dataManager: manager,
+	}
+
+	// nothing to restore
+	restored, err := deployment.Restore(cli, os.Stdout)
+	assert.NoError(t, err)
+	assert.False(t, restored)
+
+	// restore saved configuration
+	assert.NoError(t, manager.SetDeploymentConfiguration(DeploymentConfig{
+		ProjectName: "wow",
+		Branch:      "master",
+		BuildType:   "test",
+	}))
+	restored, err = deployment.Restore(cli, os.Stdout)
+	assert.NoError(t, err)
+	assert.True(t, restored)
+	assert.Equal(t, "wow", deployment.project)
+	assert.Equal(t, "master", deployment.GetBranch())
+	assert.NotNil(t, deployment.repo)
+}
+
 func TestGetBranch(t *testing.T) {
 	deployment := &Deployment{branch: "master"}
 	assert.Equal(t, "master", deployment.GetBranch())
@@ -7,6 +7,7 @@ import (
 
 	"github.com/docker/docker/client"
 	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/notify"
 	"github.com/ubclaunchpad/inertia/daemon/inertiad/project"
 )
 
@@ -22,7 +23,7 @@ type FakeDeployer struct {
 	compareRemotesReturnsOnCall map[int]struct {
 		result1 error
 	}
-	DeployStub        func(*client.Client, io.Writer, project.DeployOptions) (func() error, error)
+	DeployStub        func(*client.Client, io.Writer, project.DeployOptions) (func() error, project.Changelog, error)
 	deployMutex       sync.RWMutex
 	deployArgsForCall []struct {
 		arg1 *client.Client
@@ -31,11 +32,13 @@ type FakeDeployer struct {
 	}
 	deployReturns struct {
 		result1 func() error
-		result2 error
+		result2 project.Changelog
+		result3 error
 	}
 	deployReturnsOnCall map[int]struct {
 		result1 func() error
-		result2 error
+		result2 project.Changelog
+		result3 error
 	}
 	DestroyStub        func(*client.Client, io.Writer) error
 	destroyMutex       sync.RWMutex
@@ -83,6 +86,16 @@ type FakeDeployer struct {
 		result1 *project.DeploymentDataManager
 		result2 bool
 	}
+	GetProjectStub        func() string
+	getProjectMutex       sync.RWMutex
+	getProjectArgsForCall []struct {
+	}
+	getProjectReturns struct {
+		result1 string
+	}
+	getProjectReturnsOnCall map[int]struct {
+		result1 string
+	}
 	GetStatusStub        func(*client.Client) (api.DeploymentStatus, error)
 	getStatusMutex       sync.RWMutex
 	getStatusArgsForCall []struct {
@@ -108,6 +121,33 @@ type FakeDeployer struct {
 	initializeReturnsOnCall map[int]struct {
 		result1 error
 	}
+	NotifyStub        func(string, notify.Options) error
+	notifyMutex       sync.RWMutex
+	notifyArgsForCall []struct {
+		arg1 string
+		arg2 notify.Options
+	}
+	notifyReturns struct {
+		result1 error
+	}
+	notifyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PlanStub        func(*client.Client, project.DeploymentConfig, project.DeployOptions) (api.DeploymentPlan, error)
+	planMutex       sync.RWMutex
+	planArgsForCall []struct {
+		arg1 *client.Client
+		arg2 project.DeploymentConfig
+		arg3 project.DeployOptions
+	}
+	planReturns struct {
+		result1 api.DeploymentPlan
+		result2 error
+	}
+	planReturnsOnCall map[int]struct {
+		result1 api.DeploymentPlan
+		result2 error
+	}
 	PruneStub        func(*client.Client, io.Writer) error
 	pruneMutex       sync.RWMutex
 	pruneArgsForCall []struct {
@@ -120,15 +160,30 @@ type FakeDeployer struct {
 	pruneReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RestoreStub        func(*client.Client, io.Writer) (bool, error)
+	restoreMutex       sync.RWMutex
+	restoreArgsForCall []struct {
+		arg1 *client.Client
+		arg2 io.Writer
+	}
+	restoreReturns struct {
+		result1 bool
+		result2 error
+	}
+	restoreReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	SetConfigStub        func(project.DeploymentConfig)
 	setConfigMutex       sync.RWMutex
 	setConfigArgsForCall []struct {
 		arg1 project.DeploymentConfig
 	}
-	UpdateContainerHistoryStub        func(*client.Client) error
+	UpdateContainerHistoryStub        func(*client.Client, project.Changelog) error
 	updateContainerHistoryMutex       sync.RWMutex
 	updateContainerHistoryArgsForCall []struct {
 		arg1 *client.Client
+		arg2 project.Changelog
 	}
 	updateContainerHistoryReturns struct {
 		result1 error
@@ -214,7 +269,7 @@ func (fake *FakeDeployer) CompareRemotesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeDeployer) Deploy(arg1 *client.Client, arg2 io.Writer, arg3 project.DeployOptions) (func() error, error) {
+func (fake *FakeDeployer) Deploy(arg1 *client.Client, arg2 io.Writer, arg3 project.DeployOptions) (func() error, project.Changelog, error) {
 	fake.deployMutex.Lock()
 	ret, specificReturn := fake.deployReturnsOnCall[len(fake.deployArgsForCall)]
 	fake.deployArgsForCall = append(fake.deployArgsForCall, struct {
@@ -230,9 +285,9 @@ func (fake *FakeDeployer) Deploy(arg1 *client.Client, arg2 io.Writer, arg3 proje
 		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1, ret.result2, ret.result3
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
 func (fake *FakeDeployer) DeployCallCount() int {
@@ -241,7 +296,7 @@ func (fake *FakeDeployer) DeployCallCount() int {
 	return len(fake.deployArgsForCall)
 }
 
-func (fake *FakeDeployer) DeployCalls(stub func(*client.Client, io.Writer, project.DeployOptions) (func() error, error)) {
+func (fake *FakeDeployer) DeployCalls(stub func(*client.Client, io.Writer, project.DeployOptions) (func() error, project.Changelog, error)) {
 	fake.deployMutex.Lock()
 	defer fake.deployMutex.Unlock()
 	fake.DeployStub = stub
@@ -254,30 +309,33 @@ func (fake *FakeDeployer) DeployArgsForCall(i int) (*client.Client, io.Writer, p
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeDeployer) DeployReturns(result1 func() error, result2 error) {
+func (fake *FakeDeployer) DeployReturns(result1 func() error, result2 project.Changelog, result3 error) {
 	fake.deployMutex.Lock()
 	defer fake.deployMutex.Unlock()
 	fake.DeployStub = nil
 	fake.deployReturns = struct {
 		result1 func() error
-		result2 error
-	}{result1, result2}
+		result2 project.Changelog
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeDeployer) DeployReturnsOnCall(i int, result1 func() error, result2 error) {
+func (fake *FakeDeployer) DeployReturnsOnCall(i int, result1 func() error, result2 project.Changelog, result3 error) {
 	fake.deployMutex.Lock()
 	defer fake.deployMutex.Unlock()
 	fake.DeployStub = nil
 	if fake.deployReturnsOnCall == nil {
 		fake.deployReturnsOnCall = make(map[int]struct {
 			result1 func() error
-			result2 error
+			result2 project.Changelog
+			result3 error
 		})
 	}
 	fake.deployReturnsOnCall[i] = struct {
 		result1 func() error
-		result2 error
-	}{result1, result2}
+		result2 project.Changelog
+		result3 error
+	}{result1, result2, result3}
 }
 
 func (fake *FakeDeployer) Destroy(arg1 *client.Client, arg2 io.Writer) error {
@@ -513,6 +571,59 @@ func (fake *FakeDeployer) GetDataManagerReturnsOnCall(i int, result1 *project.De
 	}{result1, result2}
 }
 
+func (fake *FakeDeployer) GetProject() string {
+	fake.getProjectMutex.Lock()
+	ret, specificReturn := fake.getProjectReturnsOnCall[len(fake.getProjectArgsForCall)]
+	fake.getProjectArgsForCall = append(fake.getProjectArgsForCall, struct {
+	}{})
+	stub := fake.GetProjectStub
+	fakeReturns := fake.getProjectReturns
+	fake.recordInvocation("GetProject", []interface{}{})
+	fake.getProjectMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDeployer) GetProjectCallCount() int {
+	fake.getProjectMutex.RLock()
+	defer fake.getProjectMutex.RUnlock()
+	return len(fake.getProjectArgsForCall)
+}
+
+func (fake *FakeDeployer) GetProjectCalls(stub func() string) {
+	fake.getProjectMutex.Lock()
+	defer fake.getProjectMutex.Unlock()
+	fake.GetProjectStub = stub
+}
+
+func (fake *FakeDeployer) GetProjectReturns(result1 string) {
+	fake.getProjectMutex.Lock()
+	defer fake.getProjectMutex.Unlock()
+	fake.GetProjectStub = nil
+	fake.getProjectReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeDeployer) GetProjectReturnsOnCall(i int, result1 string) {
+	fake.getProjectMutex.Lock()
+	defer fake.getProjectMutex.Unlock()
+	fake.GetProjectStub = nil
+	if fake.getProjectReturnsOnCall == nil {
+		fake.getProjectReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.getProjectReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeDeployer) GetStatus(arg1 *client.Client) (api.DeploymentStatus, error) {
 	fake.getStatusMutex.Lock()
 	ret, specificReturn := fake.getStatusReturnsOnCall[len(fake.getStatusArgsForCall)]
@@ -639,6 +750,134 @@ func (fake *FakeDeployer) InitializeReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeDeployer) Notify(arg1 string, arg2 notify.Options) error {
+	fake.notifyMutex.Lock()
+	ret, specificReturn := fake.notifyReturnsOnCall[len(fake.notifyArgsForCall)]
+	fake.notifyArgsForCall = append(fake.notifyArgsForCall, struct {
+		arg1 string
+		arg2 notify.Options
+	}{arg1, arg2})
+	stub := fake.NotifyStub
+	fakeReturns := fake.notifyReturns
+	fake.recordInvocation("Notify", []interface{}{arg1, arg2})
+	fake.notifyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDeployer) NotifyCallCount() int {
+	fake.notifyMutex.RLock()
+	defer fake.notifyMutex.RUnlock()
+	return len(fake.notifyArgsForCall)
+}
+
+func (fake *FakeDeployer) NotifyCalls(stub func(string, notify.Options) error) {
+	fake.notifyMutex.Lock()
+	defer fake.notifyMutex.Unlock()
+	fake.NotifyStub = stub
+}
+
+func (fake *FakeDeployer) NotifyArgsForCall(i int) (string, notify.Options) {
+	fake.notifyMutex.RLock()
+	defer fake.notifyMutex.RUnlock()
+	argsForCall := fake.notifyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDeployer) NotifyReturns(result1 error) {
+	fake.notifyMutex.Lock()
+	defer fake.notifyMutex.Unlock()
+	fake.NotifyStub = nil
+	fake.notifyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDeployer) NotifyReturnsOnCall(i int, result1 error) {
+	fake.notifyMutex.Lock()
+	defer fake.notifyMutex.Unlock()
+	fake.NotifyStub = nil
+	if fake.notifyReturnsOnCall == nil {
+		fake.notifyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.notifyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDeployer) Plan(arg1 *client.Client, arg2 project.DeploymentConfig, arg3 project.DeployOptions) (api.DeploymentPlan, error) {
+	fake.planMutex.Lock()
+	ret, specificReturn := fake.planReturnsOnCall[len(fake.planArgsForCall)]
+	fake.planArgsForCall = append(fake.planArgsForCall, struct {
+		arg1 *client.Client
+		arg2 project.DeploymentConfig
+		arg3 project.DeployOptions
+	}{arg1, arg2, arg3})
+	stub := fake.PlanStub
+	fakeReturns := fake.planReturns
+	fake.recordInvocation("Plan", []interface{}{arg1, arg2, arg3})
+	fake.planMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDeployer) PlanCallCount() int {
+	fake.planMutex.RLock()
+	defer fake.planMutex.RUnlock()
+	return len(fake.planArgsForCall)
+}
+
+func (fake *FakeDeployer) PlanCalls(stub func(*client.Client, project.DeploymentConfig, project.DeployOptions) (api.DeploymentPlan, error)) {
+	fake.planMutex.Lock()
+	defer fake.planMutex.Unlock()
+	fake.PlanStub = stub
+}
+
+func (fake *FakeDeployer) PlanArgsForCall(i int) (*client.Client, project.DeploymentConfig, project.DeployOptions) {
+	fake.planMutex.RLock()
+	defer fake.planMutex.RUnlock()
+	argsForCall := fake.planArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDeployer) PlanReturns(result1 api.DeploymentPlan, result2 error) {
+	fake.planMutex.Lock()
+	defer fake.planMutex.Unlock()
+	fake.PlanStub = nil
+	fake.planReturns = struct {
+		result1 api.DeploymentPlan
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeployer) PlanReturnsOnCall(i int, result1 api.DeploymentPlan, result2 error) {
+	fake.planMutex.Lock()
+	defer fake.planMutex.Unlock()
+	fake.PlanStub = nil
+	if fake.planReturnsOnCall == nil {
+		fake.planReturnsOnCall = make(map[int]struct {
+			result1 api.DeploymentPlan
+			result2 error
+		})
+	}
+	fake.planReturnsOnCall[i] = struct {
+		result1 api.DeploymentPlan
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDeployer) Prune(arg1 *client.Client, arg2 io.Writer) error {
 	fake.pruneMutex.Lock()
 	ret, specificReturn := fake.pruneReturnsOnCall[len(fake.pruneArgsForCall)]
@@ -701,6 +940,71 @@ func (fake *FakeDeployer) PruneReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeDeployer) Restore(arg1 *client.Client, arg2 io.Writer) (bool, error) {
+	fake.restoreMutex.Lock()
+	ret, specificReturn := fake.restoreReturnsOnCall[len(fake.restoreArgsForCall)]
+	fake.restoreArgsForCall = append(fake.restoreArgsForCall, struct {
+		arg1 *client.Client
+		arg2 io.Writer
+	}{arg1, arg2})
+	stub := fake.RestoreStub
+	fakeReturns := fake.restoreReturns
+	fake.recordInvocation("Restore", []interface{}{arg1, arg2})
+	fake.restoreMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDeployer) RestoreCallCount() int {
+	fake.restoreMutex.RLock()
+	defer fake.restoreMutex.RUnlock()
+	return len(fake.restoreArgsForCall)
+}
+
+func (fake *FakeDeployer) RestoreCalls(stub func(*client.Client, io.Writer) (bool, error)) {
+	fake.restoreMutex.Lock()
+	defer fake.restoreMutex.Unlock()
+	fake.RestoreStub = stub
+}
+
+func (fake *FakeDeployer) RestoreArgsForCall(i int) (*client.Client, io.Writer) {
+	fake.restoreMutex.RLock()
+	defer fake.restoreMutex.RUnlock()
+	argsForCall := fake.restoreArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDeployer) RestoreReturns(result1 bool, result2 error) {
+	fake.restoreMutex.Lock()
+	defer fake.restoreMutex.Unlock()
+	fake.RestoreStub = nil
+	fake.restoreReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeployer) RestoreReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.restoreMutex.Lock()
+	defer fake.restoreMutex.Unlock()
+	fake.RestoreStub = nil
+	if fake.restoreReturnsOnCall == nil {
+		fake.restoreReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.restoreReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDeployer) SetConfig(arg1 project.DeploymentConfig) {
 	fake.setConfigMutex.Lock()
 	fake.setConfigArgsForCall = append(fake.setConfigArgsForCall, struct {
@@ -733,18 +1037,19 @@ func (fake *FakeDeployer) SetConfigArgsForCall(i int) project.DeploymentConfig {
 	return argsForCall.arg1
 }
 
-func (fake *FakeDeployer) UpdateContainerHistory(arg1 *client.Client) error {
+func (fake *FakeDeployer) UpdateContainerHistory(arg1 *client.Client, arg2 project.Changelog) error {
 	fake.updateContainerHistoryMutex.Lock()
 	ret, specificReturn := fake.updateContainerHistoryReturnsOnCall[len(fake.updateContainerHistoryArgsForCall)]
 	fake.updateContainerHistoryArgsForCall = append(fake.updateContainerHistoryArgsForCall, struct {
 		arg1 *client.Client
-	}{arg1})
+		arg2 project.Changelog
+	}{arg1, arg2})
 	stub := fake.UpdateContainerHistoryStub
 	fakeReturns := fake.updateContainerHistoryReturns
-	fake.recordInvocation("UpdateContainerHistory", []interface{}{arg1})
+	fake.recordInvocation("UpdateContainerHistory", []interface{}{arg1, arg2})
 	fake.updateContainerHistoryMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
@@ -758,17 +1063,17 @@ func (fake *FakeDeployer) UpdateContainerHistoryCallCount() int {
 	return len(fake.updateContainerHistoryArgsForCall)
 }
 
-func (fake *FakeDeployer) UpdateContainerHistoryCalls(stub func(*client.Client) error) {
+func (fake *FakeDeployer) UpdateContainerHistoryCalls(stub func(*client.Client, project.Changelog) error) {
 	fake.updateContainerHistoryMutex.Lock()
 	defer fake.updateContainerHistoryMutex.Unlock()
 	fake.UpdateContainerHistoryStub = stub
 }
 
-func (fake *FakeDeployer) UpdateContainerHistoryArgsForCall(i int) *client.Client {
+func (fake *FakeDeployer) UpdateContainerHistoryArgsForCall(i int) (*client.Client, project.Changelog) {
 	fake.updateContainerHistoryMutex.RLock()
 	defer fake.updateContainerHistoryMutex.RUnlock()
 	argsForCall := fake.updateContainerHistoryArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeDeployer) UpdateContainerHistoryReturns(result1 error) {
@@ -873,12 +1178,20 @@ func (fake *FakeDeployer) Invocations() map[string][][]interface{} {
 	defer fake.getBranchMutex.RUnlock()
 	fake.getDataManagerMutex.RLock()
 	defer fake.getDataManagerMutex.RUnlock()
+	fake.getProjectMutex.RLock()
+	defer fake.getProjectMutex.RUnlock()
 	fake.getStatusMutex.RLock()
 	defer fake.getStatusMutex.RUnlock()
 	fake.initializeMutex.RLock()
 	defer fake.initializeMutex.RUnlock()
+	fake.notifyMutex.RLock()
+	defer fake.notifyMutex.RUnlock()
+	fake.planMutex.RLock()
+	defer fake.planMutex.RUnlock()
 	fake.pruneMutex.RLock()
 	defer fake.pruneMutex.RUnlock()
+	fake.restoreMutex.RLock()
+	defer fake.restoreMutex.RUnlock()
 	fake.setConfigMutex.RLock()
 	defer fake.setConfigMutex.RUnlock()
 	fake.updateContainerHistoryMutex.RLock()
@@ -4,4 +4,44 @@ type envVariable struct {
 	Name      string
 	Value     []byte
 	Encrypted bool
+
+	// Profile and Container restrict where this variable is applied - if unset,
+	// the variable applies to all profiles and containers respectively
+	Profile   string `json:",omitempty"`
+	Container string `json:",omitempty"`
+}
+
+// scope returns the scope this variable applies to
+func (v envVariable) scope() EnvScope {
+	return EnvScope{Profile: v.Profile, Container: v.Container}
+}
+
+// EnvScope restricts environment variables to deployments with a specific
+// profile and/or to a specific container or docker-compose service
+type EnvScope struct {
+	Profile   string
+	Container string
+}
+
+// key generates the database key for the named variable in this scope.
+// Unscoped variables are keyed by name alone.
+func (s EnvScope) key(name string) []byte {
+	if s.Profile == "" && s.Container == "" {
+		return []byte(name)
+	}
+	return []byte(name + "\x00" + s.Profile + "\x00" + s.Container)
+}
+
+// String returns a human-readable description of this scope
+func (s EnvScope) String() string {
+	switch {
+	case s.Profile != "" && s.Container != "":
+		return "profile: " + s.Profile + ", container: " + s.Container
+	case s.Profile != "":
+		return "profile: " + s.Profile
+	case s.Container != "":
+		return "container: " + s.Container
+	default:
+		return ""
+	}
 }
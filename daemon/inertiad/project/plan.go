@@ -0,0 +1,267 @@
+package project
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	docker "github.com/docker/docker/client"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport/ssh"
+
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/crypto"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/git"
+)
+
+// maxPlanCommits is the most commits listed in a deployment plan
+const maxPlanCommits = 50
+
+// Plan describes what deploying the project with the given configuration and
+// options would change, without changing the deployment. The latest commit of
+// the configured branch is fetched, but not checked out.
+func (d *Deployment) Plan(
+	cli *docker.Client,
+	cfg DeploymentConfig,
+	opts DeployOptions,
+) (api.DeploymentPlan, error) {
+	var plan = api.DeploymentPlan{
+		Commits:    []api.Commit{},
+		Env:        []api.EnvChange{},
+		Containers: []string{},
+	}
+
+	// Find the commit that would be deployed. This requires network access, so
+	// only the repository is locked while doing so, to avoid blocking deploys.
+	d.mux.Lock()
+	var (
+		repo    = d.repo
+		auth    = d.auth
+		planned = d.config().withUpdates(cfg)
+	)
+	d.mux.Unlock()
+	var latest *object.Commit
+	if repo == nil {
+		// Nothing has been deployed, so the repository has not been cloned yet -
+		// look up the target commit without cloning it
+		if opts.Commit != "" {
+			plan.Target = api.Commit{Hash: opts.Commit}
+		} else {
+			auth, err := d.planAuth(planned.PemFilePath)
+			if err != nil {
+				return plan, err
+			}
+			hash, err := git.ResolveRemoteBranch(planned.RemoteURL, git.RepoOptions{
+				Branch: planned.Branch,
+				Auth:   auth,
+			})
+			if err != nil {
+				return plan, err
+			}
+			plan.Target = api.Commit{Hash: hash.String()}
+		}
+	} else {
+		d.repoMux.Lock()
+		var err error
+		latest, err = git.FetchBranch(repo, git.RepoOptions{
+			Branch: planned.Branch,
+			Auth:   auth,
+		}, ioutil.Discard)
+		d.repoMux.Unlock()
+		if err != nil {
+			return plan, err
+		}
+	}
+
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	if d.repo != repo {
+		return plan, errors.New("the deployment changed while planning - try again")
+	}
+
+	var current DeploymentConfig
+	if d.repo != nil {
+		current = d.config()
+		if err := d.planCommits(&plan, latest, opts.Commit); err != nil {
+			return plan, err
+		}
+
+		// Every active container is stopped and rebuilt
+		status, err := d.GetStatus(cli)
+		if err != nil {
+			return plan, err
+		}
+		plan.Containers = status.Containers
+	}
+
+	plan.Settings = planSettings(current, planned)
+
+	// Compare environment variables to the ones the deployment was started with
+	if d.dataManager != nil {
+		shared, containers, err := d.dataManager.GetDeploymentEnvVariables(planned.Profile)
+		if err != nil {
+			return plan, err
+		}
+		previous, found, err := d.dataManager.GetDeployedEnv()
+		if err != nil {
+			return plan, err
+		}
+		if found || d.repo == nil {
+			plan.Env = previous.diff(newEnvDigest(shared, containers))
+		}
+	}
+
+	return plan, nil
+}
+
+// planCommits fills in the current and target commits of the plan, and the
+// commits between them. The target is the given commit if set, or latest.
+func (d *Deployment) planCommits(plan *api.DeploymentPlan, latest *object.Commit, commit string) error {
+	d.repoMux.Lock()
+	defer d.repoMux.Unlock()
+	head, err := d.repo.Head()
+	if err != nil {
+		return err
+	}
+	deployed, err := d.repo.CommitObject(head.Hash())
+	if err != nil {
+		return err
+	}
+	var c = newCommit(deployed)
+	plan.Current = &c
+
+	var target = latest
+	if commit != "" {
+		hash, err := d.repo.ResolveRevision(plumbing.Revision(commit))
+		if err != nil {
+			return fmt.Errorf("could not find commit '%s': %s", commit, err.Error())
+		}
+		if target, err = d.repo.CommitObject(*hash); err != nil {
+			return err
+		}
+	}
+	plan.Target = newCommit(target)
+
+	commits, truncated, err := git.CommitRange(d.repo, deployed.Hash, target.Hash, maxPlanCommits)
+	if err != nil {
+		return err
+	}
+	for _, c := range commits {
+		plan.Commits = append(plan.Commits, newCommit(c))
+	}
+	plan.CommitsTruncated = truncated
+	return nil
+}
+
+// planAuth loads the key used to access the project repository
+func (d *Deployment) planAuth(pemFilePath string) (ssh.AuthMethod, error) {
+	pemFile, err := os.Open(pemFilePath)
+	if err != nil {
+		return nil, err
+	}
+	defer pemFile.Close()
+	return crypto.GetInertiaKey(pemFile)
+}
+
+// newCommit summarizes the given commit
+func newCommit(c *object.Commit) api.Commit {
+	var message = strings.TrimSpace(c.Message)
+	if i := strings.Index(message, "\n"); i >= 0 {
+		message = message[:i]
+	}
+	return api.Commit{
+		Hash:    c.Hash.String(),
+		Author:  c.Author.Name,
+		Message: message,
+		Time:    c.Author.When,
+	}
+}
+
+// planSettings compares the settings of the given configurations
+func planSettings(current, planned DeploymentConfig) []api.PlanSetting {
+	var notifications = func(url string) string {
+		if url == "" {
+			return "disabled"
+		}
+		return "enabled"
+	}
+	return []api.PlanSetting{
+		{Name: "profile", Current: current.Profile, Planned: planned.Profile},
+		{Name: "branch", Current: current.Branch, Planned: planned.Branch},
+		{Name: "build type", Current: current.BuildType, Planned: planned.BuildType},
+		{Name: "build file", Current: current.BuildFilePath, Planned: planned.BuildFilePath},
+		{
+			Name:    "intermediary containers",
+			Current: strings.Join(current.IntermediaryContainers, ", "),
+			Planned: strings.Join(planned.IntermediaryContainers, ", "),
+		},
+		{
+			Name:    "slack notifications",
+			Current: notifications(current.SlackNotificationURL),
+			Planned: notifications(planned.SlackNotificationURL),
+		},
+	}
+}
+
+// envDigest records digests of the values of environment variables a
+// deployment was started with, keyed by container and then by variable name.
+// Variables shared by all containers are keyed by an empty container name.
+type envDigest map[string]map[string]string
+
+// newEnvDigest creates a digest of the given variables, in the form returned by
+// GetDeploymentEnvVariables
+func newEnvDigest(shared []string, containers map[string][]string) envDigest {
+	var add = func(digest map[string]string, envs []string) {
+		for _, env := range envs {
+			var kv = strings.SplitN(env, "=", 2)
+			var value string
+			if len(kv) > 1 {
+				value = kv[1]
+			}
+			var sum = sha256.Sum256([]byte(value))
+			digest[kv[0]] = hex.EncodeToString(sum[:])
+		}
+	}
+	var digest = envDigest{"": {}}
+	add(digest[""], shared)
+	for container, envs := range containers {
+		digest[container] = map[string]string{}
+		add(digest[container], envs)
+	}
+	return digest
+}
+
+// diff lists the changes from this digest to the given one, sorted by
+// container and then by name
+func (e envDigest) diff(next envDigest) []api.EnvChange {
+	var changes = []api.EnvChange{}
+	for container, vars := range next {
+		for name, sum := range vars {
+			if previous, ok := e[container][name]; !ok {
+				changes = append(changes, api.EnvChange{Name: name, Container: container, Change: api.EnvAdded})
+			} else if previous != sum {
+				changes = append(changes, api.EnvChange{Name: name, Container: container, Change: api.EnvChanged})
+			}
+		}
+	}
+	for container, vars := range e {
+		for name := range vars {
+			if _, ok := next[container][name]; !ok {
+				changes = append(changes, api.EnvChange{Name: name, Container: container, Change: api.EnvRemoved})
+			}
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Container != changes[j].Container {
+			return changes[i].Container < changes[j].Container
+		}
+		return changes[i].Name < changes[j].Name
+	})
+	return changes
+}
@@ -0,0 +1,107 @@
+package project
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ubclaunchpad/inertia/api"
+)
+
+func TestPlanNotDeployed(t *testing.T) {
+	dir := "./test_plan"
+	assert.NoError(t, os.Mkdir(dir, os.ModePerm))
+	defer os.RemoveAll(dir)
+
+	c, err := NewDataManager(path.Join(dir, "deployment.db"), path.Join(dir, "key"))
+	assert.NoError(t, err)
+	assert.NoError(t, c.AddEnvVariable("PORT", "8080", false))
+	var d = &Deployment{dataManager: c}
+
+	plan, err := d.Plan(nil, DeploymentConfig{
+		Profile:   "default",
+		Branch:    "master",
+		BuildType: "docker-compose",
+	}, DeployOptions{Commit: "1234567"})
+	assert.NoError(t, err)
+	assert.Nil(t, plan.Current)
+	assert.Equal(t, "1234567", plan.Target.Hash)
+	assert.Empty(t, plan.Commits)
+	assert.Empty(t, plan.Containers)
+	assert.Contains(t, plan.Settings, api.PlanSetting{Name: "branch", Current: "", Planned: "master"})
+	assert.Equal(t, []api.EnvChange{{Name: "PORT", Change: api.EnvAdded}}, plan.Env)
+
+	// planning should not change the deployment
+	assert.Equal(t, "", d.GetBranch())
+}
+
+func TestPlanSettings(t *testing.T) {
+	var current = DeploymentConfig{
+		Profile:                "default",
+		Branch:                 "master",
+		BuildType:              "docker-compose",
+		BuildFilePath:          "docker-compose.yml",
+		IntermediaryContainers: []string{"migrate"},
+		SlackNotificationURL:   "https://hooks.slack.com/services/sekret",
+	}
+	var planned = current.withUpdates(DeploymentConfig{Branch: "dev"})
+	var changed = map[string]bool{}
+	for _, s := range planSettings(current, planned) {
+		changed[s.Name] = s.Changed()
+		assert.NotContains(t, s.Current, "sekret")
+	}
+	assert.Equal(t, map[string]bool{
+		"profile":                 false,
+		"branch":                  true,
+		"build type":              false,
+		"build file":              false,
+		"intermediary containers": false,
+		"slack notifications":     false,
+	}, changed)
+}
+
+func TestEnvDigest(t *testing.T) {
+	var previous = newEnvDigest(
+		[]string{"PORT=8080", "DEBUG=true", "EMPTY="},
+		map[string][]string{"web": {"TOKEN=abc"}})
+	assert.NotContains(t, previous[""]["PORT"], "8080")
+
+	var next = newEnvDigest(
+		[]string{"PORT=8080", "DEBUG=false", "EMPTY=", "NEW=1"},
+		map[string][]string{"db": {"TOKEN=abc"}})
+	assert.Equal(t, []api.EnvChange{
+		{Name: "DEBUG", Change: api.EnvChanged},
+		{Name: "NEW", Change: api.EnvAdded},
+		{Name: "TOKEN", Container: "db", Change: api.EnvAdded},
+		{Name: "TOKEN", Container: "web", Change: api.EnvRemoved},
+	}, previous.diff(next))
+	assert.Empty(t, next.diff(next))
+}
+
+func TestDataManager_DeployedEnv(t *testing.T) {
+	dir := "./test_deployed_env"
+	assert.NoError(t, os.Mkdir(dir, os.ModePerm))
+	defer os.RemoveAll(dir)
+
+	c, err := NewDataManager(path.Join(dir, "deployment.db"), path.Join(dir, "key"))
+	assert.NoError(t, err)
+
+	_, found, err := c.GetDeployedEnv()
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	var digest = newEnvDigest([]string{"PORT=8080"}, nil)
+	assert.NoError(t, c.SetDeployedEnv(digest))
+	stored, found, err := c.GetDeployedEnv()
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, digest, stored)
+
+	// should be removed on project reset
+	assert.NoError(t, c.destroy())
+	_, found, err = c.GetDeployedEnv()
+	assert.NoError(t, err)
+	assert.False(t, found)
+}
@@ -0,0 +1,96 @@
+package project
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/crypto"
+	bolt "go.etcd.io/bbolt"
+)
+
+// validateSecretName checks that the given name can be safely used as a file
+// name when secrets are written to disk
+func validateSecretName(name string) error {
+	if name == "" || name == "." || name == ".." ||
+		strings.ContainsAny(name, "/\\\x00") {
+		return fmt.Errorf("invalid secret name '%s'", name)
+	}
+	return nil
+}
+
+// AddSecret stores a secret, which will be mounted as a read-only file in
+// project containers. Secrets are always encrypted.
+func (c *DeploymentDataManager) AddSecret(name string, value []byte) error {
+	if err := validateSecretName(name); err != nil {
+		return err
+	}
+	if len(value) == 0 {
+		return errors.New("no secret value provided")
+	}
+
+	encrypted, err := crypto.Encrypt(c.symmetricKey, value)
+	if err != nil {
+		return err
+	}
+	return c.db.Update(func(tx *bolt.Tx) error {
+		bytes, err := json.Marshal(envVariable{
+			Name:      name,
+			Value:     encrypted,
+			Encrypted: true,
+		})
+		if err != nil {
+			return err
+		}
+		return tx.Bucket(secretBucket).Put([]byte(name), bytes)
+	})
+}
+
+// RemoveSecrets removes previously set secrets
+func (c *DeploymentDataManager) RemoveSecrets(names ...string) error {
+	return c.db.Update(func(tx *bolt.Tx) error {
+		var secrets = tx.Bucket(secretBucket)
+		for _, n := range names {
+			if err := secrets.Delete([]byte(n)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetSecrets retrieves the names of all stored secrets
+func (c *DeploymentDataManager) GetSecrets() ([]string, error) {
+	var names = []string{}
+	err := c.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(secretBucket).ForEach(func(name, _ []byte) error {
+			names = append(names, string(name))
+			return nil
+		})
+	})
+	sort.Strings(names)
+	return names, err
+}
+
+// GetSecretFiles retrieves the decrypted contents of all stored secrets, keyed
+// by secret name
+func (c *DeploymentDataManager) GetSecretFiles() (map[string][]byte, error) {
+	var files = map[string][]byte{}
+	err := c.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(secretBucket).ForEach(func(name, secretBytes []byte) error {
+			var secret = envVariable{}
+			if err := json.Unmarshal(secretBytes, &secret); err != nil {
+				return err
+			}
+			decrypted, err := crypto.Decrypt(c.symmetricKey, secret.Value)
+			if err != nil {
+				return fmt.Errorf("failed to decrypt secret '%s': %s", string(name), err.Error())
+			}
+			files[string(name)] = decrypted
+			return nil
+		})
+	})
+	return files, err
+}
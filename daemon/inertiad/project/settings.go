@@ -0,0 +1,174 @@
+package project
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ubclaunchpad/inertia/api"
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/crypto"
+	bolt "go.etcd.io/bbolt"
+)
+
+// Keys of settings in the settings bucket
+const (
+	settingBackup        = "backup"
+	settingDeployedEnv   = "deployedenv"
+	settingDeployment    = "deployment"
+	settingDisk          = "disk"
+	settingLogSinks      = "logsinks"
+	settingMetrics       = "metrics"
+	settingWebhookSecret = "webhooksecret"
+)
+
+// SetWebhookSecret stores the secret used to verify webhooks
+func (c *DeploymentDataManager) SetWebhookSecret(secret string) error {
+	return c.setSetting(settingWebhookSecret, secret)
+}
+
+// GetWebhookSecret retrieves the stored webhook secret, if there is one
+func (c *DeploymentDataManager) GetWebhookSecret() (string, bool, error) {
+	var secret string
+	found, err := c.getSetting(settingWebhookSecret, &secret)
+	return secret, found, err
+}
+
+// SetMetricsConfiguration stores metrics configuration
+func (c *DeploymentDataManager) SetMetricsConfiguration(conf api.MetricsConfiguration) error {
+	return c.setSetting(settingMetrics, conf)
+}
+
+// GetMetricsConfiguration retrieves stored metrics configuration, if any
+func (c *DeploymentDataManager) GetMetricsConfiguration() (*api.MetricsConfiguration, bool, error) {
+	var conf api.MetricsConfiguration
+	found, err := c.getSetting(settingMetrics, &conf)
+	if err != nil || !found {
+		return nil, found, err
+	}
+	return &conf, true, nil
+}
+
+// SetDiskConfiguration stores disk monitoring configuration
+func (c *DeploymentDataManager) SetDiskConfiguration(conf api.DiskConfiguration) error {
+	return c.setSetting(settingDisk, conf)
+}
+
+// GetDiskConfiguration retrieves stored disk monitoring configuration, if any
+func (c *DeploymentDataManager) GetDiskConfiguration() (*api.DiskConfiguration, bool, error) {
+	var conf api.DiskConfiguration
+	found, err := c.getSetting(settingDisk, &conf)
+	if err != nil || !found {
+		return nil, found, err
+	}
+	return &conf, true, nil
+}
+
+// SetLogSinkConfiguration stores the log sinks of the active deployment
+func (c *DeploymentDataManager) SetLogSinkConfiguration(conf api.LogSinkConfiguration) error {
+	return c.setSetting(settingLogSinks, conf)
+}
+
+// GetLogSinkConfiguration retrieves stored log sink configuration, if any
+func (c *DeploymentDataManager) GetLogSinkConfiguration() (*api.LogSinkConfiguration, bool, error) {
+	var conf api.LogSinkConfiguration
+	found, err := c.getSetting(settingLogSinks, &conf)
+	if err != nil || !found {
+		return nil, found, err
+	}
+	return &conf, true, nil
+}
+
+// SetDeploymentConfiguration stores the configuration of the active deployment
+func (c *DeploymentDataManager) SetDeploymentConfiguration(conf DeploymentConfig) error {
+	return c.setSetting(settingDeployment, conf)
+}
+
+// GetDeploymentConfiguration retrieves stored deployment configuration, if any
+func (c *DeploymentDataManager) GetDeploymentConfiguration() (*DeploymentConfig, bool, error) {
+	var conf DeploymentConfig
+	found, err := c.getSetting(settingDeployment, &conf)
+	if err != nil || !found {
+		return nil, found, err
+	}
+	return &conf, true, nil
+}
+
+// SetDeployedEnv stores a digest of the environment the deployment started with
+func (c *DeploymentDataManager) SetDeployedEnv(digest envDigest) error {
+	return c.setSetting(settingDeployedEnv, digest)
+}
+
+// GetDeployedEnv retrieves the digest stored by SetDeployedEnv, if there is one
+func (c *DeploymentDataManager) GetDeployedEnv() (envDigest, bool, error) {
+	var digest envDigest
+	found, err := c.getSetting(settingDeployedEnv, &digest)
+	if err != nil || !found {
+		return nil, found, err
+	}
+	return digest, true, nil
+}
+
+// SetBackupConfiguration stores backup configuration
+func (c *DeploymentDataManager) SetBackupConfiguration(conf api.BackupConfiguration) error {
+	return c.setSetting(settingBackup, conf)
+}
+
+// GetBackupConfiguration retrieves stored backup configuration, if there is any
+func (c *DeploymentDataManager) GetBackupConfiguration() (*api.BackupConfiguration, bool, error) {
+	var conf api.BackupConfiguration
+	found, err := c.getSetting(settingBackup, &conf)
+	if err != nil || !found {
+		return nil, found, err
+	}
+	return &conf, true, nil
+}
+
+// setSetting encrypts and stores the given value. Settings are always
+// encrypted, since many, such as backup and log sink configuration, may contain
+// credentials.
+func (c *DeploymentDataManager) setSetting(name string, value interface{}) error {
+	bytes, err := c.encodeSetting(name, value)
+	if err != nil {
+		return err
+	}
+	return c.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(settingsBucket).Put([]byte(name), bytes)
+	})
+}
+
+// encodeSetting encrypts the given value for storage as the named setting
+func (c *DeploymentDataManager) encodeSetting(name string, value interface{}) ([]byte, error) {
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	encrypted, err := crypto.Encrypt(c.symmetricKey, valueBytes)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(envVariable{
+		Name:      name,
+		Value:     encrypted,
+		Encrypted: true,
+	})
+}
+
+// getSetting decrypts the named setting into value, and returns false if the
+// setting has not been set
+func (c *DeploymentDataManager) getSetting(name string, value interface{}) (bool, error) {
+	var setting *envVariable
+	if err := c.db.View(func(tx *bolt.Tx) error {
+		var bytes = tx.Bucket(settingsBucket).Get([]byte(name))
+		if bytes == nil {
+			return nil
+		}
+		setting = &envVariable{}
+		return json.Unmarshal(bytes, setting)
+	}); err != nil || setting == nil {
+		return false, err
+	}
+	decrypted, err := crypto.Decrypt(c.symmetricKey, setting.Value)
+	if err != nil {
+		return false, fmt.Errorf("failed to decrypt setting '%s': %s", name, err.Error())
+	}
+	return true, json.Unmarshal(decrypted, value)
+}
@@ -0,0 +1,141 @@
+package project
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ubclaunchpad/inertia/api"
+)
+
+func TestDataManager_BackupConfiguration(t *testing.T) {
+	dir := "./test_config"
+	assert.NoError(t, os.Mkdir(dir, os.ModePerm))
+	defer os.RemoveAll(dir)
+
+	c, err := NewDataManager(path.Join(dir, "deployment.db"), path.Join(dir, "key"))
+	assert.NoError(t, err)
+
+	// nothing set
+	_, found, err := c.GetBackupConfiguration()
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	// set and retrieve
+	var conf = api.BackupConfiguration{
+		Storage:    api.BackupStorageS3,
+		S3:         &api.S3Configuration{Bucket: "backups", SecretAccessKey: "sekret"},
+		Passphrase: "hunter2",
+		Keep:       7,
+	}
+	assert.NoError(t, c.SetBackupConfiguration(conf))
+	stored, found, err := c.GetBackupConfiguration()
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, conf, *stored)
+
+	// should survive key rotation and project reset
+	assert.NoError(t, c.RotateKey())
+	assert.NoError(t, c.destroy())
+	stored, found, err = c.GetBackupConfiguration()
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, conf, *stored)
+}
+
+func TestDataManager_DeploymentConfiguration(t *testing.T) {
+	dir := "./test_config"
+	assert.NoError(t, os.Mkdir(dir, os.ModePerm))
+	defer os.RemoveAll(dir)
+
+	c, err := NewDataManager(path.Join(dir, "deployment.db"), path.Join(dir, "key"))
+	assert.NoError(t, err)
+
+	// nothing set
+	_, found, err := c.GetDeploymentConfiguration()
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	// set and retrieve
+	var conf = DeploymentConfig{
+		ProjectName:            "wow",
+		Profile:                "production",
+		BuildType:              "docker-compose",
+		RemoteURL:              "git@github.com:ubclaunchpad/inertia.git",
+		Branch:                 "master",
+		IntermediaryContainers: []string{"migrations"},
+	}
+	assert.NoError(t, c.SetDeploymentConfiguration(conf))
+	stored, found, err := c.GetDeploymentConfiguration()
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, conf, *stored)
+
+	// should be removed on project reset
+	assert.NoError(t, c.destroy())
+	_, found, err = c.GetDeploymentConfiguration()
+	assert.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestDataManager_DiskConfiguration(t *testing.T) {
+	dir := "./test_config"
+	assert.NoError(t, os.Mkdir(dir, os.ModePerm))
+	defer os.RemoveAll(dir)
+
+	c, err := NewDataManager(path.Join(dir, "deployment.db"), path.Join(dir, "key"))
+	assert.NoError(t, err)
+
+	// nothing set
+	_, found, err := c.GetDiskConfiguration()
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	// set and retrieve
+	var conf = api.DiskConfiguration{MinimumFree: "5GiB"}
+	assert.NoError(t, c.SetDiskConfiguration(conf))
+
+	// should survive project reset
+	assert.NoError(t, c.destroy())
+	stored, found, err := c.GetDiskConfiguration()
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, conf, *stored)
+}
+
+func TestDataManager_LogSinkConfiguration(t *testing.T) {
+	dir := "./test_config"
+	assert.NoError(t, os.Mkdir(dir, os.ModePerm))
+	defer os.RemoveAll(dir)
+
+	c, err := NewDataManager(path.Join(dir, "deployment.db"), path.Join(dir, "key"))
+	assert.NoError(t, err)
+
+	// nothing set
+	_, found, err := c.GetLogSinkConfiguration()
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	// set and retrieve
+	var conf = api.LogSinkConfiguration{
+		Labels: map[string]string{"project": "myproject"},
+		Sinks: []api.LogSink{{
+			Type:    api.LogSinkLoki,
+			URL:     "https://logs.example.com",
+			Headers: map[string]string{"Authorization": "Bearer abcde"},
+		}},
+	}
+	assert.NoError(t, c.SetLogSinkConfiguration(conf))
+	stored, found, err := c.GetLogSinkConfiguration()
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, conf, *stored)
+
+	// should be removed on project reset
+	assert.NoError(t, c.destroy())
+	_, found, err = c.GetLogSinkConfiguration()
+	assert.NoError(t, err)
+	assert.False(t, found)
+}
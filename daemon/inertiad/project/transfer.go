@@ -0,0 +1,168 @@
+package project
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/crypto"
+	bolt "go.etcd.io/bbolt"
+)
+
+// transferBuckets are the buckets included in data exports - each stores
+// envVariable values
+var transferBuckets = [][]byte{envVariableBucket, secretBucket, settingsBucket}
+
+// ExportData writes all environment variables, secrets, and settings to w with
+// their values decrypted, so that they can be loaded into another deployment
+// using Import. The output is sensitive, and should be encrypted before it
+// leaves the daemon.
+func (c *DeploymentDataManager) ExportData(w io.Writer) error {
+	var exported = make(map[string][]envVariable, len(transferBuckets))
+	if err := c.db.View(func(tx *bolt.Tx) error {
+		for _, bucket := range transferBuckets {
+			var values = []envVariable{}
+			if err := tx.Bucket(bucket).ForEach(func(key, valueBytes []byte) error {
+				var value envVariable
+				if err := json.Unmarshal(valueBytes, &value); err != nil {
+					return err
+				}
+				if value.Name == "" {
+					value.Name = string(key)
+				}
+				if value.Encrypted {
+					decrypted, err := crypto.Decrypt(c.symmetricKey, value.Value)
+					if err != nil {
+						return fmt.Errorf("failed to decrypt '%s': %s", value.Name, err.Error())
+					}
+					value.Value = decrypted
+				}
+				values = append(values, value)
+				return nil
+			}); err != nil {
+				return err
+			}
+			exported[string(bucket)] = values
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	return json.NewEncoder(w).Encode(exported)
+}
+
+// ExportHistory writes the deployment history of all projects to w, so that it
+// can be loaded into another deployment using Import
+func (c *DeploymentDataManager) ExportHistory(w io.Writer) error {
+	var projects []string
+	if err := c.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(deployedProjectsBucket).ForEach(func(name, _ []byte) error {
+			projects = append(projects, string(name))
+			return nil
+		})
+	}); err != nil {
+		return err
+	}
+	var exported = make(map[string][]DeploymentMetadata, len(projects))
+	for _, project := range projects {
+		builds, err := c.GetProjectBuildData(project)
+		if err != nil {
+			return err
+		}
+		exported[project] = builds
+	}
+	return json.NewEncoder(w).Encode(exported)
+}
+
+// Import loads data written by ExportData and deployment history written by
+// ExportHistory, either of which may be nil, and stores the given webhook
+// secret if it is not empty. Existing values with the same name are
+// overwritten, and values that were encrypted when exported are encrypted with
+// this deployment's key. Everything is written in a single transaction, so
+// nothing is imported if any of it is invalid.
+func (c *DeploymentDataManager) Import(data, history io.Reader, webhookSecret string) error {
+	var (
+		values map[string][]envVariable
+		builds map[string][]DeploymentMetadata
+		secret []byte
+	)
+	if data != nil {
+		if err := json.NewDecoder(data).Decode(&values); err != nil {
+			return fmt.Errorf("failed to decode data: %s", err.Error())
+		}
+	}
+	if history != nil {
+		if err := json.NewDecoder(history).Decode(&builds); err != nil {
+			return fmt.Errorf("failed to decode history: %s", err.Error())
+		}
+	}
+	if webhookSecret != "" {
+		var err error
+		if secret, err = c.encodeSetting(settingWebhookSecret, webhookSecret); err != nil {
+			return err
+		}
+	}
+
+	return c.db.Update(func(tx *bolt.Tx) error {
+		if err := c.importData(tx, values); err != nil {
+			return err
+		}
+		if err := importHistory(tx, builds); err != nil {
+			return err
+		}
+		if secret != nil {
+			return tx.Bucket(settingsBucket).Put([]byte(settingWebhookSecret), secret)
+		}
+		return nil
+	})
+}
+
+func (c *DeploymentDataManager) importData(tx *bolt.Tx, imported map[string][]envVariable) error {
+	for _, bucket := range transferBuckets {
+		for _, v := range imported[string(bucket)] {
+			if v.Name == "" {
+				return errors.New("invalid value with no name found")
+			}
+			if v.Encrypted {
+				encrypted, err := crypto.Encrypt(c.symmetricKey, v.Value)
+				if err != nil {
+					return err
+				}
+				v.Value = encrypted
+			}
+			bytes, err := json.Marshal(v)
+			if err != nil {
+				return err
+			}
+			if err := tx.Bucket(bucket).Put(v.scope().key(v.Name), bytes); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// importHistory stores builds under the same keys as UpdateProjectBuildData
+func importHistory(tx *bolt.Tx, imported map[string][]DeploymentMetadata) error {
+	for project, builds := range imported {
+		projectBkt, err := tx.Bucket(deployedProjectsBucket).CreateBucketIfNotExists([]byte(project))
+		if err != nil {
+			return fmt.Errorf("failure creating project bkt: %s", err.Error())
+		}
+		for _, build := range builds {
+			if build.DeployedAt.IsZero() {
+				return fmt.Errorf("build '%s' of project '%s' has no deployment time",
+					build.Hash, project)
+			}
+			encoded, err := json.Marshal(build)
+			if err != nil {
+				return err
+			}
+			if err := projectBkt.Put([]byte(build.DeployedAt.String()), encoded); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
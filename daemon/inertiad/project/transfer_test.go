@@ -0,0 +1,110 @@
+package project
+
+import (
+	"bytes"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ubclaunchpad/inertia/api"
+)
+
+func TestDataManager_ExportImportData(t *testing.T) {
+	dir := "./test_transfer"
+	require.NoError(t, os.Mkdir(dir, os.ModePerm))
+	defer os.RemoveAll(dir)
+
+	src, err := NewDataManager(path.Join(dir, "src.db"), path.Join(dir, "src.key"))
+	require.NoError(t, err)
+	assert.NoError(t, src.AddEnvVariable("PLAIN", "value", false))
+	assert.NoError(t, src.AddEnvVariable("SECRET", "sekret", true))
+	assert.NoError(t, src.AddScopedEnvVariable(EnvScope{Profile: "staging"}, "SECRET", "staging", true))
+	assert.NoError(t, src.AddSecret("tls.key", []byte("key")))
+	assert.NoError(t, src.SetBackupConfiguration(api.BackupConfiguration{Keep: 3}))
+
+	var buf bytes.Buffer
+	require.NoError(t, src.ExportData(&buf))
+	assert.NotContains(t, buf.String(), "src.key")
+
+	// import into a deployment with a different key
+	dst, err := NewDataManager(path.Join(dir, "dst.db"), path.Join(dir, "dst.key"))
+	require.NoError(t, err)
+	require.NoError(t, dst.Import(&buf, nil, ""))
+
+	shared, _, err := dst.GetDeploymentEnvVariables("staging")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"PLAIN=value", "SECRET=staging"}, shared)
+	vars, err := dst.GetEnvVariables(false)
+	assert.NoError(t, err)
+	assert.Contains(t, vars, "SECRET=[ENCRYPTED]")
+	secrets, err := dst.GetSecretFiles()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("key"), secrets["tls.key"])
+	conf, found, err := dst.GetBackupConfiguration()
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, 3, conf.Keep)
+
+	// garbage is rejected
+	assert.Error(t, dst.Import(bytes.NewBufferString("nope"), nil, ""))
+}
+
+func TestDataManager_ExportImportHistory(t *testing.T) {
+	dir := "./test_transfer_history"
+	require.NoError(t, os.Mkdir(dir, os.ModePerm))
+	defer os.RemoveAll(dir)
+
+	src, err := NewDataManager(path.Join(dir, "src.db"), path.Join(dir, "src.key"))
+	require.NoError(t, err)
+	var deployedAt = time.Now().Add(-time.Hour).Round(time.Second)
+	require.NoError(t, src.AddProjectBuildData("wow", DeploymentMetadata{
+		Hash: "1234", Branch: "master", DeployedAt: deployedAt}))
+	require.NoError(t, src.AddProjectBuildData("wow", DeploymentMetadata{
+		Hash: "5678", Branch: "master", DeployedAt: deployedAt.Add(time.Minute),
+		Changes: []api.Commit{{Hash: "5678", Author: "bob"}}}))
+
+	var buf bytes.Buffer
+	require.NoError(t, src.ExportHistory(&buf))
+
+	dst, err := NewDataManager(path.Join(dir, "dst.db"), path.Join(dir, "dst.key"))
+	require.NoError(t, err)
+	require.NoError(t, dst.Import(nil, &buf, "hunter2"))
+	builds, err := dst.GetProjectBuildData("wow")
+	assert.NoError(t, err)
+	require.Len(t, builds, 2)
+	assert.Equal(t, "5678", builds[0].Hash)
+	assert.Equal(t, "bob", builds[0].Changes[0].Author)
+	assert.True(t, deployedAt.Equal(builds[1].DeployedAt))
+	secret, found, err := dst.GetWebhookSecret()
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "hunter2", secret)
+
+	// garbage is rejected
+	assert.Error(t, dst.Import(nil, bytes.NewBufferString("nope"), ""))
+}
+
+func TestDataManager_ImportAtomic(t *testing.T) {
+	dir := "./test_transfer_atomic"
+	require.NoError(t, os.Mkdir(dir, os.ModePerm))
+	defer os.RemoveAll(dir)
+
+	c, err := NewDataManager(path.Join(dir, "deployment.db"), path.Join(dir, "key"))
+	require.NoError(t, err)
+
+	// invalid history should prevent data from being imported too
+	require.Error(t, c.Import(
+		bytes.NewBufferString(`{"envVariables":[{"name":"PLAIN","value":"dmFsdWU="}]}`),
+		bytes.NewBufferString(`{"wow":[{"hash":"1234"}]}`),
+		"hunter2"))
+	vars, err := c.GetEnvVariables(false)
+	assert.NoError(t, err)
+	assert.Empty(t, vars)
+	_, found, err := c.GetWebhookSecret()
+	assert.NoError(t, err)
+	assert.False(t, found)
+}
@@ -0,0 +1,4 @@
+// Package web provides the dashboard served by the Inertia daemon, a
+// single-page application that uses the daemon API with the session cookie
+// set when logging in
+package web
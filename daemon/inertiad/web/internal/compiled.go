@@ -0,0 +1,200 @@
+// Code generated by fileb0x at "2026-10-19 09:10:23.735467247 +0000 UTC m=+0.001239694" from config file "b0x.yml" DO NOT EDIT.
+// modification hash(78a700359842aacc63ac427c22714c21.8e853739eacf20b87401f2b7c6cff6a1)
+
+package internal
+
+import (
+	"bytes"
+
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path"
+
+	"golang.org/x/net/webdav"
+)
+
+var (
+	// CTX is a context for webdav vfs
+	CTX = context.Background()
+
+	// FS is a virtual memory file system
+	FS = webdav.NewMemFS()
+
+	// Handler is used to server files through a http handler
+	Handler *webdav.Handler
+
+	// HTTP is the http file system
+	HTTP http.FileSystem = new(HTTPFS)
+)
+
+// HTTPFS implements http.FileSystem
+type HTTPFS struct {
+	// Prefix allows to limit the path of all requests. F.e. a prefix "css" would allow only calls to /css/*
+	Prefix string
+}
+
+// FileStaticAppJs is "static/app.js"
+var FileStaticAppJs = []byte("\x2f\x2f\x20\x49\x6e\x65\x72\x74\x69\x61\x20\x64\x61\x73\x68\x62\x6f\x61\x72\x64\x2e\x20\x52\x65\x71\x75\x65\x73\x74\x73\x20\x61\x72\x65\x20\x61\x75\x74\x68\x65\x6e\x74\x69\x63\x61\x74\x65\x64\x20\x62\x79\x20\x74\x68\x65\x20\x73\x65\x73\x73\x69\x6f\x6e\x20\x63\x6f\x6f\x6b\x69\x65\x20\x73\x65\x74\x20\x62\x79\x0a\x2f\x2f\x20\x74\x68\x65\x20\x64\x61\x65\x6d\x6f\x6e\x20\x77\x68\x65\x6e\x20\x6c\x6f\x67\x67\x69\x6e\x67\x20\x69\x6e\x2e\x0a\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x29\x20\x7b\x0a\x20\x20\x27\x75\x73\x65\x20\x73\x74\x72\x69\x63\x74\x27\x3b\x0a\x0a\x20\x20\x76\x61\x72\x20\x41\x50\x49\x20\x3d\x20\x27\x2f\x76\x31\x27\x3b\x0a\x0a\x20\x20\x76\x61\x72\x20\x24\x20\x3d\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x69\x64\x29\x20\x7b\x20\x72\x65\x74\x75\x72\x6e\x20\x64\x6f\x63\x75\x6d\x65\x6e\x74\x2e\x67\x65\x74\x45\x6c\x65\x6d\x65\x6e\x74\x42\x79\x49\x64\x28\x69\x64\x29\x3b\x20\x7d\x3b\x0a\x0a\x20\x20\x76\x61\x72\x20\x6c\x6f\x67\x53\x6f\x63\x6b\x65\x74\x20\x3d\x20\x6e\x75\x6c\x6c\x3b\x0a\x0a\x20\x20\x2f\x2f\x20\x41\x50\x49\x45\x72\x72\x6f\x72\x20\x69\x73\x20\x61\x20\x66\x61\x69\x6c\x65\x64\x20\x72\x65\x71\x75\x65\x73\x74\x20\x74\x6f\x20\x74\x68\x65\x20\x64\x61\x65\x6d\x6f\x6e\x0a\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x41\x50\x49\x45\x72\x72\x6f\x72\x28\x73\x74\x61\x74\x75\x73\x2c\x20\x6d\x65\x73\x73\x61\x67\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x74\x68\x69\x73\x2e\x73\x74\x61\x74\x75\x73\x20\x3d\x20\x73\x74\x61\x74\x75\x73\x3b\x0a\x20\x20\x20\x20\x74\x68\x69\x73\x2e\x6d\x65\x73\x73\x61\x67\x65\x20\x3d\x20\x6d\x65\x73\x73\x61\x67\x65\x3b\x0a\x20\x20\x7d\x0a\x0a\x20\x20\x2f\x2f\x20\x72\x65\x71\x75\x65\x73\x74\x20\x6d\x61\x6b\x65\x73\x20\x61\x20\x72\x65\x71\x75\x65\x73\x74\x20\x74\x6f\x20\x74\x68\x65\x20\x64\x61\x65\x6d\x6f\x6e\x20\x41\x50\x49\x2c\x20\x61\x6e\x64\x20\x72\x65\x73\x6f\x6c\x76\x65\x73\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x64\x61\x74\x61\x20\x6f\x66\x0a\x20\x20\x2f\x2f\x20\x74\x68\x65\x20\x72\x65\x73\x70\x6f\x6e\x73\x65\x0a\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x72\x65\x71\x75\x65\x73\x74\x28\x6d\x65\x74\x68\x6f\x64\x2c\x20\x70\x61\x74\x68\x2c\x20\x62\x6f\x64\x79\x29\x20\x7b\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x6f\x70\x74\x73\x20\x3d\x20\x7b\x20\x6d\x65\x74\x68\x6f\x64\x3a\x20\x6d\x65\x74\x68\x6f\x64\x2c\x20\x63\x72\x65\x64\x65\x6e\x74\x69\x61\x6c\x73\x3a\x20\x27\x73\x61\x6d\x65\x2d\x6f\x72\x69\x67\x69\x6e\x27\x2c\x20\x68\x65\x61\x64\x65\x72\x73\x3a\x20\x7b\x7d\x20\x7d\x3b\x0a\x20\x20\x20\x20\x69\x66\x20\x28\x62\x6f\x64\x79\x20\x21\x3d\x3d\x20\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x74\x73\x2e\x68\x65\x61\x64\x65\x72\x73\x5b\x27\x43\x6f\x6e\x74\x65\x6e\x74\x2d\x54\x79\x70\x65\x27\x5d\x20\x3d\x20\x27\x61\x70\x70\x6c\x69\x63\x61\x74\x69\x6f\x6e\x2f\x6a\x73\x6f\x6e\x27\x3b\x0a\x20\x20\x20\x20\x20\x20\x6f\x70\x74\x73\x2e\x62\x6f\x64\x79\x20\x3d\x20\x4a\x53\x4f\x4e\x2e\x73\x74\x72\x69\x6e\x67\x69\x66\x79\x28\x62\x6f\x64\x79\x29\x3b\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x66\x65\x74\x63\x68\x28\x41\x50\x49\x20\x2b\x20\x70\x61\x74\x68\x2c\x20\x6f\x70\x74\x73\x29\x2e\x74\x68\x65\x6e\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x72\x65\x73\x70\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x72\x65\x73\x70\x2e\x6a\x73\x6f\x6e\x28\x29\x2e\x63\x61\x74\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x29\x20\x7b\x20\x72\x65\x74\x75\x72\x6e\x20\x7b\x7d\x3b\x20\x7d\x29\x2e\x74\x68\x65\x6e\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x62\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x21\x72\x65\x73\x70\x2e\x6f\x6b\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x6d\x73\x67\x20\x3d\x20\x62\x2e\x6d\x65\x73\x73\x61\x67\x65\x20\x7c\x7c\x20\x72\x65\x73\x70\x2e\x73\x74\x61\x74\x75\x73\x54\x65\x78\x74\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x62\x2e\x65\x72\x72\x6f\x72\x29\x20\x6d\x73\x67\x20\x2b\x3d\x20\x27\x3a\x20\x27\x20\x2b\x20\x62\x2e\x65\x72\x72\x6f\x72\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x74\x68\x72\x6f\x77\x20\x6e\x65\x77\x20\x41\x50\x49\x45\x72\x72\x6f\x72\x28\x72\x65\x73\x70\x2e\x73\x74\x61\x74\x75\x73\x2c\x20\x6d\x73\x67\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x62\x2e\x64\x61\x74\x61\x20\x7c\x7c\x20\x7b\x7d\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x29\x3b\x0a\x20\x20\x20\x20\x7d\x29\x3b\x0a\x20\x20\x7d\x0a\x0a\x20\x20\x2f\x2f\x20\x73\x74\x72\x65\x61\x6d\x20\x6d\x61\x6b\x65\x73\x20\x61\x20\x72\x65\x71\x75\x65\x73\x74\x20\x74\x6f\x20\x74\x68\x65\x20\x64\x61\x65\x6d\x6f\x6e\x20\x41\x50\x49\x2c\x20\x61\x6e\x64\x20\x61\x70\x70\x65\x6e\x64\x73\x20\x69\x74\x73\x20\x6f\x75\x74\x70\x75\x74\x20\x74\x6f\x20\x74\x68\x65\x0a\x20\x20\x2f\x2f\x20\x67\x69\x76\x65\x6e\x20\x65\x6c\x65\x6d\x65\x6e\x74\x20\x61\x73\x20\x69\x74\x20\x69\x73\x20\x72\x65\x63\x65\x69\x76\x65\x64\x0a\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x73\x74\x72\x65\x61\x6d\x28\x6d\x65\x74\x68\x6f\x64\x2c\x20\x70\x61\x74\x68\x2c\x20\x6f\x75\x74\x70\x75\x74\x29\x20\x7b\x0a\x20\x20\x20\x20\x6f\x75\x74\x70\x75\x74\x2e\x68\x69\x64\x64\x65\x6e\x20\x3d\x20\x66\x61\x6c\x73\x65\x3b\x0a\x20\x20\x20\x20\x6f\x75\x74\x70\x75\x74\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x20\x3d\x20\x27\x27\x3b\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x66\x65\x74\x63\x68\x28\x41\x50\x49\x20\x2b\x20\x70\x61\x74\x68\x2c\x20\x7b\x20\x6d\x65\x74\x68\x6f\x64\x3a\x20\x6d\x65\x74\x68\x6f\x64\x2c\x20\x63\x72\x65\x64\x65\x6e\x74\x69\x61\x6c\x73\x3a\x20\x27\x73\x61\x6d\x65\x2d\x6f\x72\x69\x67\x69\x6e\x27\x20\x7d\x29\x2e\x74\x68\x65\x6e\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x72\x65\x73\x70\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x21\x72\x65\x73\x70\x2e\x62\x6f\x64\x79\x20\x7c\x7c\x20\x21\x72\x65\x73\x70\x2e\x62\x6f\x64\x79\x2e\x67\x65\x74\x52\x65\x61\x64\x65\x72\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x72\x65\x73\x70\x2e\x74\x65\x78\x74\x28\x29\x2e\x74\x68\x65\x6e\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x74\x29\x20\x7b\x20\x6f\x75\x74\x70\x75\x74\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x20\x3d\x20\x74\x3b\x20\x7d\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x72\x65\x61\x64\x65\x72\x20\x3d\x20\x72\x65\x73\x70\x2e\x62\x6f\x64\x79\x2e\x67\x65\x74\x52\x65\x61\x64\x65\x72\x28\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x64\x65\x63\x6f\x64\x65\x72\x20\x3d\x20\x6e\x65\x77\x20\x54\x65\x78\x74\x44\x65\x63\x6f\x64\x65\x72\x28\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x72\x65\x61\x64\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x72\x65\x61\x64\x65\x72\x2e\x72\x65\x61\x64\x28\x29\x2e\x74\x68\x65\x6e\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x72\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x72\x2e\x64\x6f\x6e\x65\x29\x20\x72\x65\x74\x75\x72\x6e\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x6f\x75\x74\x70\x75\x74\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x20\x2b\x3d\x20\x64\x65\x63\x6f\x64\x65\x72\x2e\x64\x65\x63\x6f\x64\x65\x28\x72\x2e\x76\x61\x6c\x75\x65\x2c\x20\x7b\x20\x73\x74\x72\x65\x61\x6d\x3a\x20\x74\x72\x75\x65\x20\x7d\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x6f\x75\x74\x70\x75\x74\x2e\x73\x63\x72\x6f\x6c\x6c\x54\x6f\x70\x20\x3d\x20\x6f\x75\x74\x70\x75\x74\x2e\x73\x63\x72\x6f\x6c\x6c\x48\x65\x69\x67\x68\x74\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x72\x65\x61\x64\x28\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x72\x65\x61\x64\x28\x29\x3b\x0a\x20\x20\x20\x20\x7d\x29\x3b\x0a\x20\x20\x7d\x0a\x0a\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x6e\x6f\x74\x69\x66\x79\x28\x6d\x65\x73\x73\x61\x67\x65\x2c\x20\x69\x73\x45\x72\x72\x6f\x72\x29\x20\x7b\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x6e\x20\x3d\x20\x24\x28\x27\x6e\x6f\x74\x69\x63\x65\x27\x29\x3b\x0a\x20\x20\x20\x20\x6e\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x20\x3d\x20\x6d\x65\x73\x73\x61\x67\x65\x3b\x0a\x20\x20\x20\x20\x6e\x2e\x63\x6c\x61\x73\x73\x4e\x61\x6d\x65\x20\x3d\x20\x69\x73\x45\x72\x72\x6f\x72\x20\x3f\x20\x27\x65\x72\x72\x6f\x72\x27\x20\x3a\x20\x27\x27\x3b\x0a\x20\x20\x20\x20\x6e\x2e\x68\x69\x64\x64\x65\x6e\x20\x3d\x20\x66\x61\x6c\x73\x65\x3b\x0a\x20\x20\x20\x20\x63\x6c\x65\x61\x72\x54\x69\x6d\x65\x6f\x75\x74\x28\x6e\x6f\x74\x69\x66\x79\x2e\x74\x69\x6d\x65\x72\x29\x3b\x0a\x20\x20\x20\x20\x6e\x6f\x74\x69\x66\x79\x2e\x74\x69\x6d\x65\x72\x20\x3d\x20\x73\x65\x74\x54\x69\x6d\x65\x6f\x75\x74\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x29\x20\x7b\x20\x6e\x2e\x68\x69\x64\x64\x65\x6e\x20\x3d\x20\x74\x72\x75\x65\x3b\x20\x7d\x2c\x20\x35\x30\x30\x30\x29\x3b\x0a\x20\x20\x7d\x0a\x0a\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x66\x61\x69\x6c\x28\x65\x72\x72\x29\x20\x7b\x0a\x20\x20\x20\x20\x69\x66\x20\x28\x65\x72\x72\x20\x69\x6e\x73\x74\x61\x6e\x63\x65\x6f\x66\x20\x41\x50\x49\x45\x72\x72\x6f\x72\x20\x26\x26\x20\x65\x72\x72\x2e\x73\x74\x61\x74\x75\x73\x20\x3d\x3d\x3d\x20\x34\x30\x31\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x73\x68\x6f\x77\x4c\x6f\x67\x69\x6e\x28\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x3b\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x6e\x6f\x74\x69\x66\x79\x28\x65\x72\x72\x2e\x6d\x65\x73\x73\x61\x67\x65\x20\x7c\x7c\x20\x53\x74\x72\x69\x6e\x67\x28\x65\x72\x72\x29\x2c\x20\x74\x72\x75\x65\x29\x3b\x0a\x20\x20\x7d\x0a\x0a\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x63\x6c\x65\x61\x72\x28\x65\x6c\x29\x20\x7b\x0a\x20\x20\x20\x20\x77\x68\x69\x6c\x65\x20\x28\x65\x6c\x2e\x66\x69\x72\x73\x74\x43\x68\x69\x6c\x64\x29\x20\x65\x6c\x2e\x72\x65\x6d\x6f\x76\x65\x43\x68\x69\x6c\x64\x28\x65\x6c\x2e\x66\x69\x72\x73\x74\x43\x68\x69\x6c\x64\x29\x3b\x0a\x20\x20\x7d\x0a\x0a\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x62\x75\x74\x74\x6f\x6e\x28\x6c\x61\x62\x65\x6c\x2c\x20\x6f\x6e\x43\x6c\x69\x63\x6b\x2c\x20\x63\x6c\x61\x73\x73\x4e\x61\x6d\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x62\x20\x3d\x20\x64\x6f\x63\x75\x6d\x65\x6e\x74\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x27\x62\x75\x74\x74\x6f\x6e\x27\x29\x3b\x0a\x20\x20\x20\x20\x62\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x20\x3d\x20\x6c\x61\x62\x65\x6c\x3b\x0a\x20\x20\x20\x20\x69\x66\x20\x28\x63\x6c\x61\x73\x73\x4e\x61\x6d\x65\x29\x20\x62\x2e\x63\x6c\x61\x73\x73\x4e\x61\x6d\x65\x20\x3d\x20\x63\x6c\x61\x73\x73\x4e\x61\x6d\x65\x3b\x0a\x20\x20\x20\x20\x62\x2e\x61\x64\x64\x45\x76\x65\x6e\x74\x4c\x69\x73\x74\x65\x6e\x65\x72\x28\x27\x63\x6c\x69\x63\x6b\x27\x2c\x20\x6f\x6e\x43\x6c\x69\x63\x6b\x29\x3b\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x62\x3b\x0a\x20\x20\x7d\x0a\x0a\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x69\x74\x65\x6d\x28\x74\x65\x78\x74\x29\x20\x7b\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x6c\x69\x20\x3d\x20\x64\x6f\x63\x75\x6d\x65\x6e\x74\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x27\x6c\x69\x27\x29\x3b\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x73\x70\x61\x6e\x20\x3d\x20\x64\x6f\x63\x75\x6d\x65\x6e\x74\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x27\x73\x70\x61\x6e\x27\x29\x3b\x0a\x20\x20\x20\x20\x73\x70\x61\x6e\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x20\x3d\x20\x74\x65\x78\x74\x3b\x0a\x20\x20\x20\x20\x6c\x69\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x73\x70\x61\x6e\x29\x3b\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6c\x69\x3b\x0a\x20\x20\x7d\x0a\x0a\x20\x20\x2f\x2f\x20\x53\x65\x73\x73\x69\x6f\x6e\x20\x6d\x61\x6e\x61\x67\x65\x6d\x65\x6e\x74\x0a\x0a\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x73\x68\x6f\x77\x4c\x6f\x67\x69\x6e\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x63\x6c\x6f\x73\x65\x4c\x6f\x67\x73\x28\x29\x3b\x0a\x20\x20\x20\x20\x24\x28\x27\x64\x61\x73\x68\x62\x6f\x61\x72\x64\x27\x29\x2e\x68\x69\x64\x64\x65\x6e\x20\x3d\x20\x74\x72\x75\x65\x3b\x0a\x20\x20\x20\x20\x24\x28\x27\x73\x65\x73\x73\x69\x6f\x6e\x27\x29\x2e\x68\x69\x64\x64\x65\x6e\x20\x3d\x20\x74\x72\x75\x65\x3b\x0a\x20\x20\x20\x20\x24\x28\x27\x6c\x6f\x67\x69\x6e\x27\x29\x2e\x68\x69\x64\x64\x65\x6e\x20\x3d\x20\x66\x61\x6c\x73\x65\x3b\x0a\x20\x20\x7d\x0a\x0a\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x73\x68\x6f\x77\x44\x61\x73\x68\x62\x6f\x61\x72\x64\x28\x75\x73\x65\x72\x2c\x20\x61\x64\x6d\x69\x6e\x29\x20\x7b\x0a\x20\x20\x20\x20\x24\x28\x27\x6c\x6f\x67\x69\x6e\x27\x29\x2e\x68\x69\x64\x64\x65\x6e\x20\x3d\x20\x74\x72\x75\x65\x3b\x0a\x20\x20\x20\x20\x24\x28\x27\x73\x65\x73\x73\x69\x6f\x6e\x2d\x75\x73\x65\x72\x27\x29\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x20\x3d\x20\x75\x73\x65\x72\x3b\x0a\x20\x20\x20\x20\x24\x28\x27\x73\x65\x73\x73\x69\x6f\x6e\x27\x29\x2e\x68\x69\x64\x64\x65\x6e\x20\x3d\x20\x66\x61\x6c\x73\x65\x3b\x0a\x20\x20\x20\x20\x24\x28\x27\x64\x61\x73\x68\x62\x6f\x61\x72\x64\x27\x29\x2e\x68\x69\x64\x64\x65\x6e\x20\x3d\x20\x66\x61\x6c\x73\x65\x3b\x0a\x20\x20\x20\x20\x64\x6f\x63\x75\x6d\x65\x6e\x74\x2e\x62\x6f\x64\x79\x2e\x63\x6c\x61\x73\x73\x4c\x69\x73\x74\x2e\x74\x6f\x67\x67\x6c\x65\x28\x27\x69\x73\x2d\x61\x64\x6d\x69\x6e\x27\x2c\x20\x61\x64\x6d\x69\x6e\x29\x3b\x0a\x20\x20\x20\x20\x72\x65\x66\x72\x65\x73\x68\x28\x61\x64\x6d\x69\x6e\x29\x3b\x0a\x20\x20\x7d\x0a\x0a\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x76\x61\x6c\x69\x64\x61\x74\x65\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x72\x65\x71\x75\x65\x73\x74\x28\x27\x47\x45\x54\x27\x2c\x20\x27\x2f\x75\x73\x65\x72\x2f\x76\x61\x6c\x69\x64\x61\x74\x65\x27\x29\x2e\x74\x68\x65\x6e\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x64\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x73\x68\x6f\x77\x44\x61\x73\x68\x62\x6f\x61\x72\x64\x28\x64\x2e\x75\x73\x65\x72\x2c\x20\x64\x2e\x61\x64\x6d\x69\x6e\x29\x3b\x0a\x20\x20\x20\x20\x7d\x29\x2e\x63\x61\x74\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x65\x72\x72\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x65\x72\x72\x20\x69\x6e\x73\x74\x61\x6e\x63\x65\x6f\x66\x20\x41\x50\x49\x45\x72\x72\x6f\x72\x20\x26\x26\x20\x65\x72\x72\x2e\x73\x74\x61\x74\x75\x73\x20\x3d\x3d\x3d\x20\x34\x30\x31\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x73\x68\x6f\x77\x4c\x6f\x67\x69\x6e\x28\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x20\x65\x6c\x73\x65\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x61\x69\x6c\x28\x65\x72\x72\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x7d\x29\x3b\x0a\x20\x20\x7d\x0a\x0a\x20\x20\x24\x28\x27\x6c\x6f\x67\x69\x6e\x2d\x66\x6f\x72\x6d\x27\x29\x2e\x61\x64\x64\x45\x76\x65\x6e\x74\x4c\x69\x73\x74\x65\x6e\x65\x72\x28\x27\x73\x75\x62\x6d\x69\x74\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x65\x2e\x70\x72\x65\x76\x65\x6e\x74\x44\x65\x66\x61\x75\x6c\x74\x28\x29\x3b\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x66\x20\x3d\x20\x65\x2e\x74\x61\x72\x67\x65\x74\x3b\x0a\x20\x20\x20\x20\x72\x65\x71\x75\x65\x73\x74\x28\x27\x50\x4f\x53\x54\x27\x2c\x20\x27\x2f\x75\x73\x65\x72\x2f\x6c\x6f\x67\x69\x6e\x27\x2c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x75\x73\x65\x72\x6e\x61\x6d\x65\x3a\x20\x66\x2e\x65\x6c\x65\x6d\x65\x6e\x74\x73\x2e\x75\x73\x65\x72\x6e\x61\x6d\x65\x2e\x76\x61\x6c\x75\x65\x2c\x0a\x20\x20\x20\x20\x20\x20\x70\x61\x73\x73\x77\x6f\x72\x64\x3a\x20\x66\x2e\x65\x6c\x65\x6d\x65\x6e\x74\x73\x2e\x70\x61\x73\x73\x77\x6f\x72\x64\x2e\x76\x61\x6c\x75\x65\x2c\x0a\x20\x20\x20\x20\x20\x20\x74\x6f\x74\x70\x3a\x20\x66\x2e\x65\x6c\x65\x6d\x65\x6e\x74\x73\x2e\x74\x6f\x74\x70\x2e\x76\x61\x6c\x75\x65\x0a\x20\x20\x20\x20\x7d\x29\x2e\x74\x68\x65\x6e\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x66\x2e\x72\x65\x73\x65\x74\x28\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x76\x61\x6c\x69\x64\x61\x74\x65\x28\x29\x3b\x0a\x20\x20\x20\x20\x7d\x29\x2e\x63\x61\x74\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x65\x72\x72\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x6e\x6f\x74\x69\x66\x79\x28\x65\x72\x72\x2e\x6d\x65\x73\x73\x61\x67\x65\x2c\x20\x74\x72\x75\x65\x29\x3b\x0a\x20\x20\x20\x20\x7d\x29\x3b\x0a\x20\x20\x7d\x29\x3b\x0a\x0a\x20\x20\x24\x28\x27\x6c\x6f\x67\x6f\x75\x74\x27\x29\x2e\x61\x64\x64\x45\x76\x65\x6e\x74\x4c\x69\x73\x74\x65\x6e\x65\x72\x28\x27\x63\x6c\x69\x63\x6b\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x72\x65\x71\x75\x65\x73\x74\x28\x27\x50\x4f\x53\x54\x27\x2c\x20\x27\x2f\x75\x73\x65\x72\x2f\x6c\x6f\x67\x6f\x75\x74\x27\x29\x2e\x63\x61\x74\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x29\x20\x7b\x7d\x29\x2e\x74\x68\x65\x6e\x28\x73\x68\x6f\x77\x4c\x6f\x67\x69\x6e\x29\x3b\x0a\x20\x20\x7d\x29\x3b\x0a\x0a\x20\x20\x2f\x2f\x20\x44\x65\x70\x6c\x6f\x79\x6d\x65\x6e\x74\x0a\x0a\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x72\x65\x66\x72\x65\x73\x68\x28\x61\x64\x6d\x69\x6e\x29\x20\x7b\x0a\x20\x20\x20\x20\x6c\x6f\x61\x64\x53\x74\x61\x74\x75\x73\x28\x29\x3b\x0a\x20\x20\x20\x20\x6c\x6f\x61\x64\x48\x69\x73\x74\x6f\x72\x79\x28\x29\x3b\x0a\x20\x20\x20\x20\x69\x66\x20\x28\x61\x64\x6d\x69\x6e\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x6c\x6f\x61\x64\x45\x6e\x76\x28\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x6c\x6f\x61\x64\x55\x73\x65\x72\x73\x28\x29\x3b\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x0a\x0a\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x6c\x6f\x61\x64\x53\x74\x61\x74\x75\x73\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x72\x65\x71\x75\x65\x73\x74\x28\x27\x47\x45\x54\x27\x2c\x20\x27\x2f\x73\x74\x61\x74\x75\x73\x27\x29\x2e\x74\x68\x65\x6e\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x64\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x73\x20\x3d\x20\x64\x2e\x73\x74\x61\x74\x75\x73\x20\x7c\x7c\x20\x7b\x7d\x3b\x0a\x20\x20\x20\x20\x20\x20\x24\x28\x27\x73\x74\x61\x74\x75\x73\x2d\x62\x72\x61\x6e\x63\x68\x27\x29\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x20\x3d\x20\x73\x2e\x62\x72\x61\x6e\x63\x68\x20\x7c\x7c\x20\x27\x6e\x6f\x20\x64\x65\x70\x6c\x6f\x79\x6d\x65\x6e\x74\x27\x3b\x0a\x20\x20\x20\x20\x20\x20\x24\x28\x27\x73\x74\x61\x74\x75\x73\x2d\x63\x6f\x6d\x6d\x69\x74\x27\x29\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x20\x3d\x20\x73\x2e\x63\x6f\x6d\x6d\x69\x74\x5f\x68\x61\x73\x68\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3f\x20\x73\x2e\x63\x6f\x6d\x6d\x69\x74\x5f\x68\x61\x73\x68\x2e\x73\x75\x62\x73\x74\x72\x69\x6e\x67\x28\x30\x2c\x20\x38\x29\x20\x2b\x20\x27\x20\x27\x20\x2b\x20\x28\x73\x2e\x63\x6f\x6d\x6d\x69\x74\x5f\x6d\x65\x73\x73\x61\x67\x65\x20\x7c\x7c\x20\x27\x27\x29\x20\x3a\x20\x27\x27\x3b\x0a\x20\x20\x20\x20\x20\x20\x24\x28\x27\x73\x74\x61\x74\x75\x73\x2d\x62\x75\x69\x6c\x64\x27\x29\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x20\x3d\x20\x73\x2e\x62\x75\x69\x6c\x64\x5f\x74\x79\x70\x65\x20\x7c\x7c\x20\x27\x27\x3b\x0a\x20\x20\x20\x20\x20\x20\x24\x28\x27\x73\x74\x61\x74\x75\x73\x2d\x76\x65\x72\x73\x69\x6f\x6e\x27\x29\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x20\x3d\x20\x73\x2e\x76\x65\x72\x73\x69\x6f\x6e\x20\x2b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x28\x73\x2e\x6e\x65\x77\x5f\x76\x65\x72\x73\x69\x6f\x6e\x5f\x61\x76\x61\x69\x6c\x61\x62\x6c\x65\x20\x3f\x20\x27\x20\x28\x27\x20\x2b\x20\x73\x2e\x6e\x65\x77\x5f\x76\x65\x72\x73\x69\x6f\x6e\x5f\x61\x76\x61\x69\x6c\x61\x62\x6c\x65\x20\x2b\x20\x27\x20\x61\x76\x61\x69\x6c\x61\x62\x6c\x65\x29\x27\x20\x3a\x20\x27\x27\x29\x3b\x0a\x0a\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x6c\x69\x73\x74\x20\x3d\x20\x24\x28\x27\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x2d\x6c\x69\x73\x74\x27\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x63\x6c\x65\x61\x72\x28\x6c\x69\x73\x74\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x28\x73\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x73\x20\x7c\x7c\x20\x5b\x5d\x29\x2e\x66\x6f\x72\x45\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x6e\x61\x6d\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x6c\x69\x20\x3d\x20\x69\x74\x65\x6d\x28\x6e\x61\x6d\x65\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6c\x69\x2e\x64\x61\x74\x61\x73\x65\x74\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x20\x3d\x20\x6e\x61\x6d\x65\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6c\x69\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x62\x75\x74\x74\x6f\x6e\x28\x27\x6c\x6f\x67\x73\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x29\x20\x7b\x20\x6f\x70\x65\x6e\x4c\x6f\x67\x73\x28\x6e\x61\x6d\x65\x29\x3b\x20\x7d\x2c\x20\x27\x6c\x69\x6e\x6b\x27\x29\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6c\x69\x73\x74\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x6c\x69\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x21\x73\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x73\x20\x7c\x7c\x20\x73\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x73\x2e\x6c\x65\x6e\x67\x74\x68\x20\x3d\x3d\x3d\x20\x30\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6c\x69\x73\x74\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x69\x74\x65\x6d\x28\x73\x2e\x62\x75\x69\x6c\x64\x5f\x61\x63\x74\x69\x76\x65\x20\x3f\x20\x27\x62\x75\x69\x6c\x64\x20\x69\x6e\x20\x70\x72\x6f\x67\x72\x65\x73\x73\x27\x20\x3a\x20\x27\x6e\x6f\x20\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x73\x20\x61\x63\x74\x69\x76\x65\x27\x29\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x7d\x29\x2e\x63\x61\x74\x63\x68\x28\x66\x61\x69\x6c\x29\x3b\x0a\x20\x20\x7d\x0a\x0a\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x6c\x6f\x61\x64\x48\x69\x73\x74\x6f\x72\x79\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x72\x65\x71\x75\x65\x73\x74\x28\x27\x47\x45\x54\x27\x2c\x20\x27\x2f\x68\x69\x73\x74\x6f\x72\x79\x27\x29\x2e\x74\x68\x65\x6e\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x64\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x6c\x69\x73\x74\x20\x3d\x20\x24\x28\x27\x68\x69\x73\x74\x6f\x72\x79\x2d\x6c\x69\x73\x74\x27\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x63\x6c\x65\x61\x72\x28\x6c\x69\x73\x74\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x28\x64\x2e\x68\x69\x73\x74\x6f\x72\x79\x20\x7c\x7c\x20\x5b\x5d\x29\x2e\x66\x6f\x72\x45\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x68\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x74\x72\x20\x3d\x20\x64\x6f\x63\x75\x6d\x65\x6e\x74\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x27\x74\x72\x27\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x5b\x6e\x65\x77\x20\x44\x61\x74\x65\x28\x68\x2e\x64\x65\x70\x6c\x6f\x79\x65\x64\x5f\x61\x74\x29\x2e\x74\x6f\x4c\x6f\x63\x61\x6c\x65\x53\x74\x72\x69\x6e\x67\x28\x29\x2c\x20\x68\x2e\x62\x72\x61\x6e\x63\x68\x2c\x20\x68\x2e\x63\x6f\x6d\x6d\x69\x74\x5f\x68\x61\x73\x68\x2e\x73\x75\x62\x73\x74\x72\x69\x6e\x67\x28\x30\x2c\x20\x38\x29\x2c\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x68\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x5f\x73\x74\x61\x74\x75\x73\x20\x7c\x7c\x20\x27\x27\x5d\x2e\x66\x6f\x72\x45\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x76\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x74\x64\x20\x3d\x20\x64\x6f\x63\x75\x6d\x65\x6e\x74\x2e\x63\x72\x65\x61\x74\x65\x45\x6c\x65\x6d\x65\x6e\x74\x28\x27\x74\x64\x27\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x74\x64\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x20\x3d\x20\x76\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x74\x72\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x74\x64\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6c\x69\x73\x74\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x74\x72\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x29\x3b\x0a\x20\x20\x20\x20\x7d\x29\x2e\x63\x61\x74\x63\x68\x28\x66\x61\x69\x6c\x29\x3b\x0a\x20\x20\x7d\x0a\x0a\x20\x20\x24\x28\x27\x64\x65\x70\x6c\x6f\x79\x27\x29\x2e\x61\x64\x64\x45\x76\x65\x6e\x74\x4c\x69\x73\x74\x65\x6e\x65\x72\x28\x27\x63\x6c\x69\x63\x6b\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x73\x74\x72\x65\x61\x6d\x28\x27\x50\x4f\x53\x54\x27\x2c\x20\x27\x2f\x72\x65\x64\x65\x70\x6c\x6f\x79\x3f\x73\x74\x72\x65\x61\x6d\x3d\x74\x72\x75\x65\x27\x2c\x20\x24\x28\x27\x64\x65\x70\x6c\x6f\x79\x2d\x6f\x75\x74\x70\x75\x74\x27\x29\x29\x0a\x20\x20\x20\x20\x20\x20\x2e\x74\x68\x65\x6e\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x29\x20\x7b\x20\x6c\x6f\x61\x64\x53\x74\x61\x74\x75\x73\x28\x29\x3b\x20\x6c\x6f\x61\x64\x48\x69\x73\x74\x6f\x72\x79\x28\x29\x3b\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x2e\x63\x61\x74\x63\x68\x28\x66\x61\x69\x6c\x29\x3b\x0a\x20\x20\x7d\x29\x3b\x0a\x0a\x20\x20\x24\x28\x27\x64\x6f\x77\x6e\x27\x29\x2e\x61\x64\x64\x45\x76\x65\x6e\x74\x4c\x69\x73\x74\x65\x6e\x65\x72\x28\x27\x63\x6c\x69\x63\x6b\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x69\x66\x20\x28\x21\x63\x6f\x6e\x66\x69\x72\x6d\x28\x27\x53\x68\x75\x74\x20\x64\x6f\x77\x6e\x20\x74\x68\x65\x20\x64\x65\x70\x6c\x6f\x79\x6d\x65\x6e\x74\x3f\x27\x29\x29\x20\x72\x65\x74\x75\x72\x6e\x3b\x0a\x20\x20\x20\x20\x72\x65\x71\x75\x65\x73\x74\x28\x27\x50\x4f\x53\x54\x27\x2c\x20\x27\x2f\x64\x6f\x77\x6e\x27\x29\x2e\x74\x68\x65\x6e\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x6e\x6f\x74\x69\x66\x79\x28\x27\x70\x72\x6f\x6a\x65\x63\x74\x20\x73\x68\x75\x74\x20\x64\x6f\x77\x6e\x27\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x63\x6c\x6f\x73\x65\x4c\x6f\x67\x73\x28\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x6c\x6f\x61\x64\x53\x74\x61\x74\x75\x73\x28\x29\x3b\x0a\x20\x20\x20\x20\x7d\x29\x2e\x63\x61\x74\x63\x68\x28\x66\x61\x69\x6c\x29\x3b\x0a\x20\x20\x7d\x29\x3b\x0a\x0a\x20\x20\x2f\x2f\x20\x4c\x6f\x67\x73\x0a\x0a\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x6f\x70\x65\x6e\x4c\x6f\x67\x73\x28\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x29\x20\x7b\x0a\x20\x20\x20\x20\x63\x6c\x6f\x73\x65\x4c\x6f\x67\x73\x28\x29\x3b\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x70\x72\x6f\x74\x6f\x20\x3d\x20\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x2e\x70\x72\x6f\x74\x6f\x63\x6f\x6c\x20\x3d\x3d\x3d\x20\x27\x68\x74\x74\x70\x73\x3a\x27\x20\x3f\x20\x27\x77\x73\x73\x3a\x27\x20\x3a\x20\x27\x77\x73\x3a\x27\x3b\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x75\x72\x6c\x20\x3d\x20\x70\x72\x6f\x74\x6f\x20\x2b\x20\x27\x2f\x2f\x27\x20\x2b\x20\x6c\x6f\x63\x61\x74\x69\x6f\x6e\x2e\x68\x6f\x73\x74\x20\x2b\x20\x41\x50\x49\x20\x2b\x20\x27\x2f\x6c\x6f\x67\x73\x3f\x73\x74\x72\x65\x61\x6d\x3d\x74\x72\x75\x65\x26\x65\x6e\x74\x72\x69\x65\x73\x3d\x32\x30\x30\x26\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x3d\x27\x20\x2b\x0a\x20\x20\x20\x20\x20\x20\x65\x6e\x63\x6f\x64\x65\x55\x52\x49\x43\x6f\x6d\x70\x6f\x6e\x65\x6e\x74\x28\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x29\x3b\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x6f\x75\x74\x70\x75\x74\x20\x3d\x20\x24\x28\x27\x6c\x6f\x67\x73\x2d\x6f\x75\x74\x70\x75\x74\x27\x29\x3b\x0a\x20\x20\x20\x20\x6f\x75\x74\x70\x75\x74\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x20\x3d\x20\x27\x27\x3b\x0a\x20\x20\x20\x20\x24\x28\x27\x6c\x6f\x67\x73\x2d\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x27\x29\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x20\x3d\x20\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x3b\x0a\x20\x20\x20\x20\x24\x28\x27\x6c\x6f\x67\x73\x27\x29\x2e\x68\x69\x64\x64\x65\x6e\x20\x3d\x20\x66\x61\x6c\x73\x65\x3b\x0a\x20\x20\x20\x20\x41\x72\x72\x61\x79\x2e\x70\x72\x6f\x74\x6f\x74\x79\x70\x65\x2e\x66\x6f\x72\x45\x61\x63\x68\x2e\x63\x61\x6c\x6c\x28\x24\x28\x27\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x2d\x6c\x69\x73\x74\x27\x29\x2e\x63\x68\x69\x6c\x64\x72\x65\x6e\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x6c\x69\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x6c\x69\x2e\x63\x6c\x61\x73\x73\x4c\x69\x73\x74\x2e\x74\x6f\x67\x67\x6c\x65\x28\x27\x73\x65\x6c\x65\x63\x74\x65\x64\x27\x2c\x20\x6c\x69\x2e\x64\x61\x74\x61\x73\x65\x74\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x20\x3d\x3d\x3d\x20\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x29\x3b\x0a\x20\x20\x20\x20\x7d\x29\x3b\x0a\x0a\x20\x20\x20\x20\x6c\x6f\x67\x53\x6f\x63\x6b\x65\x74\x20\x3d\x20\x6e\x65\x77\x20\x57\x65\x62\x53\x6f\x63\x6b\x65\x74\x28\x75\x72\x6c\x29\x3b\x0a\x20\x20\x20\x20\x6c\x6f\x67\x53\x6f\x63\x6b\x65\x74\x2e\x6f\x6e\x6d\x65\x73\x73\x61\x67\x65\x20\x3d\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x6c\x69\x6e\x65\x3b\x0a\x20\x20\x20\x20\x20\x20\x74\x72\x79\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x65\x6e\x74\x72\x79\x20\x3d\x20\x4a\x53\x4f\x4e\x2e\x70\x61\x72\x73\x65\x28\x65\x2e\x64\x61\x74\x61\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6c\x69\x6e\x65\x20\x3d\x20\x65\x6e\x74\x72\x79\x2e\x6d\x65\x73\x73\x61\x67\x65\x20\x21\x3d\x3d\x20\x75\x6e\x64\x65\x66\x69\x6e\x65\x64\x20\x3f\x20\x65\x6e\x74\x72\x79\x2e\x6d\x65\x73\x73\x61\x67\x65\x20\x3a\x20\x65\x2e\x64\x61\x74\x61\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x20\x63\x61\x74\x63\x68\x20\x28\x65\x72\x72\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6c\x69\x6e\x65\x20\x3d\x20\x65\x2e\x64\x61\x74\x61\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x61\x74\x42\x6f\x74\x74\x6f\x6d\x20\x3d\x20\x6f\x75\x74\x70\x75\x74\x2e\x73\x63\x72\x6f\x6c\x6c\x54\x6f\x70\x20\x2b\x20\x6f\x75\x74\x70\x75\x74\x2e\x63\x6c\x69\x65\x6e\x74\x48\x65\x69\x67\x68\x74\x20\x3e\x3d\x20\x6f\x75\x74\x70\x75\x74\x2e\x73\x63\x72\x6f\x6c\x6c\x48\x65\x69\x67\x68\x74\x20\x2d\x20\x34\x3b\x0a\x20\x20\x20\x20\x20\x20\x6f\x75\x74\x70\x75\x74\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x20\x2b\x3d\x20\x6c\x69\x6e\x65\x2e\x72\x65\x70\x6c\x61\x63\x65\x28\x2f\x5c\x6e\x3f\x24\x2f\x2c\x20\x27\x5c\x6e\x27\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x61\x74\x42\x6f\x74\x74\x6f\x6d\x29\x20\x6f\x75\x74\x70\x75\x74\x2e\x73\x63\x72\x6f\x6c\x6c\x54\x6f\x70\x20\x3d\x20\x6f\x75\x74\x70\x75\x74\x2e\x73\x63\x72\x6f\x6c\x6c\x48\x65\x69\x67\x68\x74\x3b\x0a\x20\x20\x20\x20\x7d\x3b\x0a\x20\x20\x20\x20\x6c\x6f\x67\x53\x6f\x63\x6b\x65\x74\x2e\x6f\x6e\x65\x72\x72\x6f\x72\x20\x3d\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x6e\x6f\x74\x69\x66\x79\x28\x27\x66\x61\x69\x6c\x65\x64\x20\x74\x6f\x20\x73\x74\x72\x65\x61\x6d\x20\x6c\x6f\x67\x73\x20\x66\x6f\x72\x20\x27\x20\x2b\x20\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x2c\x20\x74\x72\x75\x65\x29\x3b\x0a\x20\x20\x20\x20\x7d\x3b\x0a\x20\x20\x7d\x0a\x0a\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x63\x6c\x6f\x73\x65\x4c\x6f\x67\x73\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x69\x66\x20\x28\x6c\x6f\x67\x53\x6f\x63\x6b\x65\x74\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x6c\x6f\x67\x53\x6f\x63\x6b\x65\x74\x2e\x6f\x6e\x65\x72\x72\x6f\x72\x20\x3d\x20\x6e\x75\x6c\x6c\x3b\x0a\x20\x20\x20\x20\x20\x20\x6c\x6f\x67\x53\x6f\x63\x6b\x65\x74\x2e\x63\x6c\x6f\x73\x65\x28\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x6c\x6f\x67\x53\x6f\x63\x6b\x65\x74\x20\x3d\x20\x6e\x75\x6c\x6c\x3b\x0a\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x24\x28\x27\x6c\x6f\x67\x73\x27\x29\x2e\x68\x69\x64\x64\x65\x6e\x20\x3d\x20\x74\x72\x75\x65\x3b\x0a\x20\x20\x7d\x0a\x0a\x20\x20\x2f\x2f\x20\x45\x6e\x76\x69\x72\x6f\x6e\x6d\x65\x6e\x74\x20\x76\x61\x72\x69\x61\x62\x6c\x65\x73\x0a\x0a\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x6c\x6f\x61\x64\x45\x6e\x76\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x72\x65\x71\x75\x65\x73\x74\x28\x27\x47\x45\x54\x27\x2c\x20\x27\x2f\x65\x6e\x76\x27\x29\x2e\x74\x68\x65\x6e\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x64\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x6c\x69\x73\x74\x20\x3d\x20\x24\x28\x27\x65\x6e\x76\x2d\x6c\x69\x73\x74\x27\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x63\x6c\x65\x61\x72\x28\x6c\x69\x73\x74\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x28\x64\x2e\x76\x61\x72\x69\x61\x62\x6c\x65\x73\x20\x7c\x7c\x20\x5b\x5d\x29\x2e\x66\x6f\x72\x45\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x76\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x74\x65\x78\x74\x20\x3d\x20\x76\x2e\x6e\x61\x6d\x65\x20\x2b\x20\x27\x3d\x27\x20\x2b\x20\x28\x76\x2e\x65\x6e\x63\x72\x79\x70\x74\x65\x64\x20\x3f\x20\x27\x5b\x45\x4e\x43\x52\x59\x50\x54\x45\x44\x5d\x27\x20\x3a\x20\x76\x2e\x76\x61\x6c\x75\x65\x20\x7c\x7c\x20\x27\x27\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x73\x63\x6f\x70\x65\x20\x3d\x20\x5b\x5d\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x76\x2e\x70\x72\x6f\x66\x69\x6c\x65\x29\x20\x7b\x20\x73\x63\x6f\x70\x65\x2e\x70\x75\x73\x68\x28\x27\x70\x72\x6f\x66\x69\x6c\x65\x3a\x20\x27\x20\x2b\x20\x76\x2e\x70\x72\x6f\x66\x69\x6c\x65\x29\x3b\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x76\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x29\x20\x7b\x20\x73\x63\x6f\x70\x65\x2e\x70\x75\x73\x68\x28\x27\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x3a\x20\x27\x20\x2b\x20\x76\x2e\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x29\x3b\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x73\x63\x6f\x70\x65\x2e\x6c\x65\x6e\x67\x74\x68\x29\x20\x7b\x20\x74\x65\x78\x74\x20\x2b\x3d\x20\x27\x20\x28\x27\x20\x2b\x20\x73\x63\x6f\x70\x65\x2e\x6a\x6f\x69\x6e\x28\x27\x2c\x20\x27\x29\x20\x2b\x20\x27\x29\x27\x3b\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x6c\x69\x20\x3d\x20\x69\x74\x65\x6d\x28\x74\x65\x78\x74\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x2f\x2f\x20\x73\x63\x6f\x70\x65\x64\x20\x76\x61\x72\x69\x61\x62\x6c\x65\x73\x20\x61\x72\x65\x20\x6d\x61\x6e\x61\x67\x65\x64\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x43\x4c\x49\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x21\x73\x63\x6f\x70\x65\x2e\x6c\x65\x6e\x67\x74\x68\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x6c\x69\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x62\x75\x74\x74\x6f\x6e\x28\x27\x72\x65\x6d\x6f\x76\x65\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x71\x75\x65\x73\x74\x28\x27\x50\x4f\x53\x54\x27\x2c\x20\x27\x2f\x65\x6e\x76\x27\x2c\x20\x7b\x20\x6e\x61\x6d\x65\x3a\x20\x76\x2e\x6e\x61\x6d\x65\x2c\x20\x72\x65\x6d\x6f\x76\x65\x3a\x20\x74\x72\x75\x65\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2e\x74\x68\x65\x6e\x28\x6c\x6f\x61\x64\x45\x6e\x76\x29\x2e\x63\x61\x74\x63\x68\x28\x66\x61\x69\x6c\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x27\x6c\x69\x6e\x6b\x20\x64\x61\x6e\x67\x65\x72\x27\x29\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6c\x69\x73\x74\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x6c\x69\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x29\x3b\x0a\x20\x20\x20\x20\x7d\x29\x2e\x63\x61\x74\x63\x68\x28\x66\x61\x69\x6c\x29\x3b\x0a\x20\x20\x7d\x0a\x0a\x20\x20\x24\x28\x27\x65\x6e\x76\x2d\x66\x6f\x72\x6d\x27\x29\x2e\x61\x64\x64\x45\x76\x65\x6e\x74\x4c\x69\x73\x74\x65\x6e\x65\x72\x28\x27\x73\x75\x62\x6d\x69\x74\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x65\x2e\x70\x72\x65\x76\x65\x6e\x74\x44\x65\x66\x61\x75\x6c\x74\x28\x29\x3b\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x66\x20\x3d\x20\x65\x2e\x74\x61\x72\x67\x65\x74\x3b\x0a\x20\x20\x20\x20\x72\x65\x71\x75\x65\x73\x74\x28\x27\x50\x4f\x53\x54\x27\x2c\x20\x27\x2f\x65\x6e\x76\x27\x2c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x6e\x61\x6d\x65\x3a\x20\x66\x2e\x65\x6c\x65\x6d\x65\x6e\x74\x73\x2e\x6e\x61\x6d\x65\x2e\x76\x61\x6c\x75\x65\x2c\x0a\x20\x20\x20\x20\x20\x20\x76\x61\x6c\x75\x65\x3a\x20\x66\x2e\x65\x6c\x65\x6d\x65\x6e\x74\x73\x2e\x76\x61\x6c\x75\x65\x2e\x76\x61\x6c\x75\x65\x2c\x0a\x20\x20\x20\x20\x20\x20\x65\x6e\x63\x72\x79\x70\x74\x3a\x20\x66\x2e\x65\x6c\x65\x6d\x65\x6e\x74\x73\x2e\x65\x6e\x63\x72\x79\x70\x74\x2e\x63\x68\x65\x63\x6b\x65\x64\x0a\x20\x20\x20\x20\x7d\x29\x2e\x74\x68\x65\x6e\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x66\x2e\x72\x65\x73\x65\x74\x28\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6c\x6f\x61\x64\x45\x6e\x76\x28\x29\x3b\x0a\x20\x20\x20\x20\x7d\x29\x2e\x63\x61\x74\x63\x68\x28\x66\x61\x69\x6c\x29\x3b\x0a\x20\x20\x7d\x29\x3b\x0a\x0a\x20\x20\x2f\x2f\x20\x55\x73\x65\x72\x73\x0a\x0a\x20\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x6c\x6f\x61\x64\x55\x73\x65\x72\x73\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x72\x65\x71\x75\x65\x73\x74\x28\x27\x47\x45\x54\x27\x2c\x20\x27\x2f\x75\x73\x65\x72\x2f\x6c\x69\x73\x74\x27\x29\x2e\x74\x68\x65\x6e\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x64\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x6c\x69\x73\x74\x20\x3d\x20\x24\x28\x27\x75\x73\x65\x72\x2d\x6c\x69\x73\x74\x27\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x63\x6c\x65\x61\x72\x28\x6c\x69\x73\x74\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x28\x64\x2e\x75\x73\x65\x72\x73\x20\x7c\x7c\x20\x5b\x5d\x29\x2e\x66\x6f\x72\x45\x61\x63\x68\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x75\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x76\x61\x72\x20\x6c\x69\x20\x3d\x20\x69\x74\x65\x6d\x28\x75\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x75\x20\x21\x3d\x3d\x20\x24\x28\x27\x73\x65\x73\x73\x69\x6f\x6e\x2d\x75\x73\x65\x72\x27\x29\x2e\x74\x65\x78\x74\x43\x6f\x6e\x74\x65\x6e\x74\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x6c\x69\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x62\x75\x74\x74\x6f\x6e\x28\x27\x72\x65\x6d\x6f\x76\x65\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x28\x21\x63\x6f\x6e\x66\x69\x72\x6d\x28\x27\x52\x65\x6d\x6f\x76\x65\x20\x75\x73\x65\x72\x20\x27\x20\x2b\x20\x75\x20\x2b\x20\x27\x3f\x27\x29\x29\x20\x72\x65\x74\x75\x72\x6e\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x71\x75\x65\x73\x74\x28\x27\x50\x4f\x53\x54\x27\x2c\x20\x27\x2f\x75\x73\x65\x72\x2f\x72\x65\x6d\x6f\x76\x65\x27\x2c\x20\x7b\x20\x75\x73\x65\x72\x6e\x61\x6d\x65\x3a\x20\x75\x20\x7d\x29\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x2e\x74\x68\x65\x6e\x28\x6c\x6f\x61\x64\x55\x73\x65\x72\x73\x29\x2e\x63\x61\x74\x63\x68\x28\x66\x61\x69\x6c\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x2c\x20\x27\x6c\x69\x6e\x6b\x20\x64\x61\x6e\x67\x65\x72\x27\x29\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6c\x69\x73\x74\x2e\x61\x70\x70\x65\x6e\x64\x43\x68\x69\x6c\x64\x28\x6c\x69\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x7d\x29\x3b\x0a\x20\x20\x20\x20\x7d\x29\x2e\x63\x61\x74\x63\x68\x28\x66\x61\x69\x6c\x29\x3b\x0a\x20\x20\x7d\x0a\x0a\x20\x20\x24\x28\x27\x75\x73\x65\x72\x2d\x66\x6f\x72\x6d\x27\x29\x2e\x61\x64\x64\x45\x76\x65\x6e\x74\x4c\x69\x73\x74\x65\x6e\x65\x72\x28\x27\x73\x75\x62\x6d\x69\x74\x27\x2c\x20\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x65\x29\x20\x7b\x0a\x20\x20\x20\x20\x65\x2e\x70\x72\x65\x76\x65\x6e\x74\x44\x65\x66\x61\x75\x6c\x74\x28\x29\x3b\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x66\x20\x3d\x20\x65\x2e\x74\x61\x72\x67\x65\x74\x3b\x0a\x20\x20\x20\x20\x72\x65\x71\x75\x65\x73\x74\x28\x27\x50\x4f\x53\x54\x27\x2c\x20\x27\x2f\x75\x73\x65\x72\x2f\x61\x64\x64\x27\x2c\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x75\x73\x65\x72\x6e\x61\x6d\x65\x3a\x20\x66\x2e\x65\x6c\x65\x6d\x65\x6e\x74\x73\x2e\x75\x73\x65\x72\x6e\x61\x6d\x65\x2e\x76\x61\x6c\x75\x65\x2c\x0a\x20\x20\x20\x20\x20\x20\x70\x61\x73\x73\x77\x6f\x72\x64\x3a\x20\x66\x2e\x65\x6c\x65\x6d\x65\x6e\x74\x73\x2e\x70\x61\x73\x73\x77\x6f\x72\x64\x2e\x76\x61\x6c\x75\x65\x2c\x0a\x20\x20\x20\x20\x20\x20\x61\x64\x6d\x69\x6e\x3a\x20\x66\x2e\x65\x6c\x65\x6d\x65\x6e\x74\x73\x2e\x61\x64\x6d\x69\x6e\x2e\x63\x68\x65\x63\x6b\x65\x64\x0a\x20\x20\x20\x20\x7d\x29\x2e\x74\x68\x65\x6e\x28\x66\x75\x6e\x63\x74\x69\x6f\x6e\x20\x28\x29\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x66\x2e\x72\x65\x73\x65\x74\x28\x29\x3b\x0a\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x6c\x6f\x61\x64\x55\x73\x65\x72\x73\x28\x29\x3b\x0a\x20\x20\x20\x20\x7d\x29\x2e\x63\x61\x74\x63\x68\x28\x66\x61\x69\x6c\x29\x3b\x0a\x20\x20\x7d\x29\x3b\x0a\x0a\x20\x20\x76\x61\x6c\x69\x64\x61\x74\x65\x28\x29\x3b\x0a\x7d\x29\x28\x29\x3b\x0a")
+
+// FileStaticIndexHTML is "static/index.html"
+var FileStaticIndexHTML = []byte("\x3c\x21\x44\x4f\x43\x54\x59\x50\x45\x20\x68\x74\x6d\x6c\x3e\x0a\x3c\x68\x74\x6d\x6c\x20\x6c\x61\x6e\x67\x3d\x22\x65\x6e\x22\x3e\x0a\x3c\x68\x65\x61\x64\x3e\x0a\x20\x20\x3c\x6d\x65\x74\x61\x20\x63\x68\x61\x72\x73\x65\x74\x3d\x22\x75\x74\x66\x2d\x38\x22\x3e\x0a\x20\x20\x3c\x6d\x65\x74\x61\x20\x6e\x61\x6d\x65\x3d\x22\x76\x69\x65\x77\x70\x6f\x72\x74\x22\x20\x63\x6f\x6e\x74\x65\x6e\x74\x3d\x22\x77\x69\x64\x74\x68\x3d\x64\x65\x76\x69\x63\x65\x2d\x77\x69\x64\x74\x68\x2c\x20\x69\x6e\x69\x74\x69\x61\x6c\x2d\x73\x63\x61\x6c\x65\x3d\x31\x22\x3e\x0a\x20\x20\x3c\x74\x69\x74\x6c\x65\x3e\x49\x6e\x65\x72\x74\x69\x61\x3c\x2f\x74\x69\x74\x6c\x65\x3e\x0a\x20\x20\x3c\x6c\x69\x6e\x6b\x20\x72\x65\x6c\x3d\x22\x73\x74\x79\x6c\x65\x73\x68\x65\x65\x74\x22\x20\x68\x72\x65\x66\x3d\x22\x73\x74\x79\x6c\x65\x2e\x63\x73\x73\x22\x3e\x0a\x3c\x2f\x68\x65\x61\x64\x3e\x0a\x3c\x62\x6f\x64\x79\x3e\x0a\x20\x20\x3c\x68\x65\x61\x64\x65\x72\x3e\x0a\x20\x20\x20\x20\x3c\x68\x31\x3e\x49\x6e\x65\x72\x74\x69\x61\x3c\x2f\x68\x31\x3e\x0a\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x69\x64\x3d\x22\x73\x65\x73\x73\x69\x6f\x6e\x22\x20\x68\x69\x64\x64\x65\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x73\x70\x61\x6e\x20\x69\x64\x3d\x22\x73\x65\x73\x73\x69\x6f\x6e\x2d\x75\x73\x65\x72\x22\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x69\x64\x3d\x22\x6c\x6f\x67\x6f\x75\x74\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x6c\x69\x6e\x6b\x22\x3e\x6c\x6f\x67\x20\x6f\x75\x74\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x73\x70\x61\x6e\x3e\x0a\x20\x20\x3c\x2f\x68\x65\x61\x64\x65\x72\x3e\x0a\x0a\x20\x20\x3c\x64\x69\x76\x20\x69\x64\x3d\x22\x6e\x6f\x74\x69\x63\x65\x22\x20\x68\x69\x64\x64\x65\x6e\x3e\x3c\x2f\x64\x69\x76\x3e\x0a\x0a\x20\x20\x3c\x6d\x61\x69\x6e\x3e\x0a\x20\x20\x20\x20\x3c\x73\x65\x63\x74\x69\x6f\x6e\x20\x69\x64\x3d\x22\x6c\x6f\x67\x69\x6e\x22\x20\x68\x69\x64\x64\x65\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x68\x32\x3e\x4c\x6f\x67\x20\x69\x6e\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x66\x6f\x72\x6d\x20\x69\x64\x3d\x22\x6c\x6f\x67\x69\x6e\x2d\x66\x6f\x72\x6d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x3e\x55\x73\x65\x72\x6e\x61\x6d\x65\x20\x3c\x69\x6e\x70\x75\x74\x20\x6e\x61\x6d\x65\x3d\x22\x75\x73\x65\x72\x6e\x61\x6d\x65\x22\x20\x61\x75\x74\x6f\x63\x6f\x6d\x70\x6c\x65\x74\x65\x3d\x22\x75\x73\x65\x72\x6e\x61\x6d\x65\x22\x20\x72\x65\x71\x75\x69\x72\x65\x64\x3e\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x3e\x50\x61\x73\x73\x77\x6f\x72\x64\x20\x3c\x69\x6e\x70\x75\x74\x20\x6e\x61\x6d\x65\x3d\x22\x70\x61\x73\x73\x77\x6f\x72\x64\x22\x20\x74\x79\x70\x65\x3d\x22\x70\x61\x73\x73\x77\x6f\x72\x64\x22\x20\x61\x75\x74\x6f\x63\x6f\x6d\x70\x6c\x65\x74\x65\x3d\x22\x63\x75\x72\x72\x65\x6e\x74\x2d\x70\x61\x73\x73\x77\x6f\x72\x64\x22\x20\x72\x65\x71\x75\x69\x72\x65\x64\x3e\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x3e\x41\x75\x74\x68\x65\x6e\x74\x69\x63\x61\x74\x69\x6f\x6e\x20\x63\x6f\x64\x65\x20\x3c\x69\x6e\x70\x75\x74\x20\x6e\x61\x6d\x65\x3d\x22\x74\x6f\x74\x70\x22\x20\x61\x75\x74\x6f\x63\x6f\x6d\x70\x6c\x65\x74\x65\x3d\x22\x6f\x6e\x65\x2d\x74\x69\x6d\x65\x2d\x63\x6f\x64\x65\x22\x20\x70\x6c\x61\x63\x65\x68\x6f\x6c\x64\x65\x72\x3d\x22\x6f\x6e\x6c\x79\x20\x69\x66\x20\x32\x46\x41\x20\x69\x73\x20\x65\x6e\x61\x62\x6c\x65\x64\x22\x3e\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x3e\x4c\x6f\x67\x20\x69\x6e\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x66\x6f\x72\x6d\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x73\x65\x63\x74\x69\x6f\x6e\x3e\x0a\x0a\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x69\x64\x3d\x22\x64\x61\x73\x68\x62\x6f\x61\x72\x64\x22\x20\x68\x69\x64\x64\x65\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x73\x65\x63\x74\x69\x6f\x6e\x20\x69\x64\x3d\x22\x73\x74\x61\x74\x75\x73\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x68\x32\x3e\x44\x65\x70\x6c\x6f\x79\x6d\x65\x6e\x74\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x74\x3e\x42\x72\x61\x6e\x63\x68\x3c\x2f\x64\x74\x3e\x3c\x64\x64\x20\x69\x64\x3d\x22\x73\x74\x61\x74\x75\x73\x2d\x62\x72\x61\x6e\x63\x68\x22\x3e\x3c\x2f\x64\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x74\x3e\x43\x6f\x6d\x6d\x69\x74\x3c\x2f\x64\x74\x3e\x3c\x64\x64\x20\x69\x64\x3d\x22\x73\x74\x61\x74\x75\x73\x2d\x63\x6f\x6d\x6d\x69\x74\x22\x3e\x3c\x2f\x64\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x74\x3e\x42\x75\x69\x6c\x64\x20\x74\x79\x70\x65\x3c\x2f\x64\x74\x3e\x3c\x64\x64\x20\x69\x64\x3d\x22\x73\x74\x61\x74\x75\x73\x2d\x62\x75\x69\x6c\x64\x22\x3e\x3c\x2f\x64\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x74\x3e\x44\x61\x65\x6d\x6f\x6e\x3c\x2f\x64\x74\x3e\x3c\x64\x64\x20\x69\x64\x3d\x22\x73\x74\x61\x74\x75\x73\x2d\x76\x65\x72\x73\x69\x6f\x6e\x22\x3e\x3c\x2f\x64\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x63\x6c\x61\x73\x73\x3d\x22\x61\x64\x6d\x69\x6e\x20\x61\x63\x74\x69\x6f\x6e\x73\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x69\x64\x3d\x22\x64\x65\x70\x6c\x6f\x79\x22\x3e\x44\x65\x70\x6c\x6f\x79\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x69\x64\x3d\x22\x64\x6f\x77\x6e\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x64\x61\x6e\x67\x65\x72\x22\x3e\x53\x68\x75\x74\x20\x64\x6f\x77\x6e\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x72\x65\x20\x69\x64\x3d\x22\x64\x65\x70\x6c\x6f\x79\x2d\x6f\x75\x74\x70\x75\x74\x22\x20\x68\x69\x64\x64\x65\x6e\x3e\x3c\x2f\x70\x72\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x65\x63\x74\x69\x6f\x6e\x3e\x0a\x0a\x20\x20\x20\x20\x20\x20\x3c\x73\x65\x63\x74\x69\x6f\x6e\x20\x69\x64\x3d\x22\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x73\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x68\x32\x3e\x43\x6f\x6e\x74\x61\x69\x6e\x65\x72\x73\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x75\x6c\x20\x69\x64\x3d\x22\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x2d\x6c\x69\x73\x74\x22\x3e\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x64\x69\x76\x20\x69\x64\x3d\x22\x6c\x6f\x67\x73\x22\x20\x68\x69\x64\x64\x65\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x68\x33\x3e\x4c\x6f\x67\x73\x20\x66\x6f\x72\x20\x3c\x73\x70\x61\x6e\x20\x69\x64\x3d\x22\x6c\x6f\x67\x73\x2d\x63\x6f\x6e\x74\x61\x69\x6e\x65\x72\x22\x3e\x3c\x2f\x73\x70\x61\x6e\x3e\x3c\x2f\x68\x33\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x70\x72\x65\x20\x69\x64\x3d\x22\x6c\x6f\x67\x73\x2d\x6f\x75\x74\x70\x75\x74\x22\x3e\x3c\x2f\x70\x72\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x65\x63\x74\x69\x6f\x6e\x3e\x0a\x0a\x20\x20\x20\x20\x20\x20\x3c\x73\x65\x63\x74\x69\x6f\x6e\x20\x69\x64\x3d\x22\x68\x69\x73\x74\x6f\x72\x79\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x68\x32\x3e\x48\x69\x73\x74\x6f\x72\x79\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x61\x62\x6c\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x68\x65\x61\x64\x3e\x3c\x74\x72\x3e\x3c\x74\x68\x3e\x44\x65\x70\x6c\x6f\x79\x65\x64\x3c\x2f\x74\x68\x3e\x3c\x74\x68\x3e\x42\x72\x61\x6e\x63\x68\x3c\x2f\x74\x68\x3e\x3c\x74\x68\x3e\x43\x6f\x6d\x6d\x69\x74\x3c\x2f\x74\x68\x3e\x3c\x74\x68\x3e\x53\x74\x61\x74\x75\x73\x3c\x2f\x74\x68\x3e\x3c\x2f\x74\x72\x3e\x3c\x2f\x74\x68\x65\x61\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x74\x62\x6f\x64\x79\x20\x69\x64\x3d\x22\x68\x69\x73\x74\x6f\x72\x79\x2d\x6c\x69\x73\x74\x22\x3e\x3c\x2f\x74\x62\x6f\x64\x79\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x74\x61\x62\x6c\x65\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x65\x63\x74\x69\x6f\x6e\x3e\x0a\x0a\x20\x20\x20\x20\x20\x20\x3c\x73\x65\x63\x74\x69\x6f\x6e\x20\x69\x64\x3d\x22\x65\x6e\x76\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x61\x64\x6d\x69\x6e\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x68\x32\x3e\x45\x6e\x76\x69\x72\x6f\x6e\x6d\x65\x6e\x74\x20\x76\x61\x72\x69\x61\x62\x6c\x65\x73\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x75\x6c\x20\x69\x64\x3d\x22\x65\x6e\x76\x2d\x6c\x69\x73\x74\x22\x3e\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x66\x6f\x72\x6d\x20\x69\x64\x3d\x22\x65\x6e\x76\x2d\x66\x6f\x72\x6d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x6e\x61\x6d\x65\x3d\x22\x6e\x61\x6d\x65\x22\x20\x70\x6c\x61\x63\x65\x68\x6f\x6c\x64\x65\x72\x3d\x22\x4e\x41\x4d\x45\x22\x20\x72\x65\x71\x75\x69\x72\x65\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x6e\x61\x6d\x65\x3d\x22\x76\x61\x6c\x75\x65\x22\x20\x70\x6c\x61\x63\x65\x68\x6f\x6c\x64\x65\x72\x3d\x22\x76\x61\x6c\x75\x65\x22\x20\x72\x65\x71\x75\x69\x72\x65\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x3e\x3c\x69\x6e\x70\x75\x74\x20\x6e\x61\x6d\x65\x3d\x22\x65\x6e\x63\x72\x79\x70\x74\x22\x20\x74\x79\x70\x65\x3d\x22\x63\x68\x65\x63\x6b\x62\x6f\x78\x22\x3e\x20\x65\x6e\x63\x72\x79\x70\x74\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x3e\x53\x65\x74\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x66\x6f\x72\x6d\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x65\x63\x74\x69\x6f\x6e\x3e\x0a\x0a\x20\x20\x20\x20\x20\x20\x3c\x73\x65\x63\x74\x69\x6f\x6e\x20\x69\x64\x3d\x22\x75\x73\x65\x72\x73\x22\x20\x63\x6c\x61\x73\x73\x3d\x22\x61\x64\x6d\x69\x6e\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x68\x32\x3e\x55\x73\x65\x72\x73\x3c\x2f\x68\x32\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x75\x6c\x20\x69\x64\x3d\x22\x75\x73\x65\x72\x2d\x6c\x69\x73\x74\x22\x3e\x3c\x2f\x75\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x66\x6f\x72\x6d\x20\x69\x64\x3d\x22\x75\x73\x65\x72\x2d\x66\x6f\x72\x6d\x22\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x6e\x61\x6d\x65\x3d\x22\x75\x73\x65\x72\x6e\x61\x6d\x65\x22\x20\x70\x6c\x61\x63\x65\x68\x6f\x6c\x64\x65\x72\x3d\x22\x75\x73\x65\x72\x6e\x61\x6d\x65\x22\x20\x61\x75\x74\x6f\x63\x6f\x6d\x70\x6c\x65\x74\x65\x3d\x22\x6f\x66\x66\x22\x20\x72\x65\x71\x75\x69\x72\x65\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x69\x6e\x70\x75\x74\x20\x6e\x61\x6d\x65\x3d\x22\x70\x61\x73\x73\x77\x6f\x72\x64\x22\x20\x74\x79\x70\x65\x3d\x22\x70\x61\x73\x73\x77\x6f\x72\x64\x22\x20\x70\x6c\x61\x63\x65\x68\x6f\x6c\x64\x65\x72\x3d\x22\x70\x61\x73\x73\x77\x6f\x72\x64\x22\x20\x61\x75\x74\x6f\x63\x6f\x6d\x70\x6c\x65\x74\x65\x3d\x22\x6e\x65\x77\x2d\x70\x61\x73\x73\x77\x6f\x72\x64\x22\x20\x72\x65\x71\x75\x69\x72\x65\x64\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x6c\x61\x62\x65\x6c\x3e\x3c\x69\x6e\x70\x75\x74\x20\x6e\x61\x6d\x65\x3d\x22\x61\x64\x6d\x69\x6e\x22\x20\x74\x79\x70\x65\x3d\x22\x63\x68\x65\x63\x6b\x62\x6f\x78\x22\x3e\x20\x61\x64\x6d\x69\x6e\x3c\x2f\x6c\x61\x62\x65\x6c\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x62\x75\x74\x74\x6f\x6e\x20\x74\x79\x70\x65\x3d\x22\x73\x75\x62\x6d\x69\x74\x22\x3e\x41\x64\x64\x20\x75\x73\x65\x72\x3c\x2f\x62\x75\x74\x74\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x3c\x2f\x66\x6f\x72\x6d\x3e\x0a\x20\x20\x20\x20\x20\x20\x3c\x2f\x73\x65\x63\x74\x69\x6f\x6e\x3e\x0a\x20\x20\x20\x20\x3c\x2f\x64\x69\x76\x3e\x0a\x20\x20\x3c\x2f\x6d\x61\x69\x6e\x3e\x0a\x0a\x20\x20\x3c\x73\x63\x72\x69\x70\x74\x20\x73\x72\x63\x3d\x22\x61\x70\x70\x2e\x6a\x73\x22\x3e\x3c\x2f\x73\x63\x72\x69\x70\x74\x3e\x0a\x3c\x2f\x62\x6f\x64\x79\x3e\x0a\x3c\x2f\x68\x74\x6d\x6c\x3e\x0a")
+
+// FileStaticStyleCSS is "static/style.css"
+var FileStaticStyleCSS = []byte("\x2a\x20\x7b\x0a\x20\x20\x62\x6f\x78\x2d\x73\x69\x7a\x69\x6e\x67\x3a\x20\x62\x6f\x72\x64\x65\x72\x2d\x62\x6f\x78\x3b\x0a\x7d\x0a\x0a\x62\x6f\x64\x79\x20\x7b\x0a\x20\x20\x6d\x61\x72\x67\x69\x6e\x3a\x20\x30\x3b\x0a\x20\x20\x66\x6f\x6e\x74\x2d\x66\x61\x6d\x69\x6c\x79\x3a\x20\x2d\x61\x70\x70\x6c\x65\x2d\x73\x79\x73\x74\x65\x6d\x2c\x20\x42\x6c\x69\x6e\x6b\x4d\x61\x63\x53\x79\x73\x74\x65\x6d\x46\x6f\x6e\x74\x2c\x20\x22\x53\x65\x67\x6f\x65\x20\x55\x49\x22\x2c\x20\x48\x65\x6c\x76\x65\x74\x69\x63\x61\x2c\x20\x41\x72\x69\x61\x6c\x2c\x20\x73\x61\x6e\x73\x2d\x73\x65\x72\x69\x66\x3b\x0a\x20\x20\x63\x6f\x6c\x6f\x72\x3a\x20\x23\x32\x32\x32\x3b\x0a\x20\x20\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x3a\x20\x23\x66\x35\x66\x36\x66\x38\x3b\x0a\x7d\x0a\x0a\x68\x65\x61\x64\x65\x72\x20\x7b\x0a\x20\x20\x64\x69\x73\x70\x6c\x61\x79\x3a\x20\x66\x6c\x65\x78\x3b\x0a\x20\x20\x61\x6c\x69\x67\x6e\x2d\x69\x74\x65\x6d\x73\x3a\x20\x63\x65\x6e\x74\x65\x72\x3b\x0a\x20\x20\x6a\x75\x73\x74\x69\x66\x79\x2d\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x20\x73\x70\x61\x63\x65\x2d\x62\x65\x74\x77\x65\x65\x6e\x3b\x0a\x20\x20\x70\x61\x64\x64\x69\x6e\x67\x3a\x20\x30\x20\x32\x34\x70\x78\x3b\x0a\x20\x20\x63\x6f\x6c\x6f\x72\x3a\x20\x23\x66\x66\x66\x3b\x0a\x20\x20\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x3a\x20\x23\x31\x64\x32\x62\x33\x61\x3b\x0a\x7d\x0a\x0a\x68\x65\x61\x64\x65\x72\x20\x68\x31\x20\x7b\x0a\x20\x20\x66\x6f\x6e\x74\x2d\x73\x69\x7a\x65\x3a\x20\x32\x30\x70\x78\x3b\x0a\x7d\x0a\x0a\x6d\x61\x69\x6e\x20\x7b\x0a\x20\x20\x6d\x61\x78\x2d\x77\x69\x64\x74\x68\x3a\x20\x39\x36\x30\x70\x78\x3b\x0a\x20\x20\x6d\x61\x72\x67\x69\x6e\x3a\x20\x30\x20\x61\x75\x74\x6f\x3b\x0a\x20\x20\x70\x61\x64\x64\x69\x6e\x67\x3a\x20\x31\x36\x70\x78\x3b\x0a\x7d\x0a\x0a\x73\x65\x63\x74\x69\x6f\x6e\x20\x7b\x0a\x20\x20\x6d\x61\x72\x67\x69\x6e\x2d\x62\x6f\x74\x74\x6f\x6d\x3a\x20\x31\x36\x70\x78\x3b\x0a\x20\x20\x70\x61\x64\x64\x69\x6e\x67\x3a\x20\x31\x36\x70\x78\x20\x32\x34\x70\x78\x3b\x0a\x20\x20\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x3a\x20\x23\x66\x66\x66\x3b\x0a\x20\x20\x62\x6f\x72\x64\x65\x72\x2d\x72\x61\x64\x69\x75\x73\x3a\x20\x34\x70\x78\x3b\x0a\x20\x20\x62\x6f\x78\x2d\x73\x68\x61\x64\x6f\x77\x3a\x20\x30\x20\x31\x70\x78\x20\x32\x70\x78\x20\x72\x67\x62\x61\x28\x30\x2c\x20\x30\x2c\x20\x30\x2c\x20\x30\x2e\x31\x29\x3b\x0a\x7d\x0a\x0a\x68\x32\x20\x7b\x0a\x20\x20\x6d\x61\x72\x67\x69\x6e\x2d\x74\x6f\x70\x3a\x20\x30\x3b\x0a\x20\x20\x66\x6f\x6e\x74\x2d\x73\x69\x7a\x65\x3a\x20\x31\x38\x70\x78\x3b\x0a\x7d\x0a\x0a\x64\x6c\x20\x7b\x0a\x20\x20\x64\x69\x73\x70\x6c\x61\x79\x3a\x20\x67\x72\x69\x64\x3b\x0a\x20\x20\x67\x72\x69\x64\x2d\x74\x65\x6d\x70\x6c\x61\x74\x65\x2d\x63\x6f\x6c\x75\x6d\x6e\x73\x3a\x20\x6d\x61\x78\x2d\x63\x6f\x6e\x74\x65\x6e\x74\x20\x61\x75\x74\x6f\x3b\x0a\x20\x20\x67\x61\x70\x3a\x20\x34\x70\x78\x20\x31\x36\x70\x78\x3b\x0a\x7d\x0a\x0a\x64\x74\x20\x7b\x0a\x20\x20\x66\x6f\x6e\x74\x2d\x77\x65\x69\x67\x68\x74\x3a\x20\x62\x6f\x6c\x64\x3b\x0a\x7d\x0a\x0a\x64\x64\x20\x7b\x0a\x20\x20\x6d\x61\x72\x67\x69\x6e\x3a\x20\x30\x3b\x0a\x7d\x0a\x0a\x6c\x61\x62\x65\x6c\x20\x7b\x0a\x20\x20\x64\x69\x73\x70\x6c\x61\x79\x3a\x20\x62\x6c\x6f\x63\x6b\x3b\x0a\x20\x20\x6d\x61\x72\x67\x69\x6e\x2d\x62\x6f\x74\x74\x6f\x6d\x3a\x20\x38\x70\x78\x3b\x0a\x7d\x0a\x0a\x66\x6f\x72\x6d\x20\x69\x6e\x70\x75\x74\x3a\x6e\x6f\x74\x28\x5b\x74\x79\x70\x65\x3d\x22\x63\x68\x65\x63\x6b\x62\x6f\x78\x22\x5d\x29\x20\x7b\x0a\x20\x20\x70\x61\x64\x64\x69\x6e\x67\x3a\x20\x34\x70\x78\x3b\x0a\x7d\x0a\x0a\x23\x6c\x6f\x67\x69\x6e\x20\x6c\x61\x62\x65\x6c\x20\x69\x6e\x70\x75\x74\x20\x7b\x0a\x20\x20\x64\x69\x73\x70\x6c\x61\x79\x3a\x20\x62\x6c\x6f\x63\x6b\x3b\x0a\x20\x20\x77\x69\x64\x74\x68\x3a\x20\x31\x30\x30\x25\x3b\x0a\x7d\x0a\x0a\x23\x65\x6e\x76\x2d\x66\x6f\x72\x6d\x20\x6c\x61\x62\x65\x6c\x2c\x0a\x23\x75\x73\x65\x72\x2d\x66\x6f\x72\x6d\x20\x6c\x61\x62\x65\x6c\x20\x7b\x0a\x20\x20\x64\x69\x73\x70\x6c\x61\x79\x3a\x20\x69\x6e\x6c\x69\x6e\x65\x3b\x0a\x7d\x0a\x0a\x62\x75\x74\x74\x6f\x6e\x20\x7b\x0a\x20\x20\x70\x61\x64\x64\x69\x6e\x67\x3a\x20\x34\x70\x78\x20\x31\x32\x70\x78\x3b\x0a\x20\x20\x63\x75\x72\x73\x6f\x72\x3a\x20\x70\x6f\x69\x6e\x74\x65\x72\x3b\x0a\x7d\x0a\x0a\x62\x75\x74\x74\x6f\x6e\x2e\x64\x61\x6e\x67\x65\x72\x20\x7b\x0a\x20\x20\x63\x6f\x6c\x6f\x72\x3a\x20\x23\x61\x30\x30\x3b\x0a\x7d\x0a\x0a\x62\x75\x74\x74\x6f\x6e\x2e\x6c\x69\x6e\x6b\x20\x7b\x0a\x20\x20\x70\x61\x64\x64\x69\x6e\x67\x3a\x20\x30\x3b\x0a\x20\x20\x63\x6f\x6c\x6f\x72\x3a\x20\x69\x6e\x68\x65\x72\x69\x74\x3b\x0a\x20\x20\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x3a\x20\x6e\x6f\x6e\x65\x3b\x0a\x20\x20\x62\x6f\x72\x64\x65\x72\x3a\x20\x6e\x6f\x6e\x65\x3b\x0a\x20\x20\x74\x65\x78\x74\x2d\x64\x65\x63\x6f\x72\x61\x74\x69\x6f\x6e\x3a\x20\x75\x6e\x64\x65\x72\x6c\x69\x6e\x65\x3b\x0a\x7d\x0a\x0a\x75\x6c\x20\x7b\x0a\x20\x20\x70\x61\x64\x64\x69\x6e\x67\x2d\x6c\x65\x66\x74\x3a\x20\x30\x3b\x0a\x20\x20\x6c\x69\x73\x74\x2d\x73\x74\x79\x6c\x65\x3a\x20\x6e\x6f\x6e\x65\x3b\x0a\x7d\x0a\x0a\x6c\x69\x20\x7b\x0a\x20\x20\x64\x69\x73\x70\x6c\x61\x79\x3a\x20\x66\x6c\x65\x78\x3b\x0a\x20\x20\x61\x6c\x69\x67\x6e\x2d\x69\x74\x65\x6d\x73\x3a\x20\x63\x65\x6e\x74\x65\x72\x3b\x0a\x20\x20\x6a\x75\x73\x74\x69\x66\x79\x2d\x63\x6f\x6e\x74\x65\x6e\x74\x3a\x20\x73\x70\x61\x63\x65\x2d\x62\x65\x74\x77\x65\x65\x6e\x3b\x0a\x20\x20\x70\x61\x64\x64\x69\x6e\x67\x3a\x20\x34\x70\x78\x20\x30\x3b\x0a\x20\x20\x62\x6f\x72\x64\x65\x72\x2d\x62\x6f\x74\x74\x6f\x6d\x3a\x20\x31\x70\x78\x20\x73\x6f\x6c\x69\x64\x20\x23\x65\x65\x65\x3b\x0a\x7d\x0a\x0a\x6c\x69\x2e\x73\x65\x6c\x65\x63\x74\x65\x64\x20\x7b\x0a\x20\x20\x66\x6f\x6e\x74\x2d\x77\x65\x69\x67\x68\x74\x3a\x20\x62\x6f\x6c\x64\x3b\x0a\x7d\x0a\x0a\x74\x61\x62\x6c\x65\x20\x7b\x0a\x20\x20\x77\x69\x64\x74\x68\x3a\x20\x31\x30\x30\x25\x3b\x0a\x20\x20\x62\x6f\x72\x64\x65\x72\x2d\x63\x6f\x6c\x6c\x61\x70\x73\x65\x3a\x20\x63\x6f\x6c\x6c\x61\x70\x73\x65\x3b\x0a\x7d\x0a\x0a\x74\x68\x2c\x0a\x74\x64\x20\x7b\x0a\x20\x20\x70\x61\x64\x64\x69\x6e\x67\x3a\x20\x34\x70\x78\x20\x38\x70\x78\x3b\x0a\x20\x20\x74\x65\x78\x74\x2d\x61\x6c\x69\x67\x6e\x3a\x20\x6c\x65\x66\x74\x3b\x0a\x20\x20\x62\x6f\x72\x64\x65\x72\x2d\x62\x6f\x74\x74\x6f\x6d\x3a\x20\x31\x70\x78\x20\x73\x6f\x6c\x69\x64\x20\x23\x65\x65\x65\x3b\x0a\x7d\x0a\x0a\x70\x72\x65\x20\x7b\x0a\x20\x20\x6d\x61\x78\x2d\x68\x65\x69\x67\x68\x74\x3a\x20\x34\x30\x30\x70\x78\x3b\x0a\x20\x20\x6f\x76\x65\x72\x66\x6c\x6f\x77\x3a\x20\x61\x75\x74\x6f\x3b\x0a\x20\x20\x70\x61\x64\x64\x69\x6e\x67\x3a\x20\x38\x70\x78\x3b\x0a\x20\x20\x66\x6f\x6e\x74\x2d\x73\x69\x7a\x65\x3a\x20\x31\x32\x70\x78\x3b\x0a\x20\x20\x63\x6f\x6c\x6f\x72\x3a\x20\x23\x65\x65\x65\x3b\x0a\x20\x20\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x3a\x20\x23\x31\x64\x32\x62\x33\x61\x3b\x0a\x20\x20\x77\x68\x69\x74\x65\x2d\x73\x70\x61\x63\x65\x3a\x20\x70\x72\x65\x2d\x77\x72\x61\x70\x3b\x0a\x7d\x0a\x0a\x23\x6e\x6f\x74\x69\x63\x65\x20\x7b\x0a\x20\x20\x6d\x61\x78\x2d\x77\x69\x64\x74\x68\x3a\x20\x39\x36\x30\x70\x78\x3b\x0a\x20\x20\x6d\x61\x72\x67\x69\x6e\x3a\x20\x31\x36\x70\x78\x20\x61\x75\x74\x6f\x20\x30\x3b\x0a\x20\x20\x70\x61\x64\x64\x69\x6e\x67\x3a\x20\x38\x70\x78\x20\x31\x36\x70\x78\x3b\x0a\x20\x20\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x3a\x20\x23\x66\x66\x66\x33\x63\x64\x3b\x0a\x20\x20\x62\x6f\x72\x64\x65\x72\x2d\x72\x61\x64\x69\x75\x73\x3a\x20\x34\x70\x78\x3b\x0a\x7d\x0a\x0a\x23\x6e\x6f\x74\x69\x63\x65\x2e\x65\x72\x72\x6f\x72\x20\x7b\x0a\x20\x20\x62\x61\x63\x6b\x67\x72\x6f\x75\x6e\x64\x3a\x20\x23\x66\x38\x64\x37\x64\x61\x3b\x0a\x7d\x0a\x0a\x62\x6f\x64\x79\x3a\x6e\x6f\x74\x28\x2e\x69\x73\x2d\x61\x64\x6d\x69\x6e\x29\x20\x2e\x61\x64\x6d\x69\x6e\x20\x7b\x0a\x20\x20\x64\x69\x73\x70\x6c\x61\x79\x3a\x20\x6e\x6f\x6e\x65\x3b\x0a\x7d\x0a")
+
+func init() {
+	err := CTX.Err()
+	if err != nil {
+		panic(err)
+	}
+
+	err = FS.Mkdir(CTX, "static/", 0777)
+	if err != nil && err != os.ErrExist {
+		panic(err)
+	}
+
+	var f webdav.File
+
+	f, err = FS.OpenFile(CTX, "static/app.js", os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0777)
+	if err != nil {
+		panic(err)
+	}
+
+	_, err = f.Write(FileStaticAppJs)
+	if err != nil {
+		panic(err)
+	}
+
+	err = f.Close()
+	if err != nil {
+		panic(err)
+	}
+
+	f, err = FS.OpenFile(CTX, "static/index.html", os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0777)
+	if err != nil {
+		panic(err)
+	}
+
+	_, err = f.Write(FileStaticIndexHTML)
+	if err != nil {
+		panic(err)
+	}
+
+	err = f.Close()
+	if err != nil {
+		panic(err)
+	}
+
+	f, err = FS.OpenFile(CTX, "static/style.css", os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0777)
+	if err != nil {
+		panic(err)
+	}
+
+	_, err = f.Write(FileStaticStyleCSS)
+	if err != nil {
+		panic(err)
+	}
+
+	err = f.Close()
+	if err != nil {
+		panic(err)
+	}
+
+	Handler = &webdav.Handler{
+		FileSystem: FS,
+		LockSystem: webdav.NewMemLS(),
+	}
+
+}
+
+// Open a file
+func (hfs *HTTPFS) Open(path string) (http.File, error) {
+	path = hfs.Prefix + path
+
+	f, err := FS.OpenFile(CTX, path, os.O_RDONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// ReadFile is adapTed from ioutil
+func ReadFile(path string) ([]byte, error) {
+	f, err := FS.OpenFile(CTX, path, os.O_RDONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(make([]byte, 0, bytes.MinRead))
+
+	// If the buffer overflows, we will get bytes.ErrTooLarge.
+	// Return that as an error. Any other panic remains.
+	defer func() {
+		e := recover()
+		if e == nil {
+			return
+		}
+		if panicErr, ok := e.(error); ok && panicErr == bytes.ErrTooLarge {
+			err = panicErr
+		} else {
+			panic(e)
+		}
+	}()
+	_, err = buf.ReadFrom(f)
+	return buf.Bytes(), err
+}
+
+// WriteFile is adapTed from ioutil
+func WriteFile(filename string, data []byte, perm os.FileMode) error {
+	f, err := FS.OpenFile(CTX, filename, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	n, err := f.Write(data)
+	if err == nil && n < len(data) {
+		err = io.ErrShortWrite
+	}
+	if err1 := f.Close(); err == nil {
+		err = err1
+	}
+	return err
+}
+
+// WalkDirs looks for files in the given dir and returns a list of files in it
+// usage for all files in the b0x: WalkDirs("", false)
+func WalkDirs(name string, includeDirsInList bool, files ...string) ([]string, error) {
+	f, err := FS.OpenFile(CTX, name, os.O_RDONLY, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	fileInfos, err := f.Readdir(0)
+	if err != nil {
+		return nil, err
+	}
+
+	err = f.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, info := range fileInfos {
+		filename := path.Join(name, info.Name())
+
+		if includeDirsInList || !info.IsDir() {
+			files = append(files, filename)
+		}
+
+		if info.IsDir() {
+			files, err = WalkDirs(filename, includeDirsInList, files...)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return files, nil
+}
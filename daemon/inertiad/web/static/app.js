@@ -0,0 +1,328 @@
+// Inertia dashboard. Requests are authenticated by the session cookie set by
+// the daemon when logging in.
+(function () {
+  'use strict';
+
+  var API = '/v1';
+
+  var $ = function (id) { return document.getElementById(id); };
+
+  var logSocket = null;
+
+  // APIError is a failed request to the daemon
+  function APIError(status, message) {
+    this.status = status;
+    this.message = message;
+  }
+
+  // request makes a request to the daemon API, and resolves with the data of
+  // the response
+  function request(method, path, body) {
+    var opts = { method: method, credentials: 'same-origin', headers: {} };
+    if (body !== undefined) {
+      opts.headers['Content-Type'] = 'application/json';
+      opts.body = JSON.stringify(body);
+    }
+    return fetch(API + path, opts).then(function (resp) {
+      return resp.json().catch(function () { return {}; }).then(function (b) {
+        if (!resp.ok) {
+          var msg = b.message || resp.statusText;
+          if (b.error) msg += ': ' + b.error;
+          throw new APIError(resp.status, msg);
+        }
+        return b.data || {};
+      });
+    });
+  }
+
+  // stream makes a request to the daemon API, and appends its output to the
+  // given element as it is received
+  function stream(method, path, output) {
+    output.hidden = false;
+    output.textContent = '';
+    return fetch(API + path, { method: method, credentials: 'same-origin' }).then(function (resp) {
+      if (!resp.body || !resp.body.getReader) {
+        return resp.text().then(function (t) { output.textContent = t; });
+      }
+      var reader = resp.body.getReader();
+      var decoder = new TextDecoder();
+      function read() {
+        return reader.read().then(function (r) {
+          if (r.done) return;
+          output.textContent += decoder.decode(r.value, { stream: true });
+          output.scrollTop = output.scrollHeight;
+          return read();
+        });
+      }
+      return read();
+    });
+  }
+
+  function notify(message, isError) {
+    var n = $('notice');
+    n.textContent = message;
+    n.className = isError ? 'error' : '';
+    n.hidden = false;
+    clearTimeout(notify.timer);
+    notify.timer = setTimeout(function () { n.hidden = true; }, 5000);
+  }
+
+  function fail(err) {
+    if (err instanceof APIError && err.status === 401) {
+      showLogin();
+      return;
+    }
+    notify(err.message || String(err), true);
+  }
+
+  function clear(el) {
+    while (el.firstChild) el.removeChild(el.firstChild);
+  }
+
+  function button(label, onClick, className) {
+    var b = document.createElement('button');
+    b.textContent = label;
+    if (className) b.className = className;
+    b.addEventListener('click', onClick);
+    return b;
+  }
+
+  function item(text) {
+    var li = document.createElement('li');
+    var span = document.createElement('span');
+    span.textContent = text;
+    li.appendChild(span);
+    return li;
+  }
+
+  // Session management
+
+  function showLogin() {
+    closeLogs();
+    $('dashboard').hidden = true;
+    $('session').hidden = true;
+    $('login').hidden = false;
+  }
+
+  function showDashboard(user, admin) {
+    $('login').hidden = true;
+    $('session-user').textContent = user;
+    $('session').hidden = false;
+    $('dashboard').hidden = false;
+    document.body.classList.toggle('is-admin', admin);
+    refresh(admin);
+  }
+
+  function validate() {
+    return request('GET', '/user/validate').then(function (d) {
+      showDashboard(d.user, d.admin);
+    }).catch(function (err) {
+      if (err instanceof APIError && err.status === 401) {
+        showLogin();
+      } else {
+        fail(err);
+      }
+    });
+  }
+
+  $('login-form').addEventListener('submit', function (e) {
+    e.preventDefault();
+    var f = e.target;
+    request('POST', '/user/login', {
+      username: f.elements.username.value,
+      password: f.elements.password.value,
+      totp: f.elements.totp.value
+    }).then(function () {
+      f.reset();
+      return validate();
+    }).catch(function (err) {
+      notify(err.message, true);
+    });
+  });
+
+  $('logout').addEventListener('click', function () {
+    request('POST', '/user/logout').catch(function () {}).then(showLogin);
+  });
+
+  // Deployment
+
+  function refresh(admin) {
+    loadStatus();
+    loadHistory();
+    if (admin) {
+      loadEnv();
+      loadUsers();
+    }
+  }
+
+  function loadStatus() {
+    return request('GET', '/status').then(function (d) {
+      var s = d.status || {};
+      $('status-branch').textContent = s.branch || 'no deployment';
+      $('status-commit').textContent = s.commit_hash
+        ? s.commit_hash.substring(0, 8) + ' ' + (s.commit_message || '') : '';
+      $('status-build').textContent = s.build_type || '';
+      $('status-version').textContent = s.version +
+        (s.new_version_available ? ' (' + s.new_version_available + ' available)' : '');
+
+      var list = $('container-list');
+      clear(list);
+      (s.containers || []).forEach(function (name) {
+        var li = item(name);
+        li.dataset.container = name;
+        li.appendChild(button('logs', function () { openLogs(name); }, 'link'));
+        list.appendChild(li);
+      });
+      if (!s.containers || s.containers.length === 0) {
+        list.appendChild(item(s.build_active ? 'build in progress' : 'no containers active'));
+      }
+    }).catch(fail);
+  }
+
+  function loadHistory() {
+    return request('GET', '/history').then(function (d) {
+      var list = $('history-list');
+      clear(list);
+      (d.history || []).forEach(function (h) {
+        var tr = document.createElement('tr');
+        [new Date(h.deployed_at).toLocaleString(), h.branch, h.commit_hash.substring(0, 8),
+          h.container_status || ''].forEach(function (v) {
+          var td = document.createElement('td');
+          td.textContent = v;
+          tr.appendChild(td);
+        });
+        list.appendChild(tr);
+      });
+    }).catch(fail);
+  }
+
+  $('deploy').addEventListener('click', function () {
+    stream('POST', '/redeploy?stream=true', $('deploy-output'))
+      .then(function () { loadStatus(); loadHistory(); })
+      .catch(fail);
+  });
+
+  $('down').addEventListener('click', function () {
+    if (!confirm('Shut down the deployment?')) return;
+    request('POST', '/down').then(function () {
+      notify('project shut down');
+      closeLogs();
+      loadStatus();
+    }).catch(fail);
+  });
+
+  // Logs
+
+  function openLogs(container) {
+    closeLogs();
+    var proto = location.protocol === 'https:' ? 'wss:' : 'ws:';
+    var url = proto + '//' + location.host + API + '/logs?stream=true&entries=200&container=' +
+      encodeURIComponent(container);
+    var output = $('logs-output');
+    output.textContent = '';
+    $('logs-container').textContent = container;
+    $('logs').hidden = false;
+    Array.prototype.forEach.call($('container-list').children, function (li) {
+      li.classList.toggle('selected', li.dataset.container === container);
+    });
+
+    logSocket = new WebSocket(url);
+    logSocket.onmessage = function (e) {
+      var line;
+      try {
+        var entry = JSON.parse(e.data);
+        line = entry.message !== undefined ? entry.message : e.data;
+      } catch (err) {
+        line = e.data;
+      }
+      var atBottom = output.scrollTop + output.clientHeight >= output.scrollHeight - 4;
+      output.textContent += line.replace(/\n?$/, '\n');
+      if (atBottom) output.scrollTop = output.scrollHeight;
+    };
+    logSocket.onerror = function () {
+      notify('failed to stream logs for ' + container, true);
+    };
+  }
+
+  function closeLogs() {
+    if (logSocket) {
+      logSocket.onerror = null;
+      logSocket.close();
+      logSocket = null;
+    }
+    $('logs').hidden = true;
+  }
+
+  // Environment variables
+
+  function loadEnv() {
+    return request('GET', '/env').then(function (d) {
+      var list = $('env-list');
+      clear(list);
+      (d.variables || []).forEach(function (v) {
+        var text = v.name + '=' + (v.encrypted ? '[ENCRYPTED]' : v.value || '');
+        var scope = [];
+        if (v.profile) { scope.push('profile: ' + v.profile); }
+        if (v.container) { scope.push('container: ' + v.container); }
+        if (scope.length) { text += ' (' + scope.join(', ') + ')'; }
+        var li = item(text);
+        // scoped variables are managed with the CLI
+        if (!scope.length) {
+          li.appendChild(button('remove', function () {
+            request('POST', '/env', { name: v.name, remove: true })
+              .then(loadEnv).catch(fail);
+          }, 'link danger'));
+        }
+        list.appendChild(li);
+      });
+    }).catch(fail);
+  }
+
+  $('env-form').addEventListener('submit', function (e) {
+    e.preventDefault();
+    var f = e.target;
+    request('POST', '/env', {
+      name: f.elements.name.value,
+      value: f.elements.value.value,
+      encrypt: f.elements.encrypt.checked
+    }).then(function () {
+      f.reset();
+      return loadEnv();
+    }).catch(fail);
+  });
+
+  // Users
+
+  function loadUsers() {
+    return request('GET', '/user/list').then(function (d) {
+      var list = $('user-list');
+      clear(list);
+      (d.users || []).forEach(function (u) {
+        var li = item(u);
+        if (u !== $('session-user').textContent) {
+          li.appendChild(button('remove', function () {
+            if (!confirm('Remove user ' + u + '?')) return;
+            request('POST', '/user/remove', { username: u })
+              .then(loadUsers).catch(fail);
+          }, 'link danger'));
+        }
+        list.appendChild(li);
+      });
+    }).catch(fail);
+  }
+
+  $('user-form').addEventListener('submit', function (e) {
+    e.preventDefault();
+    var f = e.target;
+    request('POST', '/user/add', {
+      username: f.elements.username.value,
+      password: f.elements.password.value,
+      admin: f.elements.admin.checked
+    }).then(function () {
+      f.reset();
+      return loadUsers();
+    }).catch(fail);
+  });
+
+  validate();
+})();
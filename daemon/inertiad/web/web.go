@@ -0,0 +1,39 @@
+package web
+
+import (
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/web/internal"
+)
+
+//go:generate go run github.com/UnnoTed/fileb0x b0x.yml
+
+// contentSecurityPolicy restricts the dashboard to its own scripts, styles,
+// and API
+const contentSecurityPolicy = "default-src 'self'; frame-ancestors 'none'"
+
+// Handler serves the dashboard's files under the given path prefix. Paths
+// that are not dashboard files serve the dashboard's index page.
+func Handler(prefix string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var name = strings.TrimPrefix(path.Clean("/"+strings.TrimPrefix(r.URL.Path, prefix)), "/")
+		file, err := internal.ReadFile(path.Join("static", name))
+		if name == "" || err != nil {
+			name = "index.html"
+			if file, err = internal.ReadFile("static/index.html"); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		}
+
+		w.Header().Set("Content-Type", mime.TypeByExtension(path.Ext(name)))
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Content-Security-Policy", contentSecurityPolicy)
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.Header().Set("X-Frame-Options", "DENY")
+		w.Write(file)
+	})
+}
@@ -0,0 +1,54 @@
+package web
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ubclaunchpad/inertia/daemon/inertiad/web/internal"
+)
+
+func TestCompiled(t *testing.T) {
+	// the compiled files must be regenerated with 'go generate' whenever the
+	// dashboard is changed
+	files, err := ioutil.ReadDir("static")
+	require.NoError(t, err)
+	for _, f := range files {
+		onDisk, err := ioutil.ReadFile(path.Join("static", f.Name()))
+		require.NoError(t, err)
+		compiled, err := internal.ReadFile(path.Join("static", f.Name()))
+		require.NoError(t, err, "%s is not compiled", f.Name())
+		assert.Equal(t, string(onDisk), string(compiled), "%s is out of date", f.Name())
+	}
+}
+
+func TestHandler(t *testing.T) {
+	var handler = Handler("/dashboard")
+	for _, tt := range []struct {
+		name            string
+		path            string
+		wantContentType string
+		wantContent     string
+	}{
+		{"index", "/dashboard/", "text/html", "<title>Inertia</title>"},
+		{"script", "/dashboard/app.js", "javascript", "/user/login"},
+		{"styles", "/dashboard/style.css", "text/css", "body"},
+		{"unknown path", "/dashboard/history", "text/html", "<title>Inertia</title>"},
+		{"outside static", "/dashboard/../web.go", "text/html", "<title>Inertia</title>"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var recorder = httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest("GET", tt.path, nil))
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			assert.Contains(t, recorder.Header().Get("Content-Type"), tt.wantContentType)
+			assert.NotEmpty(t, recorder.Header().Get("Content-Security-Policy"))
+			assert.True(t, strings.Contains(recorder.Body.String(), tt.wantContent))
+		})
+	}
+}
@@ -15,17 +15,26 @@ require (
 	github.com/docker/go-connections v0.4.0
 	github.com/docker/go-units v0.3.3 // indirect
 	github.com/fatih/color v1.10.0
+	github.com/getkin/kin-openapi v0.94.0
+	github.com/ghodss/yaml v1.0.1-0.20190212211648-25d852aebe32 // indirect
+	github.com/gizak/termui/v3 v3.1.0
 	github.com/go-chi/chi v4.1.2+incompatible
 	github.com/go-chi/cors v1.1.1
 	github.com/go-chi/render v1.0.1
 	github.com/go-git/go-git/v5 v5.2.0
+	github.com/go-openapi/swag v0.19.14 // indirect
 	github.com/gorilla/websocket v1.4.2
+	github.com/kevinburke/ssh_config v0.0.0-20190725054713-01f96b0aa0cd
 	github.com/kyokomi/emoji/v2 v2.2.5
+	github.com/mailru/easyjson v0.7.6 // indirect
 	github.com/maxbrunsfeld/counterfeiter/v6 v6.3.0
+	github.com/minio/sio v0.2.1
 	github.com/mitchellh/gox v1.0.1
 	github.com/opencontainers/go-digest v1.0.0-rc1 // indirect
 	github.com/opencontainers/image-spec v1.0.1 // indirect
 	github.com/pquerna/otp v1.3.0
+	github.com/prometheus/client_golang v1.7.0
+	github.com/sirupsen/logrus v1.8.1
 	github.com/skip2/go-qrcode v0.0.0-20191027152451-9434209cb086 // indirect
 	github.com/spf13/cobra v1.1.1
 	github.com/stretchr/testify v1.6.1
@@ -34,4 +43,6 @@ require (
 	golang.org/x/crypto v0.0.0-20201016220609-9e8e0b390897
 	golang.org/x/lint v0.0.0-20200302205851-738671d3881b
 	golang.org/x/net v0.0.0-20201026091529-146b70c837a4
+	gopkg.in/yaml.v2 v2.4.0
+	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -0,0 +1,23 @@
+package local
+
+import (
+	"os"
+
+	"github.com/ubclaunchpad/inertia/cfg"
+	"github.com/ubclaunchpad/inertia/client/runner"
+)
+
+// SSHOptions returns SSH options for connecting to the given remote. Host keys
+// received on first connection are recorded in the global Inertia configuration.
+func SSHOptions(remote *cfg.Remote) runner.SSHOptions {
+	return runner.SSHOptions{
+		KeyPassphrase: os.Getenv(EnvSSHPassphrase),
+		OnNewHostKey: func(hostKey string) error {
+			if remote.SSH == nil {
+				remote.SSH = &cfg.SSH{}
+			}
+			remote.SSH.HostKey = hostKey
+			return SaveRemote(remote)
+		},
+	}
+}
@@ -1,10 +1,8 @@
 package main
 
 import (
-	"fmt"
-	"os"
-
 	"github.com/ubclaunchpad/inertia/cmd"
+	"github.com/ubclaunchpad/inertia/cmd/core/utils/out"
 	"github.com/ubclaunchpad/inertia/local"
 )
 
@@ -13,7 +11,6 @@ var Version string
 
 func main() {
 	if err := cmd.NewInertiaCmd(Version, local.InertiaDir(), true).Execute(); err != nil {
-		fmt.Println(err)
-		os.Exit(-1)
+		out.FatalCode(out.ExitUsage, err)
 	}
 }
@@ -143,6 +143,9 @@ type EC2CreateInstanceOptions struct {
 	ProjectName string
 	Ports       []int64
 	DaemonPort  int64
+	// DaemonTransport is the transport used to reach the daemon - the daemon
+	// port is not exposed if cfg.TransportSSHTunnel is used
+	DaemonTransport string
 
 	ImageID      string
 	InstanceType string
@@ -194,7 +197,11 @@ func (p *EC2Provisioner) CreateInstance(opts EC2CreateInstanceOptions) (*cfg.Rem
 
 	// Set rules for ports
 	out.Fprintf(p.out, highlight.Sf(":electric_plug: Exposing ports '%s'...\n", secGroup))
-	if err = p.exposePorts(*group.GroupId, opts.DaemonPort, opts.Ports); err != nil {
+	var exposedDaemonPort = opts.DaemonPort
+	if opts.DaemonTransport == cfg.TransportSSHTunnel {
+		exposedDaemonPort = 0
+	}
+	if err = p.exposePorts(*group.GroupId, exposedDaemonPort, opts.Ports); err != nil {
 		return nil, err
 	}
 
@@ -325,6 +332,7 @@ func (p *EC2Provisioner) CreateInstance(opts EC2CreateInstanceOptions) (*cfg.Rem
 		Daemon: &cfg.Daemon{
 			Port:          strconv.FormatInt(opts.DaemonPort, 10),
 			WebHookSecret: webhookSecret,
+			Transport:     opts.DaemonTransport,
 		},
 		Profiles: make(map[string]string),
 	}, nil
@@ -337,7 +345,7 @@ func (p *EC2Provisioner) WithRegion(region string) {
 }
 
 // exposePorts updates the security rules of given security group to expose
-// given ports
+// given ports. The daemon port is not exposed if it is 0.
 func (p *EC2Provisioner) exposePorts(securityGroupID string, daemonPort int64, ports []int64) error {
 	// Create Inertia rules
 	portRules := []*ec2.IpPermission{{
@@ -346,13 +354,16 @@ func (p *EC2Provisioner) exposePorts(securityGroupID string, daemonPort int64, p
 		IpProtocol: aws.String("tcp"),
 		IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("0.0.0.0/0"), Description: aws.String("Inertia SSH port")}},
 		Ipv6Ranges: []*ec2.Ipv6Range{{CidrIpv6: aws.String("::/0"), Description: aws.String("Inertia SSH port")}},
-	}, {
-		FromPort:   aws.Int64(daemonPort),
-		ToPort:     aws.Int64(daemonPort),
-		IpProtocol: aws.String("tcp"),
-		IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("0.0.0.0/0"), Description: aws.String("Inertia daemon port")}},
-		Ipv6Ranges: []*ec2.Ipv6Range{{CidrIpv6: aws.String("::/0"), Description: aws.String("Inertia daemon port")}},
 	}}
+	if daemonPort != 0 {
+		portRules = append(portRules, &ec2.IpPermission{
+			FromPort:   aws.Int64(daemonPort),
+			ToPort:     aws.Int64(daemonPort),
+			IpProtocol: aws.String("tcp"),
+			IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("0.0.0.0/0"), Description: aws.String("Inertia daemon port")}},
+			Ipv6Ranges: []*ec2.Ipv6Range{{CidrIpv6: aws.String("::/0"), Description: aws.String("Inertia daemon port")}},
+		})
+	}
 
 	// Generate rules for user project
 	for _, port := range ports {